@@ -239,30 +239,88 @@ const docTemplate = `{
                 }
             }
         },
-        "/study-session": {
+        "/feed-tokens": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Get the user's active study session",
+                "description": "List the user's calendar feed tokens that weren't revoked",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "study-session"
+                    "feeds"
                 ],
-                "summary": "Get active study session",
+                "summary": "List feed tokens",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/studysession.StudySession"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/feeds.FeedToken"
+                            }
                         }
                     },
-                    "404": {
-                        "description": "No active session found",
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Create a secret token for the user's calendar feed. The token and the feed URL are only returned once.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "feeds"
+                ],
+                "summary": "Create a feed token",
+                "parameters": [
+                    {
+                        "description": "Feed token data",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/feeds.CreateFeedTokenRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/feeds.CreatedFeedToken"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "409": {
+                        "description": "Too many feed tokens",
                         "schema": {
                             "type": "object",
                             "additionalProperties": {
@@ -282,33 +340,104 @@ const docTemplate = `{
                 }
             }
         },
-        "/study-session/events": {
-            "get": {
+        "/feed-tokens/{id}": {
+            "delete": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Get events for the user's active study session",
+                "description": "Revoke a calendar feed token, its feed URL stops working immediately",
+                "tags": [
+                    "feeds"
+                ],
+                "summary": "Revoke a feed token",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Feed token ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Feed token not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/feeds/{token}": {
+            "get": {
+                "description": "Serve the completed sessions of the token owner as an iCalendar feed. The token in the URL replaces the Bearer token. Supports If-None-Match and If-Modified-Since.",
                 "produces": [
-                    "application/json"
+                    "text/calendar"
                 ],
                 "tags": [
-                    "study-session"
+                    "feeds"
+                ],
+                "summary": "Get calendar feed",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Feed token followed by .ics",
+                        "name": "token",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of the cached feed",
+                        "name": "If-None-Match",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Last-Modified of the cached feed",
+                        "name": "If-Modified-Since",
+                        "in": "header"
+                    }
                 ],
-                "summary": "Get active study session events",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/studysession.SessionEvent"
-                            }
+                            "type": "file"
                         }
                     },
+                    "304": {
+                        "description": "Not Modified"
+                    },
                     "404": {
-                        "description": "No active session found",
+                        "description": "Feed not found",
                         "schema": {
                             "type": "object",
                             "additionalProperties": {
@@ -326,40 +455,54 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "post": {
+            }
+        },
+        "/stats": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Add events to the user's active study session",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Aggregate the focused time of the user's completed sessions per day, week or month in the user's timezone",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "study-session"
+                    "stats"
                 ],
-                "summary": "Add events to active study session",
+                "summary": "Get study statistics",
                 "parameters": [
                     {
-                        "description": "Session events data",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/studysession.AddStudySessionEventsRequest"
-                        }
+                        "type": "string",
+                        "description": "Bucket size: day, week or month (default day)",
+                        "name": "granularity",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "First date (YYYY-MM-DD), defaults to 30 days, 12 weeks or 12 months before to",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Last date (YYYY-MM-DD), defaults to today",
+                        "name": "to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "IANA timezone of the buckets (default UTC)",
+                        "name": "tz",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/studysession.StudySession"
+                            "$ref": "#/definitions/stats.StudyStats"
                         }
                     },
                     "400": {
@@ -371,17 +514,56 @@ const docTemplate = `{
                             }
                         }
                     },
-                    "404": {
-                        "description": "No active session found",
+                    "500": {
+                        "description": "Internal Server Error",
                         "schema": {
                             "type": "object",
                             "additionalProperties": {
                                 "type": "string"
                             }
                         }
+                    }
+                }
+            }
+        },
+        "/stats/heatmap": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get the focused minutes of every day of a year with an intensity level based on the quantiles of the user's days",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "stats"
+                ],
+                "summary": "Get study heatmap",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Year (default current year)",
+                        "name": "year",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "IANA timezone of the days (default the streak settings timezone)",
+                        "name": "tz",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/stats.Heatmap"
+                        }
                     },
-                    "422": {
-                        "description": "Session not active",
+                    "400": {
+                        "description": "Bad Request",
                         "schema": {
                             "type": "object",
                             "additionalProperties": {
@@ -401,14 +583,48 @@ const docTemplate = `{
                 }
             }
         },
-        "/study-session/finish": {
-            "post": {
+        "/streaks": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get the user's current and longest streak of days reaching the daily goal, along with the banked freezes",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "stats"
+                ],
+                "summary": "Get study streak",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/stats.Streak"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/streaks/settings": {
+            "put": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Finish the user's active study session",
+                "description": "Set the daily focused time goal and the timezone the streak days are counted in",
                 "consumes": [
                     "application/json"
                 ],
@@ -416,17 +632,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "study-session"
+                    "stats"
                 ],
-                "summary": "Finish active study session",
+                "summary": "Update streak settings",
                 "parameters": [
                     {
-                        "description": "Finish session data",
+                        "description": "Streak settings",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/studysession.FinishStudySessionRequest"
+                            "$ref": "#/definitions/stats.UpdateStreakSettingsRequest"
                         }
                     }
                 ],
@@ -434,7 +650,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/studysession.StudySession"
+                            "$ref": "#/definitions/stats.StreakSettings"
                         }
                     },
                     "400": {
@@ -446,17 +662,42 @@ const docTemplate = `{
                             }
                         }
                     },
-                    "404": {
-                        "description": "No active session found",
+                    "500": {
+                        "description": "Internal Server Error",
                         "schema": {
                             "type": "object",
                             "additionalProperties": {
                                 "type": "string"
                             }
                         }
+                    }
+                }
+            }
+        },
+        "/study-session": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get the user's active study session, with the current phase and the time remaining for pomodoro sessions",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "study-session"
+                ],
+                "summary": "Get active study session",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/studysession.StudySession"
+                        }
                     },
-                    "422": {
-                        "description": "Session not active",
+                    "404": {
+                        "description": "No active session found",
                         "schema": {
                             "type": "object",
                             "additionalProperties": {
@@ -474,16 +715,14 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/study-session/start": {
-            "post": {
+            },
+            "patch": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Create a new study session for the authenticated user",
+                "description": "Update the title, notes and subjects of the user's active study session",
                 "consumes": [
                     "application/json"
                 ],
@@ -493,21 +732,27 @@ const docTemplate = `{
                 "tags": [
                     "study-session"
                 ],
-                "summary": "Create a study session",
+                "summary": "Update active study session",
                 "parameters": [
                     {
-                        "description": "Study session data",
+                        "type": "string",
+                        "description": "ETag of the session version being edited",
+                        "name": "If-Match",
+                        "in": "header"
+                    },
+                    {
+                        "description": "Fields to update",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/studysession.UpsertActiveStudySessionRequest"
+                            "$ref": "#/definitions/studysession.UpdateStudySessionRequest"
                         }
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
                             "$ref": "#/definitions/studysession.StudySession"
                         }
@@ -521,8 +766,226 @@ const docTemplate = `{
                             }
                         }
                     },
-                    "409": {
-                        "description": "Active session already exists",
+                    "404": {
+                        "description": "No active session found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "412": {
+                        "description": "Session was modified",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "422": {
+                        "description": "Subject not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/study-session/cancel": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Discard the user's active study session, it's kept as abandoned",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "study-session"
+                ],
+                "summary": "Cancel active study session",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/studysession.StudySession"
+                        }
+                    },
+                    "404": {
+                        "description": "No active session found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/study-session/events": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get events for the user's active study session. With a cursor only the events recorded after it are\nreturned, including late events from other devices; a cursor from a previous session returns all events.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "study-session"
+                ],
+                "summary": "Get active study session events",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "X-Sync-Cursor returned by the previous sync",
+                        "name": "cursor",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/studysession.SessionEvent"
+                            }
+                        },
+                        "headers": {
+                            "X-Sync-Cursor": {
+                                "type": "string",
+                                "description": "Cursor pointing after the last recorded event"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid cursor",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "No active session found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Add events to the user's active study session. Events whose id is already recorded in the session are skipped so\noffline clients can replay them, events older than the last session event are merged by event time.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "study-session"
+                ],
+                "summary": "Add events to active study session",
+                "parameters": [
+                    {
+                        "description": "Session events data",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/studysession.AddStudySessionEventsRequest"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Key making the request safe to retry, repeats replay the first response",
+                        "name": "Idempotency-Key",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/studysession.SessionEvent"
+                            }
+                        },
+                        "headers": {
+                            "X-Sync-Cursor": {
+                                "type": "string",
+                                "description": "Cursor pointing after the last recorded event"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "No active session found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "422": {
+                        "description": "Events break the session state machine or reuse the id of another session event",
                         "schema": {
                             "type": "object",
                             "additionalProperties": {
@@ -541,206 +1004,3303 @@ const docTemplate = `{
                     }
                 }
             }
-        }
-    },
-    "definitions": {
-        "auth.Account": {
+        },
+        "/study-session/export": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Stream all the user's sessions with their events and durations as CSV (one row per event) or as a JSON array",
+                "produces": [
+                    "text/csv",
+                    "application/json"
+                ],
+                "tags": [
+                    "study-session"
+                ],
+                "summary": "Export study sessions",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Export format (csv, json), defaults to csv",
+                        "name": "format",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/study-session/export.ics": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Export the user's completed sessions as an RFC 5545 calendar, one event per session",
+                "produces": [
+                    "text/calendar"
+                ],
+                "tags": [
+                    "study-session"
+                ],
+                "summary": "Export study sessions as iCalendar",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "First session date (YYYY-MM-DD)",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Last session date (YYYY-MM-DD)",
+                        "name": "to",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/study-session/finish": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Finish the user's active study session, the response includes the change to the user's streak",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "study-session"
+                ],
+                "summary": "Finish active study session",
+                "parameters": [
+                    {
+                        "description": "Finish session data",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/studysession.FinishStudySessionRequest"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Key making the request safe to retry, repeats replay the first response",
+                        "name": "Idempotency-Key",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/studysession.FinishStudySessionResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "No active session found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "422": {
+                        "description": "Finish time is not valid for the session",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/study-session/history": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "List the user's finished study sessions, most recent first",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "study-session"
+                ],
+                "summary": "List study session history",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "First session date (YYYY-MM-DD)",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Last session date (YYYY-MM-DD)",
+                        "name": "to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "Session states to include",
+                        "name": "state",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Case-insensitive title search",
+                        "name": "title",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Only sessions closed by the stale session sweeper",
+                        "name": "auto_closed",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Cursor returned by the previous page",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page size (default 20, max 100)",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/studysession.StudySessionPage"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/study-session/import": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Create completed sessions from a CSV file with start, end, title, notes and subject columns, or from a Toggl or Clockify export. Rows already imported are skipped and nothing is imported when a row is invalid.",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "study-session"
+                ],
+                "summary": "Import study sessions",
+                "parameters": [
+                    {
+                        "type": "file",
+                        "description": "CSV file",
+                        "name": "file",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Column mapping (generic, toggl, clockify), defaults to generic",
+                        "name": "mapping",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "IANA timezone of the times without offset, defaults to UTC",
+                        "name": "tz",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Validate the file without importing it",
+                        "name": "dry_run",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/studysession.ImportReport"
+                        }
+                    },
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/studysession.ImportReport"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "409": {
+                        "description": "A session overlapping the file was created meanwhile",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "413": {
+                        "description": "Request Entity Too Large",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/studysession.ImportReport"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/study-session/manual": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Record a completed session with explicit start, end and pause times, the active session is left untouched",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "study-session"
+                ],
+                "summary": "Log a past study session",
+                "parameters": [
+                    {
+                        "description": "Past session data",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/studysession.CreateManualStudySessionRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/studysession.StudySession"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "409": {
+                        "description": "Session overlaps another session",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "422": {
+                        "description": "Subject not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/study-session/start": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Create a new study session for the authenticated user, optionally in pomodoro mode",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "study-session"
+                ],
+                "summary": "Create a study session",
+                "parameters": [
+                    {
+                        "description": "Study session data",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/studysession.UpsertActiveStudySessionRequest"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Key making the request safe to retry, repeats replay the first response",
+                        "name": "Idempotency-Key",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/studysession.StudySession"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "409": {
+                        "description": "Active session already exists",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "422": {
+                        "description": "Subject not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/study-session/stream": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Server-sent events stream of the changes to the user's sessions, made from any device. Each event is named\nafter the notification type (e.g. session.started, session.paused) and its id can be sent back as\nLast-Event-ID to resume after a disconnect. A comment is sent as heartbeat while there is no change.",
+                "produces": [
+                    "text/event-stream"
+                ],
+                "tags": [
+                    "study-session"
+                ],
+                "summary": "Stream study session notifications",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Id of the last event received, the stream resumes after it",
+                        "name": "Last-Event-ID",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Same as the Last-Event-ID header, for clients that can't set headers",
+                        "name": "last_event_id",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/notifications.Notification"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid last event id",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/study-session/subject-totals": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Split the focused time of the user's completed sessions between their subjects",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "study-session"
+                ],
+                "summary": "Get time per subject",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "First session date (YYYY-MM-DD)",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Last session date (YYYY-MM-DD)",
+                        "name": "to",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/studysession.SubjectTimeReport"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/study-session/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get one of the user's study sessions, active or finished, with its events",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "study-session"
+                ],
+                "summary": "Get study session",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Study session ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/studysession.StudySessionDetails"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Session not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Delete one of the user's finished study sessions, it can be restored during the retention window",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "study-session"
+                ],
+                "summary": "Delete study session",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Study session ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Session not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "409": {
+                        "description": "Session is active",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Update the title, notes and subjects of one of the user's study sessions",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "study-session"
+                ],
+                "summary": "Update study session",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Study session ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of the session version being edited",
+                        "name": "If-Match",
+                        "in": "header"
+                    },
+                    {
+                        "description": "Fields to update",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/studysession.UpdateStudySessionRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/studysession.StudySession"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Session not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "412": {
+                        "description": "Session was modified",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "422": {
+                        "description": "Subject not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/study-session/{id}/events": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get the events of one of the user's study sessions, ordered by time",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "study-session"
+                ],
+                "summary": "Get study session events",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Study session ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/studysession.SessionEvent"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Session not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/study-session/{id}/restore": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Restore one of the user's deleted study sessions while it's within the retention window",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "study-session"
+                ],
+                "summary": "Restore study session",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Study session ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/studysession.StudySession"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "No restorable session found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/subjects": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "List the study subjects of the authenticated user ordered by name",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "subjects"
+                ],
+                "summary": "List subjects",
+                "parameters": [
+                    {
+                        "type": "boolean",
+                        "description": "Include archived subjects",
+                        "name": "include_archived",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/subjects.Subject"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Create a new study subject for the authenticated user",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "subjects"
+                ],
+                "summary": "Create a subject",
+                "parameters": [
+                    {
+                        "description": "Subject data",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/subjects.CreateSubjectRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/subjects.Subject"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "409": {
+                        "description": "Subject name already in use",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/subjects/relations": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "List the parent and prerequisite relations between the user's subjects",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "subjects"
+                ],
+                "summary": "List subject relations",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/subjects.SubjectRelation"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Make a subject the parent or a prerequisite of another subject",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "subjects"
+                ],
+                "summary": "Create subject relation",
+                "parameters": [
+                    {
+                        "description": "Relation data",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/subjects.CreateRelationRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/subjects.SubjectRelation"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Subject not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "409": {
+                        "description": "Relation already exists or subject already has a parent",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "422": {
+                        "description": "Relation would create a cycle",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/subjects/relations/{id}": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Remove a relation between two of the user's subjects",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "subjects"
+                ],
+                "summary": "Delete subject relation",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Relation ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Relation not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/subjects/study-order": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get the user's active subjects sorted so that every subject comes after its prerequisites",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "subjects"
+                ],
+                "summary": "Get study order",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/subjects.Subject"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/subjects/tree": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get the user's active subjects nested by their parent relations",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "subjects"
+                ],
+                "summary": "Get subject tree",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/subjects.SubjectNode"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/subjects/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get one of the user's study subjects",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "subjects"
+                ],
+                "summary": "Get subject",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Subject ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/subjects.Subject"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Subject not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Update the name, color and icon of one of the user's study subjects",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "subjects"
+                ],
+                "summary": "Update subject",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Subject ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Fields to update",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/subjects.UpdateSubjectRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/subjects.Subject"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Subject not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "409": {
+                        "description": "Subject name already in use",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/subjects/{id}/archive": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Archive one of the user's study subjects, hiding it from the default listing",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "subjects"
+                ],
+                "summary": "Archive subject",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Subject ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/subjects.Subject"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Subject not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/subjects/{id}/unarchive": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Restore one of the user's archived study subjects",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "subjects"
+                ],
+                "summary": "Unarchive subject",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Subject ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/subjects.Subject"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Subject not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "409": {
+                        "description": "Subject name already in use",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/webhooks": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "List the webhook endpoints registered by the user",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "webhooks"
+                ],
+                "summary": "List webhook endpoints",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/webhooks.Endpoint"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Register a URL that receives the events of the user's sessions. Payloads are signed with HMAC-SHA256 in the X-Webhook-Signature header as \"t=\u003cunix time\u003e,v1=\u003chex signature\u003e\", the signed content is \"\u003cunix time\u003e.\u003cbody\u003e\". The secret is only returned once.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "webhooks"
+                ],
+                "summary": "Create a webhook endpoint",
+                "parameters": [
+                    {
+                        "description": "Webhook endpoint data",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/webhooks.CreateEndpointRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/webhooks.CreatedEndpoint"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "409": {
+                        "description": "Too many webhook endpoints",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/webhooks/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get one of the user's webhook endpoints",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "webhooks"
+                ],
+                "summary": "Get a webhook endpoint",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Webhook endpoint ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/webhooks.Endpoint"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Webhook endpoint not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Delete a webhook endpoint along with its delivery log, pending deliveries are dropped",
+                "tags": [
+                    "webhooks"
+                ],
+                "summary": "Delete a webhook endpoint",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Webhook endpoint ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Webhook endpoint not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Update the URL, description and event types of a webhook endpoint, or pause and resume its deliveries",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "webhooks"
+                ],
+                "summary": "Update a webhook endpoint",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Webhook endpoint ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Fields to update",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/webhooks.UpdateEndpointRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/webhooks.Endpoint"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Webhook endpoint not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/webhooks/{id}/deliveries": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "List the latest deliveries of a webhook endpoint, newest first, with the response code of their last attempt",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "webhooks"
+                ],
+                "summary": "List webhook deliveries",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Webhook endpoint ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Number of deliveries, 50 by default and at most 100",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/webhooks.Delivery"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Webhook endpoint not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/webhooks/{id}/deliveries/{deliveryId}/redeliver": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Send the event of a finished delivery to the endpoint again. The redelivery is a new delivery with its own attempts, the event keeps its id.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "webhooks"
+                ],
+                "summary": "Redeliver a webhook",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Webhook endpoint ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Webhook delivery ID",
+                        "name": "deliveryId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "202": {
+                        "description": "Accepted",
+                        "schema": {
+                            "$ref": "#/definitions/webhooks.Delivery"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Webhook delivery not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "409": {
+                        "description": "Webhook delivery is still pending",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/ws": {
+            "get": {
+                "description": "WebSocket carrying JSON messages {\"id\", \"type\", \"payload\"}. The connection is authenticated with the\nBearer token of the upgrade request or, within a few seconds, with an \"auth\" message whose payload is\n{\"token\"}; sending \"auth\" again with a fresh token keeps the connection open. Commands \"start\", \"pause\",\n\"resume\" and \"stop\" take the payloads of the matching REST endpoints and \"state\" requests the current\nstate. The server replies with a \"state\" message holding the active session and the server time, or an\n\"error\" message with a code, and pushes the state whenever the session changes on any device. The socket\nis closed with code 4001 when the token expires and 4002 when no valid token is sent.",
+                "tags": [
+                    "study-session"
+                ],
+                "summary": "Live timer control channel",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Bearer token, can be sent in an auth message instead",
+                        "name": "Authorization",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "101": {
+                        "description": "Switching Protocols"
+                    },
+                    "400": {
+                        "description": "Not a WebSocket handshake",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "auth.Account": {
+            "type": "object",
+            "properties": {
+                "roles": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "auth.CreateSessionRequest": {
+            "type": "object",
+            "required": [
+                "password",
+                "username"
+            ],
+            "properties": {
+                "password": {
+                    "type": "string"
+                },
+                "username": {
+                    "type": "string"
+                }
+            }
+        },
+        "auth.FinishSessionRequest": {
+            "type": "object",
+            "required": [
+                "access_token"
+            ],
+            "properties": {
+                "access_token": {
+                    "type": "string"
+                }
+            }
+        },
+        "auth.FinishSessionResponse": {
+            "type": "object"
+        },
+        "auth.ResourceAccess": {
+            "type": "object",
+            "properties": {
+                "account": {
+                    "$ref": "#/definitions/auth.Account"
+                }
+            }
+        },
+        "auth.SessionInfo": {
+            "type": "object",
+            "properties": {
+                "access_token": {
+                    "type": "string"
+                },
+                "expires_in": {
+                    "type": "integer"
+                },
+                "refresh_expires_in": {
+                    "type": "integer"
+                },
+                "refresh_token": {
+                    "type": "string"
+                },
+                "scope": {
+                    "type": "string"
+                },
+                "session_state": {
+                    "type": "string"
+                },
+                "token_type": {
+                    "type": "string"
+                }
+            }
+        },
+        "auth.UpdateSessionRequest": {
+            "type": "object",
+            "required": [
+                "refresh_token"
+            ],
+            "properties": {
+                "refresh_token": {
+                    "type": "string"
+                }
+            }
+        },
+        "auth.UserInfo": {
+            "type": "object",
+            "properties": {
+                "email": {
+                    "type": "string"
+                },
+                "email_verified": {
+                    "type": "boolean"
+                },
+                "family_name": {
+                    "type": "string"
+                },
+                "given_name": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "preferred_username": {
+                    "type": "string"
+                },
+                "resource_access": {
+                    "$ref": "#/definitions/auth.ResourceAccess"
+                },
+                "username": {
+                    "type": "string"
+                },
+                "uuid": {
+                    "type": "string"
+                }
+            }
+        },
+        "feeds.CreateFeedTokenRequest": {
+            "type": "object",
+            "properties": {
+                "name": {
+                    "description": "Name helps the user recognize the token, e.g. the calendar app using it",
+                    "type": "string"
+                }
+            }
+        },
+        "feeds.CreatedFeedToken": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "last_used_at": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "revoked_at": {
+                    "type": "string"
+                },
+                "token": {
+                    "type": "string"
+                },
+                "url": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "feeds.FeedToken": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "last_used_at": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "revoked_at": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "healthcheck.Status": {
+            "type": "object",
+            "properties": {
+                "online_time": {
+                    "type": "string"
+                }
+            }
+        },
+        "notifications.Notification": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "session_id": {
+                    "type": "string"
+                },
+                "type": {
+                    "$ref": "#/definitions/notifications.NotificationType"
+                }
+            }
+        },
+        "notifications.NotificationType": {
+            "type": "string",
+            "enum": [
+                "session.started",
+                "session.paused",
+                "session.resumed",
+                "session.finished",
+                "session.canceled",
+                "session.updated",
+                "session.created",
+                "session.deleted",
+                "session.restored"
+            ],
+            "x-enum-varnames": [
+                "NotificationTypeStarted",
+                "NotificationTypePaused",
+                "NotificationTypeResumed",
+                "NotificationTypeFinished",
+                "NotificationTypeCanceled",
+                "NotificationTypeUpdated",
+                "NotificationTypeCreated",
+                "NotificationTypeDeleted",
+                "NotificationTypeRestored"
+            ]
+        },
+        "stats.Granularity": {
+            "type": "string",
+            "enum": [
+                "day",
+                "week",
+                "month"
+            ],
+            "x-enum-varnames": [
+                "GranularityDay",
+                "GranularityWeek",
+                "GranularityMonth"
+            ]
+        },
+        "stats.Heatmap": {
+            "type": "object",
+            "properties": {
+                "days": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/stats.HeatmapDay"
+                    }
+                },
+                "thresholds": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "timezone": {
+                    "type": "string"
+                },
+                "year": {
+                    "type": "integer"
+                }
+            }
+        },
+        "stats.HeatmapDay": {
+            "type": "object",
+            "properties": {
+                "date": {
+                    "type": "string"
+                },
+                "focused_minutes": {
+                    "type": "integer"
+                },
+                "level": {
+                    "type": "integer"
+                }
+            }
+        },
+        "stats.StatsBucket": {
+            "type": "object",
+            "properties": {
+                "average_session_seconds": {
+                    "type": "integer"
+                },
+                "focused_seconds": {
+                    "type": "integer"
+                },
+                "longest_session_seconds": {
+                    "type": "integer"
+                },
+                "session_count": {
+                    "type": "integer"
+                },
+                "start": {
+                    "type": "string"
+                }
+            }
+        },
+        "stats.Streak": {
+            "type": "object",
+            "properties": {
+                "current_streak": {
+                    "type": "integer"
+                },
+                "daily_goal_minutes": {
+                    "type": "integer"
+                },
+                "freezes_available": {
+                    "type": "integer"
+                },
+                "freezes_used": {
+                    "type": "integer"
+                },
+                "goal_met_today": {
+                    "type": "boolean"
+                },
+                "longest_streak": {
+                    "type": "integer"
+                },
+                "timezone": {
+                    "type": "string"
+                },
+                "today_focused_seconds": {
+                    "type": "integer"
+                }
+            }
+        },
+        "stats.StreakSettings": {
+            "type": "object",
+            "properties": {
+                "daily_goal_minutes": {
+                    "type": "integer"
+                },
+                "timezone": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "stats.StreakUpdate": {
+            "type": "object",
+            "properties": {
+                "current_streak": {
+                    "type": "integer"
+                },
+                "freeze_earned": {
+                    "type": "boolean"
+                },
+                "freezes_available": {
+                    "type": "integer"
+                },
+                "goal_reached": {
+                    "type": "boolean"
+                },
+                "longest_streak": {
+                    "type": "integer"
+                },
+                "new_record": {
+                    "type": "boolean"
+                },
+                "previous_streak": {
+                    "type": "integer"
+                }
+            }
+        },
+        "stats.StudyStats": {
+            "type": "object",
+            "properties": {
+                "buckets": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/stats.StatsBucket"
+                    }
+                },
+                "granularity": {
+                    "$ref": "#/definitions/stats.Granularity"
+                },
+                "timezone": {
+                    "type": "string"
+                }
+            }
+        },
+        "stats.UpdateStreakSettingsRequest": {
+            "type": "object",
+            "properties": {
+                "daily_goal_minutes": {
+                    "description": "DailyGoalMinutes is the focused time needed for a day to count, 1 to 1440",
+                    "type": "integer"
+                },
+                "timezone": {
+                    "description": "Timezone is the IANA name of the timezone the days are counted in",
+                    "type": "string"
+                }
+            }
+        },
+        "studysession.AddStudySessionEventsRequest": {
+            "type": "object",
+            "properties": {
+                "events": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/studysession.SessionEvent"
+                    }
+                }
+            }
+        },
+        "studysession.CreateManualStudySessionRequest": {
+            "type": "object",
+            "properties": {
+                "notes": {
+                    "type": "string"
+                },
+                "pauses": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/studysession.PauseInterval"
+                    }
+                },
+                "started_at": {
+                    "type": "string"
+                },
+                "stopped_at": {
+                    "type": "string"
+                },
+                "subjects": {
+                    "description": "Subjects optionally links the session to the user's subjects",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/studysession.SessionSubject"
+                    }
+                },
+                "title": {
+                    "type": "string"
+                }
+            }
+        },
+        "studysession.EventType": {
+            "type": "string",
+            "enum": [
+                "start",
+                "pause",
+                "resume",
+                "stop",
+                "work_phase",
+                "short_break",
+                "long_break"
+            ],
+            "x-enum-varnames": [
+                "EventTypeStart",
+                "EventTypePause",
+                "EventTypeResume",
+                "EventTypeStop",
+                "EventTypeWorkPhase",
+                "EventTypeShortBreak",
+                "EventTypeLongBreak"
+            ]
+        },
+        "studysession.FinishStudySessionRequest": {
+            "type": "object",
+            "properties": {
+                "final_state": {
+                    "description": "FinalState \"paused\" stops a paused session at the time it was paused,\nit can't be combined with FinishedAt",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/studysession.TimerStatus"
+                        }
+                    ]
+                },
+                "finished_at": {
+                    "description": "FinishedAt defaults to the current time",
+                    "type": "string"
+                }
+            }
+        },
+        "studysession.FinishStudySessionResponse": {
+            "type": "object",
+            "properties": {
+                "auto_closed_at": {
+                    "description": "AutoClosedAt is set when the session was finished or paused because\nits timer was left running, so the user can review it",
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "date": {
+                    "type": "string"
+                },
+                "deleted_at": {
+                    "type": "string"
+                },
+                "durations": {
+                    "$ref": "#/definitions/studysession.SessionDurations"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "notes": {
+                    "type": "string"
+                },
+                "pomodoro": {
+                    "$ref": "#/definitions/studysession.Pomodoro"
+                },
+                "session_state": {
+                    "$ref": "#/definitions/studysession.SessionState"
+                },
+                "streak": {
+                    "$ref": "#/definitions/stats.StreakUpdate"
+                },
+                "subjects": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/studysession.SessionSubject"
+                    }
+                },
+                "title": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "studysession.ImportReport": {
+            "type": "object",
+            "properties": {
+                "dry_run": {
+                    "type": "boolean"
+                },
+                "duplicates": {
+                    "type": "integer"
+                },
+                "imported": {
+                    "type": "integer"
+                },
+                "invalid": {
+                    "type": "integer"
+                },
+                "rows": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/studysession.ImportRowResult"
+                    }
+                },
+                "valid": {
+                    "type": "integer"
+                }
+            }
+        },
+        "studysession.ImportRowResult": {
+            "type": "object",
+            "properties": {
+                "errors": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "row": {
+                    "type": "integer"
+                },
+                "started_at": {
+                    "type": "string"
+                },
+                "status": {
+                    "$ref": "#/definitions/studysession.ImportRowStatus"
+                },
+                "stopped_at": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                }
+            }
+        },
+        "studysession.ImportRowStatus": {
+            "type": "string",
+            "enum": [
+                "valid",
+                "imported",
+                "duplicate",
+                "invalid"
+            ],
+            "x-enum-varnames": [
+                "ImportRowStatusValid",
+                "ImportRowStatusImported",
+                "ImportRowStatusDuplicate",
+                "ImportRowStatusInvalid"
+            ]
+        },
+        "studysession.PauseInterval": {
+            "type": "object",
+            "properties": {
+                "end": {
+                    "type": "string"
+                },
+                "start": {
+                    "type": "string"
+                }
+            }
+        },
+        "studysession.Pomodoro": {
+            "type": "object",
+            "properties": {
+                "completed_pomodoros": {
+                    "type": "integer"
+                },
+                "phase": {
+                    "$ref": "#/definitions/studysession.PomodoroPhase"
+                },
+                "phase_ends_at": {
+                    "type": "string"
+                },
+                "remaining_seconds": {
+                    "type": "integer"
+                },
+                "settings": {
+                    "$ref": "#/definitions/studysession.PomodoroSettings"
+                }
+            }
+        },
+        "studysession.PomodoroPhase": {
+            "type": "string",
+            "enum": [
+                "work",
+                "short_break",
+                "long_break"
+            ],
+            "x-enum-varnames": [
+                "PomodoroPhaseWork",
+                "PomodoroPhaseShortBreak",
+                "PomodoroPhaseLongBreak"
+            ]
+        },
+        "studysession.PomodoroSettings": {
+            "type": "object",
+            "properties": {
+                "cycles": {
+                    "type": "integer"
+                },
+                "long_break_minutes": {
+                    "type": "integer"
+                },
+                "short_break_minutes": {
+                    "type": "integer"
+                },
+                "work_minutes": {
+                    "type": "integer"
+                }
+            }
+        },
+        "studysession.SessionDurations": {
+            "type": "object",
+            "properties": {
+                "focused_seconds": {
+                    "type": "integer"
+                },
+                "pause_count": {
+                    "type": "integer"
+                },
+                "paused_seconds": {
+                    "type": "integer"
+                },
+                "total_seconds": {
+                    "type": "integer"
+                }
+            }
+        },
+        "studysession.SessionEvent": {
+            "type": "object",
+            "properties": {
+                "device_id": {
+                    "description": "DeviceID identifies the client device that recorded the event",
+                    "type": "string"
+                },
+                "event_time": {
+                    "type": "string"
+                },
+                "event_type": {
+                    "$ref": "#/definitions/studysession.EventType"
+                },
+                "id": {
+                    "description": "ID is generated by offline capable clients so that replaying an event\nrecords it once, the server generates it when it's missing",
+                    "type": "string"
+                }
+            }
+        },
+        "studysession.SessionState": {
+            "type": "string",
+            "enum": [
+                "active",
+                "completed",
+                "abandoned"
+            ],
+            "x-enum-varnames": [
+                "SessionStateActive",
+                "SessionStateCompleted",
+                "SessionStateAbandoned"
+            ]
+        },
+        "studysession.SessionSubject": {
+            "type": "object",
+            "properties": {
+                "percentage": {
+                    "type": "integer"
+                },
+                "subject_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "studysession.StudySession": {
+            "type": "object",
+            "properties": {
+                "auto_closed_at": {
+                    "description": "AutoClosedAt is set when the session was finished or paused because\nits timer was left running, so the user can review it",
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "date": {
+                    "type": "string"
+                },
+                "deleted_at": {
+                    "type": "string"
+                },
+                "durations": {
+                    "$ref": "#/definitions/studysession.SessionDurations"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "notes": {
+                    "type": "string"
+                },
+                "pomodoro": {
+                    "$ref": "#/definitions/studysession.Pomodoro"
+                },
+                "session_state": {
+                    "$ref": "#/definitions/studysession.SessionState"
+                },
+                "subjects": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/studysession.SessionSubject"
+                    }
+                },
+                "title": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "studysession.StudySessionDetails": {
+            "type": "object",
+            "properties": {
+                "auto_closed_at": {
+                    "description": "AutoClosedAt is set when the session was finished or paused because\nits timer was left running, so the user can review it",
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "date": {
+                    "type": "string"
+                },
+                "deleted_at": {
+                    "type": "string"
+                },
+                "durations": {
+                    "$ref": "#/definitions/studysession.SessionDurations"
+                },
+                "events": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/studysession.SessionEvent"
+                    }
+                },
+                "id": {
+                    "type": "string"
+                },
+                "notes": {
+                    "type": "string"
+                },
+                "pomodoro": {
+                    "$ref": "#/definitions/studysession.Pomodoro"
+                },
+                "session_state": {
+                    "$ref": "#/definitions/studysession.SessionState"
+                },
+                "subjects": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/studysession.SessionSubject"
+                    }
+                },
+                "title": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "studysession.StudySessionPage": {
             "type": "object",
             "properties": {
-                "roles": {
+                "next_cursor": {
+                    "type": "string"
+                },
+                "sessions": {
                     "type": "array",
                     "items": {
-                        "type": "string"
+                        "$ref": "#/definitions/studysession.StudySession"
                     }
                 }
             }
         },
-        "auth.CreateSessionRequest": {
+        "studysession.SubjectTime": {
             "type": "object",
-            "required": [
-                "password",
-                "username"
-            ],
             "properties": {
-                "password": {
-                    "type": "string"
+                "focused_seconds": {
+                    "type": "integer"
                 },
-                "username": {
+                "session_count": {
+                    "type": "integer"
+                },
+                "subject_id": {
                     "type": "string"
                 }
             }
         },
-        "auth.FinishSessionRequest": {
+        "studysession.SubjectTimeReport": {
             "type": "object",
-            "required": [
-                "access_token"
-            ],
             "properties": {
-                "access_token": {
-                    "type": "string"
+                "subjects": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/studysession.SubjectTime"
+                    }
+                },
+                "unassigned_seconds": {
+                    "type": "integer"
                 }
             }
         },
-        "auth.FinishSessionResponse": {
-            "type": "object"
+        "studysession.TimerStatus": {
+            "type": "string",
+            "enum": [
+                "not_started",
+                "running",
+                "paused",
+                "stopped"
+            ],
+            "x-enum-varnames": [
+                "TimerStatusNotStarted",
+                "TimerStatusRunning",
+                "TimerStatusPaused",
+                "TimerStatusStopped"
+            ]
         },
-        "auth.ResourceAccess": {
+        "studysession.UpdateStudySessionRequest": {
             "type": "object",
             "properties": {
-                "account": {
-                    "$ref": "#/definitions/auth.Account"
+                "notes": {
+                    "type": "string"
+                },
+                "subjects": {
+                    "description": "Subjects replaces the session subjects when present",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/studysession.SessionSubject"
+                    }
+                },
+                "title": {
+                    "type": "string"
                 }
             }
         },
-        "auth.SessionInfo": {
+        "studysession.UpsertActiveStudySessionRequest": {
             "type": "object",
             "properties": {
-                "access_token": {
+                "notes": {
                     "type": "string"
                 },
-                "expires_in": {
-                    "type": "integer"
+                "pomodoro": {
+                    "description": "Pomodoro turns on pomodoro mode, the server then tracks the work and\nbreak phases of the session",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/studysession.PomodoroSettings"
+                        }
+                    ]
                 },
-                "refresh_expires_in": {
-                    "type": "integer"
+                "started_at": {
+                    "type": "string"
                 },
-                "refresh_token": {
+                "subjects": {
+                    "description": "Subjects optionally links the session to the user's subjects",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/studysession.SessionSubject"
+                    }
+                },
+                "title": {
+                    "type": "string"
+                }
+            }
+        },
+        "subjects.CreateRelationRequest": {
+            "type": "object",
+            "properties": {
+                "from_subject_id": {
                     "type": "string"
                 },
-                "scope": {
+                "relation_type": {
+                    "$ref": "#/definitions/subjects.RelationType"
+                },
+                "to_subject_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "subjects.CreateSubjectRequest": {
+            "type": "object",
+            "properties": {
+                "color": {
                     "type": "string"
                 },
-                "session_state": {
+                "icon": {
                     "type": "string"
                 },
-                "token_type": {
+                "name": {
                     "type": "string"
                 }
             }
         },
-        "auth.UpdateSessionRequest": {
-            "type": "object",
-            "required": [
-                "refresh_token"
+        "subjects.RelationType": {
+            "type": "string",
+            "enum": [
+                "parent",
+                "prerequisite"
             ],
+            "x-enum-varnames": [
+                "RelationTypeParent",
+                "RelationTypePrerequisite"
+            ]
+        },
+        "subjects.Subject": {
+            "type": "object",
             "properties": {
-                "refresh_token": {
+                "archived_at": {
+                    "type": "string"
+                },
+                "color": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "icon": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "user_id": {
                     "type": "string"
                 }
             }
         },
-        "auth.UserInfo": {
+        "subjects.SubjectNode": {
             "type": "object",
             "properties": {
-                "email": {
+                "archived_at": {
                     "type": "string"
                 },
-                "email_verified": {
-                    "type": "boolean"
+                "children": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/subjects.SubjectNode"
+                    }
                 },
-                "family_name": {
+                "color": {
                     "type": "string"
                 },
-                "given_name": {
+                "created_at": {
+                    "type": "string"
+                },
+                "icon": {
+                    "type": "string"
+                },
+                "id": {
                     "type": "string"
                 },
                 "name": {
                     "type": "string"
                 },
-                "preferred_username": {
+                "updated_at": {
                     "type": "string"
                 },
-                "resource_access": {
-                    "$ref": "#/definitions/auth.ResourceAccess"
+                "user_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "subjects.SubjectRelation": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
                 },
-                "username": {
+                "from_subject_id": {
                     "type": "string"
                 },
-                "uuid": {
+                "id": {
+                    "type": "string"
+                },
+                "relation_type": {
+                    "$ref": "#/definitions/subjects.RelationType"
+                },
+                "to_subject_id": {
+                    "type": "string"
+                },
+                "user_id": {
                     "type": "string"
                 }
             }
         },
-        "healthcheck.Status": {
+        "subjects.UpdateSubjectRequest": {
             "type": "object",
             "properties": {
-                "online_time": {
+                "color": {
+                    "type": "string"
+                },
+                "icon": {
+                    "type": "string"
+                },
+                "name": {
                     "type": "string"
                 }
             }
         },
-        "studysession.AddStudySessionEventsRequest": {
+        "webhooks.CreateEndpointRequest": {
             "type": "object",
             "properties": {
-                "events": {
+                "description": {
+                    "type": "string"
+                },
+                "event_types": {
+                    "description": "EventTypes are the events sent to the endpoint, at least one",
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/studysession.SessionEvent"
+                        "$ref": "#/definitions/webhooks.EventType"
                     }
+                },
+                "url": {
+                    "description": "URL receives the events, it must use https",
+                    "type": "string"
                 }
             }
         },
-        "studysession.EventType": {
-            "type": "string",
-            "enum": [
-                "start",
-                "pause",
-                "resume",
-                "stop"
-            ],
-            "x-enum-varnames": [
-                "EventTypeStart",
-                "EventTypePause",
-                "EventTypeResume",
-                "EventTypeStop"
-            ]
-        },
-        "studysession.FinishStudySessionRequest": {
+        "webhooks.CreatedEndpoint": {
             "type": "object",
             "properties": {
-                "finished_at": {
+                "active": {
+                    "type": "boolean"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "event_types": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/webhooks.EventType"
+                    }
+                },
+                "id": {
+                    "type": "string"
+                },
+                "secret": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "url": {
+                    "type": "string"
+                },
+                "user_id": {
                     "type": "string"
                 }
             }
         },
-        "studysession.SessionEvent": {
+        "webhooks.Delivery": {
             "type": "object",
             "properties": {
-                "event_time": {
+                "attempts": {
+                    "type": "integer"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "endpoint_id": {
+                    "type": "string"
+                },
+                "event_id": {
                     "type": "string"
                 },
                 "event_type": {
-                    "$ref": "#/definitions/studysession.EventType"
+                    "$ref": "#/definitions/webhooks.EventType"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "last_attempt_at": {
+                    "type": "string"
+                },
+                "last_error": {
+                    "type": "string"
+                },
+                "next_attempt_at": {
+                    "type": "string"
+                },
+                "response_code": {
+                    "description": "ResponseCode is the HTTP status of the last attempt, it's nil when\nthe endpoint couldn't be reached",
+                    "type": "integer"
+                },
+                "status": {
+                    "$ref": "#/definitions/webhooks.DeliveryStatus"
                 }
             }
         },
-        "studysession.SessionState": {
+        "webhooks.DeliveryStatus": {
             "type": "string",
             "enum": [
-                "active",
-                "completed"
+                "pending",
+                "succeeded",
+                "failed"
             ],
             "x-enum-varnames": [
-                "SessionStateActive",
-                "SessionStateCompleted"
+                "DeliveryStatusPending",
+                "DeliveryStatusSucceeded",
+                "DeliveryStatusFailed"
             ]
         },
-        "studysession.StudySession": {
+        "webhooks.Endpoint": {
             "type": "object",
             "properties": {
-                "date": {
+                "active": {
+                    "type": "boolean"
+                },
+                "created_at": {
                     "type": "string"
                 },
-                "id": {
+                "description": {
                     "type": "string"
                 },
-                "notes": {
+                "event_types": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/webhooks.EventType"
+                    }
+                },
+                "id": {
                     "type": "string"
                 },
-                "session_state": {
-                    "$ref": "#/definitions/studysession.SessionState"
+                "updated_at": {
+                    "type": "string"
                 },
-                "title": {
+                "url": {
                     "type": "string"
                 },
                 "user_id": {
@@ -748,16 +4308,49 @@ const docTemplate = `{
                 }
             }
         },
-        "studysession.UpsertActiveStudySessionRequest": {
+        "webhooks.EventType": {
+            "type": "string",
+            "enum": [
+                "session.started",
+                "session.paused",
+                "session.resumed",
+                "session.finished",
+                "session.canceled",
+                "session.updated",
+                "session.created",
+                "session.deleted",
+                "session.restored"
+            ],
+            "x-enum-varnames": [
+                "EventTypeStarted",
+                "EventTypePaused",
+                "EventTypeResumed",
+                "EventTypeFinished",
+                "EventTypeCanceled",
+                "EventTypeUpdated",
+                "EventTypeCreated",
+                "EventTypeDeleted",
+                "EventTypeRestored"
+            ]
+        },
+        "webhooks.UpdateEndpointRequest": {
             "type": "object",
             "properties": {
-                "notes": {
-                    "type": "string"
+                "active": {
+                    "description": "Active pauses or resumes the deliveries to the endpoint",
+                    "type": "boolean"
                 },
-                "started_at": {
+                "description": {
                     "type": "string"
                 },
-                "title": {
+                "event_types": {
+                    "description": "EventTypes replaces the events sent to the endpoint when present",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/webhooks.EventType"
+                    }
+                },
+                "url": {
                     "type": "string"
                 }
             }
@@ -6,6 +6,10 @@ import (
 	context "context"
 
 	mock "github.com/stretchr/testify/mock"
+
+	sql "database/sql"
+
+	sqlx "github.com/jmoiron/sqlx"
 )
 
 // PostgresClient is an autogenerated mock type for the PostgresClient type
@@ -21,6 +25,135 @@ func (_m *PostgresClient) EXPECT() *PostgresClient_Expecter {
 	return &PostgresClient_Expecter{mock: &_m.Mock}
 }
 
+// BeginTransaction provides a mock function with given fields: ctx, opts
+func (_m *PostgresClient) BeginTransaction(ctx context.Context, opts *sql.TxOptions) (*sqlx.Tx, error) {
+	ret := _m.Called(ctx, opts)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BeginTransaction")
+	}
+
+	var r0 *sqlx.Tx
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *sql.TxOptions) (*sqlx.Tx, error)); ok {
+		return rf(ctx, opts)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *sql.TxOptions) *sqlx.Tx); ok {
+		r0 = rf(ctx, opts)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*sqlx.Tx)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *sql.TxOptions) error); ok {
+		r1 = rf(ctx, opts)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PostgresClient_BeginTransaction_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BeginTransaction'
+type PostgresClient_BeginTransaction_Call struct {
+	*mock.Call
+}
+
+// BeginTransaction is a helper method to define mock.On call
+//   - ctx context.Context
+//   - opts *sql.TxOptions
+func (_e *PostgresClient_Expecter) BeginTransaction(ctx interface{}, opts interface{}) *PostgresClient_BeginTransaction_Call {
+	return &PostgresClient_BeginTransaction_Call{Call: _e.mock.On("BeginTransaction", ctx, opts)}
+}
+
+func (_c *PostgresClient_BeginTransaction_Call) Run(run func(ctx context.Context, opts *sql.TxOptions)) *PostgresClient_BeginTransaction_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*sql.TxOptions))
+	})
+	return _c
+}
+
+func (_c *PostgresClient_BeginTransaction_Call) Return(_a0 *sqlx.Tx, _a1 error) *PostgresClient_BeginTransaction_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PostgresClient_BeginTransaction_Call) RunAndReturn(run func(context.Context, *sql.TxOptions) (*sqlx.Tx, error)) *PostgresClient_BeginTransaction_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// QueryRows provides a mock function with given fields: ctx, sqlQuery, args
+func (_m *PostgresClient) QueryRows(ctx context.Context, sqlQuery string, args ...interface{}) (*sqlx.Rows, error) {
+	var _ca []interface{}
+	_ca = append(_ca, ctx, sqlQuery)
+	_ca = append(_ca, args...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for QueryRows")
+	}
+
+	var r0 *sqlx.Rows
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, ...interface{}) (*sqlx.Rows, error)); ok {
+		return rf(ctx, sqlQuery, args...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, ...interface{}) *sqlx.Rows); ok {
+		r0 = rf(ctx, sqlQuery, args...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*sqlx.Rows)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, ...interface{}) error); ok {
+		r1 = rf(ctx, sqlQuery, args...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PostgresClient_QueryRows_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'QueryRows'
+type PostgresClient_QueryRows_Call struct {
+	*mock.Call
+}
+
+// QueryRows is a helper method to define mock.On call
+//   - ctx context.Context
+//   - sqlQuery string
+//   - args ...interface{}
+func (_e *PostgresClient_Expecter) QueryRows(ctx interface{}, sqlQuery interface{}, args ...interface{}) *PostgresClient_QueryRows_Call {
+	return &PostgresClient_QueryRows_Call{Call: _e.mock.On("QueryRows",
+		append([]interface{}{ctx, sqlQuery}, args...)...)}
+}
+
+func (_c *PostgresClient_QueryRows_Call) Run(run func(ctx context.Context, sqlQuery string, args ...interface{})) *PostgresClient_QueryRows_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]interface{}, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(interface{})
+			}
+		}
+		run(args[0].(context.Context), args[1].(string), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *PostgresClient_QueryRows_Call) Return(_a0 *sqlx.Rows, _a1 error) *PostgresClient_QueryRows_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PostgresClient_QueryRows_Call) RunAndReturn(run func(context.Context, string, ...interface{}) (*sqlx.Rows, error)) *PostgresClient_QueryRows_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // QuerySelect provides a mock function with given fields: ctx, result, sqlQuery, args
 func (_m *PostgresClient) QuerySelect(ctx context.Context, result interface{}, sqlQuery string, args ...interface{}) error {
 	var _ca []interface{}
@@ -0,0 +1,84 @@
+// Code generated by mockery v2.53.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// PostgresListener is an autogenerated mock type for the PostgresListener type
+type PostgresListener struct {
+	mock.Mock
+}
+
+type PostgresListener_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *PostgresListener) EXPECT() *PostgresListener_Expecter {
+	return &PostgresListener_Expecter{mock: &_m.Mock}
+}
+
+// Listen provides a mock function with given fields: ctx, channel, handle
+func (_m *PostgresListener) Listen(ctx context.Context, channel string, handle func(string)) error {
+	ret := _m.Called(ctx, channel, handle)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Listen")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, func(string)) error); ok {
+		r0 = rf(ctx, channel, handle)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// PostgresListener_Listen_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Listen'
+type PostgresListener_Listen_Call struct {
+	*mock.Call
+}
+
+// Listen is a helper method to define mock.On call
+//   - ctx context.Context
+//   - channel string
+//   - handle func(string)
+func (_e *PostgresListener_Expecter) Listen(ctx interface{}, channel interface{}, handle interface{}) *PostgresListener_Listen_Call {
+	return &PostgresListener_Listen_Call{Call: _e.mock.On("Listen", ctx, channel, handle)}
+}
+
+func (_c *PostgresListener_Listen_Call) Run(run func(ctx context.Context, channel string, handle func(string))) *PostgresListener_Listen_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(func(string)))
+	})
+	return _c
+}
+
+func (_c *PostgresListener_Listen_Call) Return(_a0 error) *PostgresListener_Listen_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *PostgresListener_Listen_Call) RunAndReturn(run func(context.Context, string, func(string)) error) *PostgresListener_Listen_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewPostgresListener creates a new instance of PostgresListener. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewPostgresListener(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *PostgresListener {
+	mock := &PostgresListener{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,96 @@
+// Code generated by mockery v2.53.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	webhook "go-api/src/clients/webhook"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// WebhookClient is an autogenerated mock type for the WebhookClient type
+type WebhookClient struct {
+	mock.Mock
+}
+
+type WebhookClient_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *WebhookClient) EXPECT() *WebhookClient_Expecter {
+	return &WebhookClient_Expecter{mock: &_m.Mock}
+}
+
+// Send provides a mock function with given fields: ctx, request
+func (_m *WebhookClient) Send(ctx context.Context, request webhook.SendWebhookRequest) (*webhook.SendWebhookResponse, error) {
+	ret := _m.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Send")
+	}
+
+	var r0 *webhook.SendWebhookResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, webhook.SendWebhookRequest) (*webhook.SendWebhookResponse, error)); ok {
+		return rf(ctx, request)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, webhook.SendWebhookRequest) *webhook.SendWebhookResponse); ok {
+		r0 = rf(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*webhook.SendWebhookResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, webhook.SendWebhookRequest) error); ok {
+		r1 = rf(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// WebhookClient_Send_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Send'
+type WebhookClient_Send_Call struct {
+	*mock.Call
+}
+
+// Send is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request webhook.SendWebhookRequest
+func (_e *WebhookClient_Expecter) Send(ctx interface{}, request interface{}) *WebhookClient_Send_Call {
+	return &WebhookClient_Send_Call{Call: _e.mock.On("Send", ctx, request)}
+}
+
+func (_c *WebhookClient_Send_Call) Run(run func(ctx context.Context, request webhook.SendWebhookRequest)) *WebhookClient_Send_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(webhook.SendWebhookRequest))
+	})
+	return _c
+}
+
+func (_c *WebhookClient_Send_Call) Return(_a0 *webhook.SendWebhookResponse, _a1 error) *WebhookClient_Send_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *WebhookClient_Send_Call) RunAndReturn(run func(context.Context, webhook.SendWebhookRequest) (*webhook.SendWebhookResponse, error)) *WebhookClient_Send_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewWebhookClient creates a new instance of WebhookClient. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewWebhookClient(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *WebhookClient {
+	mock := &WebhookClient{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,81 @@
+// Code generated by mockery v2.53.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	echo "github.com/labstack/echo/v4"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ControlChannelHandler is an autogenerated mock type for the ControlChannelHandler type
+type ControlChannelHandler struct {
+	mock.Mock
+}
+
+type ControlChannelHandler_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *ControlChannelHandler) EXPECT() *ControlChannelHandler_Expecter {
+	return &ControlChannelHandler_Expecter{mock: &_m.Mock}
+}
+
+// Connect provides a mock function with given fields: e
+func (_m *ControlChannelHandler) Connect(e echo.Context) error {
+	ret := _m.Called(e)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Connect")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(echo.Context) error); ok {
+		r0 = rf(e)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ControlChannelHandler_Connect_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Connect'
+type ControlChannelHandler_Connect_Call struct {
+	*mock.Call
+}
+
+// Connect is a helper method to define mock.On call
+//   - e echo.Context
+func (_e *ControlChannelHandler_Expecter) Connect(e interface{}) *ControlChannelHandler_Connect_Call {
+	return &ControlChannelHandler_Connect_Call{Call: _e.mock.On("Connect", e)}
+}
+
+func (_c *ControlChannelHandler_Connect_Call) Run(run func(e echo.Context)) *ControlChannelHandler_Connect_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(echo.Context))
+	})
+	return _c
+}
+
+func (_c *ControlChannelHandler_Connect_Call) Return(_a0 error) *ControlChannelHandler_Connect_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ControlChannelHandler_Connect_Call) RunAndReturn(run func(echo.Context) error) *ControlChannelHandler_Connect_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewControlChannelHandler creates a new instance of ControlChannelHandler. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewControlChannelHandler(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ControlChannelHandler {
+	mock := &ControlChannelHandler{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,220 @@
+// Code generated by mockery v2.53.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	echo "github.com/labstack/echo/v4"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// FeedHandler is an autogenerated mock type for the FeedHandler type
+type FeedHandler struct {
+	mock.Mock
+}
+
+type FeedHandler_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *FeedHandler) EXPECT() *FeedHandler_Expecter {
+	return &FeedHandler_Expecter{mock: &_m.Mock}
+}
+
+// CreateFeedToken provides a mock function with given fields: e
+func (_m *FeedHandler) CreateFeedToken(e echo.Context) error {
+	ret := _m.Called(e)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateFeedToken")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(echo.Context) error); ok {
+		r0 = rf(e)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FeedHandler_CreateFeedToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateFeedToken'
+type FeedHandler_CreateFeedToken_Call struct {
+	*mock.Call
+}
+
+// CreateFeedToken is a helper method to define mock.On call
+//   - e echo.Context
+func (_e *FeedHandler_Expecter) CreateFeedToken(e interface{}) *FeedHandler_CreateFeedToken_Call {
+	return &FeedHandler_CreateFeedToken_Call{Call: _e.mock.On("CreateFeedToken", e)}
+}
+
+func (_c *FeedHandler_CreateFeedToken_Call) Run(run func(e echo.Context)) *FeedHandler_CreateFeedToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(echo.Context))
+	})
+	return _c
+}
+
+func (_c *FeedHandler_CreateFeedToken_Call) Return(_a0 error) *FeedHandler_CreateFeedToken_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *FeedHandler_CreateFeedToken_Call) RunAndReturn(run func(echo.Context) error) *FeedHandler_CreateFeedToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetCalendarFeed provides a mock function with given fields: e
+func (_m *FeedHandler) GetCalendarFeed(e echo.Context) error {
+	ret := _m.Called(e)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCalendarFeed")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(echo.Context) error); ok {
+		r0 = rf(e)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FeedHandler_GetCalendarFeed_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCalendarFeed'
+type FeedHandler_GetCalendarFeed_Call struct {
+	*mock.Call
+}
+
+// GetCalendarFeed is a helper method to define mock.On call
+//   - e echo.Context
+func (_e *FeedHandler_Expecter) GetCalendarFeed(e interface{}) *FeedHandler_GetCalendarFeed_Call {
+	return &FeedHandler_GetCalendarFeed_Call{Call: _e.mock.On("GetCalendarFeed", e)}
+}
+
+func (_c *FeedHandler_GetCalendarFeed_Call) Run(run func(e echo.Context)) *FeedHandler_GetCalendarFeed_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(echo.Context))
+	})
+	return _c
+}
+
+func (_c *FeedHandler_GetCalendarFeed_Call) Return(_a0 error) *FeedHandler_GetCalendarFeed_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *FeedHandler_GetCalendarFeed_Call) RunAndReturn(run func(echo.Context) error) *FeedHandler_GetCalendarFeed_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListFeedTokens provides a mock function with given fields: e
+func (_m *FeedHandler) ListFeedTokens(e echo.Context) error {
+	ret := _m.Called(e)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListFeedTokens")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(echo.Context) error); ok {
+		r0 = rf(e)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FeedHandler_ListFeedTokens_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListFeedTokens'
+type FeedHandler_ListFeedTokens_Call struct {
+	*mock.Call
+}
+
+// ListFeedTokens is a helper method to define mock.On call
+//   - e echo.Context
+func (_e *FeedHandler_Expecter) ListFeedTokens(e interface{}) *FeedHandler_ListFeedTokens_Call {
+	return &FeedHandler_ListFeedTokens_Call{Call: _e.mock.On("ListFeedTokens", e)}
+}
+
+func (_c *FeedHandler_ListFeedTokens_Call) Run(run func(e echo.Context)) *FeedHandler_ListFeedTokens_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(echo.Context))
+	})
+	return _c
+}
+
+func (_c *FeedHandler_ListFeedTokens_Call) Return(_a0 error) *FeedHandler_ListFeedTokens_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *FeedHandler_ListFeedTokens_Call) RunAndReturn(run func(echo.Context) error) *FeedHandler_ListFeedTokens_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RevokeFeedToken provides a mock function with given fields: e
+func (_m *FeedHandler) RevokeFeedToken(e echo.Context) error {
+	ret := _m.Called(e)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeFeedToken")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(echo.Context) error); ok {
+		r0 = rf(e)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FeedHandler_RevokeFeedToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeFeedToken'
+type FeedHandler_RevokeFeedToken_Call struct {
+	*mock.Call
+}
+
+// RevokeFeedToken is a helper method to define mock.On call
+//   - e echo.Context
+func (_e *FeedHandler_Expecter) RevokeFeedToken(e interface{}) *FeedHandler_RevokeFeedToken_Call {
+	return &FeedHandler_RevokeFeedToken_Call{Call: _e.mock.On("RevokeFeedToken", e)}
+}
+
+func (_c *FeedHandler_RevokeFeedToken_Call) Run(run func(e echo.Context)) *FeedHandler_RevokeFeedToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(echo.Context))
+	})
+	return _c
+}
+
+func (_c *FeedHandler_RevokeFeedToken_Call) Return(_a0 error) *FeedHandler_RevokeFeedToken_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *FeedHandler_RevokeFeedToken_Call) RunAndReturn(run func(echo.Context) error) *FeedHandler_RevokeFeedToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewFeedHandler creates a new instance of FeedHandler. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewFeedHandler(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *FeedHandler {
+	mock := &FeedHandler{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
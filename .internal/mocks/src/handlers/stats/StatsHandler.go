@@ -0,0 +1,219 @@
+// Code generated by mockery v2.53.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	echo "github.com/labstack/echo/v4"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// StatsHandler is an autogenerated mock type for the StatsHandler type
+type StatsHandler struct {
+	mock.Mock
+}
+
+type StatsHandler_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *StatsHandler) EXPECT() *StatsHandler_Expecter {
+	return &StatsHandler_Expecter{mock: &_m.Mock}
+}
+
+// GetHeatmap provides a mock function with given fields: e
+func (_m *StatsHandler) GetHeatmap(e echo.Context) error {
+	ret := _m.Called(e)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetHeatmap")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(echo.Context) error); ok {
+		r0 = rf(e)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// StatsHandler_GetHeatmap_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetHeatmap'
+type StatsHandler_GetHeatmap_Call struct {
+	*mock.Call
+}
+
+// GetHeatmap is a helper method to define mock.On call
+//   - e echo.Context
+func (_e *StatsHandler_Expecter) GetHeatmap(e interface{}) *StatsHandler_GetHeatmap_Call {
+	return &StatsHandler_GetHeatmap_Call{Call: _e.mock.On("GetHeatmap", e)}
+}
+
+func (_c *StatsHandler_GetHeatmap_Call) Run(run func(e echo.Context)) *StatsHandler_GetHeatmap_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(echo.Context))
+	})
+	return _c
+}
+
+func (_c *StatsHandler_GetHeatmap_Call) Return(_a0 error) *StatsHandler_GetHeatmap_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *StatsHandler_GetHeatmap_Call) RunAndReturn(run func(echo.Context) error) *StatsHandler_GetHeatmap_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetStreak provides a mock function with given fields: e
+func (_m *StatsHandler) GetStreak(e echo.Context) error {
+	ret := _m.Called(e)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetStreak")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(echo.Context) error); ok {
+		r0 = rf(e)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// StatsHandler_GetStreak_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetStreak'
+type StatsHandler_GetStreak_Call struct {
+	*mock.Call
+}
+
+// GetStreak is a helper method to define mock.On call
+//   - e echo.Context
+func (_e *StatsHandler_Expecter) GetStreak(e interface{}) *StatsHandler_GetStreak_Call {
+	return &StatsHandler_GetStreak_Call{Call: _e.mock.On("GetStreak", e)}
+}
+
+func (_c *StatsHandler_GetStreak_Call) Run(run func(e echo.Context)) *StatsHandler_GetStreak_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(echo.Context))
+	})
+	return _c
+}
+
+func (_c *StatsHandler_GetStreak_Call) Return(_a0 error) *StatsHandler_GetStreak_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *StatsHandler_GetStreak_Call) RunAndReturn(run func(echo.Context) error) *StatsHandler_GetStreak_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetStudyStats provides a mock function with given fields: e
+func (_m *StatsHandler) GetStudyStats(e echo.Context) error {
+	ret := _m.Called(e)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetStudyStats")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(echo.Context) error); ok {
+		r0 = rf(e)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// StatsHandler_GetStudyStats_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetStudyStats'
+type StatsHandler_GetStudyStats_Call struct {
+	*mock.Call
+}
+
+// GetStudyStats is a helper method to define mock.On call
+//   - e echo.Context
+func (_e *StatsHandler_Expecter) GetStudyStats(e interface{}) *StatsHandler_GetStudyStats_Call {
+	return &StatsHandler_GetStudyStats_Call{Call: _e.mock.On("GetStudyStats", e)}
+}
+
+func (_c *StatsHandler_GetStudyStats_Call) Run(run func(e echo.Context)) *StatsHandler_GetStudyStats_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(echo.Context))
+	})
+	return _c
+}
+
+func (_c *StatsHandler_GetStudyStats_Call) Return(_a0 error) *StatsHandler_GetStudyStats_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *StatsHandler_GetStudyStats_Call) RunAndReturn(run func(echo.Context) error) *StatsHandler_GetStudyStats_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateStreakSettings provides a mock function with given fields: e
+func (_m *StatsHandler) UpdateStreakSettings(e echo.Context) error {
+	ret := _m.Called(e)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateStreakSettings")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(echo.Context) error); ok {
+		r0 = rf(e)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// StatsHandler_UpdateStreakSettings_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateStreakSettings'
+type StatsHandler_UpdateStreakSettings_Call struct {
+	*mock.Call
+}
+
+// UpdateStreakSettings is a helper method to define mock.On call
+//   - e echo.Context
+func (_e *StatsHandler_Expecter) UpdateStreakSettings(e interface{}) *StatsHandler_UpdateStreakSettings_Call {
+	return &StatsHandler_UpdateStreakSettings_Call{Call: _e.mock.On("UpdateStreakSettings", e)}
+}
+
+func (_c *StatsHandler_UpdateStreakSettings_Call) Run(run func(e echo.Context)) *StatsHandler_UpdateStreakSettings_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(echo.Context))
+	})
+	return _c
+}
+
+func (_c *StatsHandler_UpdateStreakSettings_Call) Return(_a0 error) *StatsHandler_UpdateStreakSettings_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *StatsHandler_UpdateStreakSettings_Call) RunAndReturn(run func(echo.Context) error) *StatsHandler_UpdateStreakSettings_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewStatsHandler creates a new instance of StatsHandler. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewStatsHandler(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *StatsHandler {
+	mock := &StatsHandler{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,909 @@
+// Code generated by mockery v2.53.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	echo "github.com/labstack/echo/v4"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// StudySessionHandler is an autogenerated mock type for the StudySessionHandler type
+type StudySessionHandler struct {
+	mock.Mock
+}
+
+type StudySessionHandler_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *StudySessionHandler) EXPECT() *StudySessionHandler_Expecter {
+	return &StudySessionHandler_Expecter{mock: &_m.Mock}
+}
+
+// AddStudySessionEvents provides a mock function with given fields: e
+func (_m *StudySessionHandler) AddStudySessionEvents(e echo.Context) error {
+	ret := _m.Called(e)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddStudySessionEvents")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(echo.Context) error); ok {
+		r0 = rf(e)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// StudySessionHandler_AddStudySessionEvents_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddStudySessionEvents'
+type StudySessionHandler_AddStudySessionEvents_Call struct {
+	*mock.Call
+}
+
+// AddStudySessionEvents is a helper method to define mock.On call
+//   - e echo.Context
+func (_e *StudySessionHandler_Expecter) AddStudySessionEvents(e interface{}) *StudySessionHandler_AddStudySessionEvents_Call {
+	return &StudySessionHandler_AddStudySessionEvents_Call{Call: _e.mock.On("AddStudySessionEvents", e)}
+}
+
+func (_c *StudySessionHandler_AddStudySessionEvents_Call) Run(run func(e echo.Context)) *StudySessionHandler_AddStudySessionEvents_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(echo.Context))
+	})
+	return _c
+}
+
+func (_c *StudySessionHandler_AddStudySessionEvents_Call) Return(_a0 error) *StudySessionHandler_AddStudySessionEvents_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *StudySessionHandler_AddStudySessionEvents_Call) RunAndReturn(run func(echo.Context) error) *StudySessionHandler_AddStudySessionEvents_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CancelActiveStudySession provides a mock function with given fields: e
+func (_m *StudySessionHandler) CancelActiveStudySession(e echo.Context) error {
+	ret := _m.Called(e)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CancelActiveStudySession")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(echo.Context) error); ok {
+		r0 = rf(e)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// StudySessionHandler_CancelActiveStudySession_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CancelActiveStudySession'
+type StudySessionHandler_CancelActiveStudySession_Call struct {
+	*mock.Call
+}
+
+// CancelActiveStudySession is a helper method to define mock.On call
+//   - e echo.Context
+func (_e *StudySessionHandler_Expecter) CancelActiveStudySession(e interface{}) *StudySessionHandler_CancelActiveStudySession_Call {
+	return &StudySessionHandler_CancelActiveStudySession_Call{Call: _e.mock.On("CancelActiveStudySession", e)}
+}
+
+func (_c *StudySessionHandler_CancelActiveStudySession_Call) Run(run func(e echo.Context)) *StudySessionHandler_CancelActiveStudySession_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(echo.Context))
+	})
+	return _c
+}
+
+func (_c *StudySessionHandler_CancelActiveStudySession_Call) Return(_a0 error) *StudySessionHandler_CancelActiveStudySession_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *StudySessionHandler_CancelActiveStudySession_Call) RunAndReturn(run func(echo.Context) error) *StudySessionHandler_CancelActiveStudySession_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateManualStudySession provides a mock function with given fields: e
+func (_m *StudySessionHandler) CreateManualStudySession(e echo.Context) error {
+	ret := _m.Called(e)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateManualStudySession")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(echo.Context) error); ok {
+		r0 = rf(e)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// StudySessionHandler_CreateManualStudySession_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateManualStudySession'
+type StudySessionHandler_CreateManualStudySession_Call struct {
+	*mock.Call
+}
+
+// CreateManualStudySession is a helper method to define mock.On call
+//   - e echo.Context
+func (_e *StudySessionHandler_Expecter) CreateManualStudySession(e interface{}) *StudySessionHandler_CreateManualStudySession_Call {
+	return &StudySessionHandler_CreateManualStudySession_Call{Call: _e.mock.On("CreateManualStudySession", e)}
+}
+
+func (_c *StudySessionHandler_CreateManualStudySession_Call) Run(run func(e echo.Context)) *StudySessionHandler_CreateManualStudySession_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(echo.Context))
+	})
+	return _c
+}
+
+func (_c *StudySessionHandler_CreateManualStudySession_Call) Return(_a0 error) *StudySessionHandler_CreateManualStudySession_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *StudySessionHandler_CreateManualStudySession_Call) RunAndReturn(run func(echo.Context) error) *StudySessionHandler_CreateManualStudySession_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteStudySession provides a mock function with given fields: e
+func (_m *StudySessionHandler) DeleteStudySession(e echo.Context) error {
+	ret := _m.Called(e)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteStudySession")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(echo.Context) error); ok {
+		r0 = rf(e)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// StudySessionHandler_DeleteStudySession_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteStudySession'
+type StudySessionHandler_DeleteStudySession_Call struct {
+	*mock.Call
+}
+
+// DeleteStudySession is a helper method to define mock.On call
+//   - e echo.Context
+func (_e *StudySessionHandler_Expecter) DeleteStudySession(e interface{}) *StudySessionHandler_DeleteStudySession_Call {
+	return &StudySessionHandler_DeleteStudySession_Call{Call: _e.mock.On("DeleteStudySession", e)}
+}
+
+func (_c *StudySessionHandler_DeleteStudySession_Call) Run(run func(e echo.Context)) *StudySessionHandler_DeleteStudySession_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(echo.Context))
+	})
+	return _c
+}
+
+func (_c *StudySessionHandler_DeleteStudySession_Call) Return(_a0 error) *StudySessionHandler_DeleteStudySession_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *StudySessionHandler_DeleteStudySession_Call) RunAndReturn(run func(echo.Context) error) *StudySessionHandler_DeleteStudySession_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ExportStudySessionData provides a mock function with given fields: e
+func (_m *StudySessionHandler) ExportStudySessionData(e echo.Context) error {
+	ret := _m.Called(e)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExportStudySessionData")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(echo.Context) error); ok {
+		r0 = rf(e)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// StudySessionHandler_ExportStudySessionData_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExportStudySessionData'
+type StudySessionHandler_ExportStudySessionData_Call struct {
+	*mock.Call
+}
+
+// ExportStudySessionData is a helper method to define mock.On call
+//   - e echo.Context
+func (_e *StudySessionHandler_Expecter) ExportStudySessionData(e interface{}) *StudySessionHandler_ExportStudySessionData_Call {
+	return &StudySessionHandler_ExportStudySessionData_Call{Call: _e.mock.On("ExportStudySessionData", e)}
+}
+
+func (_c *StudySessionHandler_ExportStudySessionData_Call) Run(run func(e echo.Context)) *StudySessionHandler_ExportStudySessionData_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(echo.Context))
+	})
+	return _c
+}
+
+func (_c *StudySessionHandler_ExportStudySessionData_Call) Return(_a0 error) *StudySessionHandler_ExportStudySessionData_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *StudySessionHandler_ExportStudySessionData_Call) RunAndReturn(run func(echo.Context) error) *StudySessionHandler_ExportStudySessionData_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ExportStudySessionsICal provides a mock function with given fields: e
+func (_m *StudySessionHandler) ExportStudySessionsICal(e echo.Context) error {
+	ret := _m.Called(e)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExportStudySessionsICal")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(echo.Context) error); ok {
+		r0 = rf(e)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// StudySessionHandler_ExportStudySessionsICal_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExportStudySessionsICal'
+type StudySessionHandler_ExportStudySessionsICal_Call struct {
+	*mock.Call
+}
+
+// ExportStudySessionsICal is a helper method to define mock.On call
+//   - e echo.Context
+func (_e *StudySessionHandler_Expecter) ExportStudySessionsICal(e interface{}) *StudySessionHandler_ExportStudySessionsICal_Call {
+	return &StudySessionHandler_ExportStudySessionsICal_Call{Call: _e.mock.On("ExportStudySessionsICal", e)}
+}
+
+func (_c *StudySessionHandler_ExportStudySessionsICal_Call) Run(run func(e echo.Context)) *StudySessionHandler_ExportStudySessionsICal_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(echo.Context))
+	})
+	return _c
+}
+
+func (_c *StudySessionHandler_ExportStudySessionsICal_Call) Return(_a0 error) *StudySessionHandler_ExportStudySessionsICal_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *StudySessionHandler_ExportStudySessionsICal_Call) RunAndReturn(run func(echo.Context) error) *StudySessionHandler_ExportStudySessionsICal_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FinishStudySession provides a mock function with given fields: e
+func (_m *StudySessionHandler) FinishStudySession(e echo.Context) error {
+	ret := _m.Called(e)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FinishStudySession")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(echo.Context) error); ok {
+		r0 = rf(e)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// StudySessionHandler_FinishStudySession_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FinishStudySession'
+type StudySessionHandler_FinishStudySession_Call struct {
+	*mock.Call
+}
+
+// FinishStudySession is a helper method to define mock.On call
+//   - e echo.Context
+func (_e *StudySessionHandler_Expecter) FinishStudySession(e interface{}) *StudySessionHandler_FinishStudySession_Call {
+	return &StudySessionHandler_FinishStudySession_Call{Call: _e.mock.On("FinishStudySession", e)}
+}
+
+func (_c *StudySessionHandler_FinishStudySession_Call) Run(run func(e echo.Context)) *StudySessionHandler_FinishStudySession_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(echo.Context))
+	})
+	return _c
+}
+
+func (_c *StudySessionHandler_FinishStudySession_Call) Return(_a0 error) *StudySessionHandler_FinishStudySession_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *StudySessionHandler_FinishStudySession_Call) RunAndReturn(run func(echo.Context) error) *StudySessionHandler_FinishStudySession_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetActiveStudySession provides a mock function with given fields: e
+func (_m *StudySessionHandler) GetActiveStudySession(e echo.Context) error {
+	ret := _m.Called(e)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetActiveStudySession")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(echo.Context) error); ok {
+		r0 = rf(e)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// StudySessionHandler_GetActiveStudySession_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetActiveStudySession'
+type StudySessionHandler_GetActiveStudySession_Call struct {
+	*mock.Call
+}
+
+// GetActiveStudySession is a helper method to define mock.On call
+//   - e echo.Context
+func (_e *StudySessionHandler_Expecter) GetActiveStudySession(e interface{}) *StudySessionHandler_GetActiveStudySession_Call {
+	return &StudySessionHandler_GetActiveStudySession_Call{Call: _e.mock.On("GetActiveStudySession", e)}
+}
+
+func (_c *StudySessionHandler_GetActiveStudySession_Call) Run(run func(e echo.Context)) *StudySessionHandler_GetActiveStudySession_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(echo.Context))
+	})
+	return _c
+}
+
+func (_c *StudySessionHandler_GetActiveStudySession_Call) Return(_a0 error) *StudySessionHandler_GetActiveStudySession_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *StudySessionHandler_GetActiveStudySession_Call) RunAndReturn(run func(echo.Context) error) *StudySessionHandler_GetActiveStudySession_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetActiveStudySessionEvents provides a mock function with given fields: e
+func (_m *StudySessionHandler) GetActiveStudySessionEvents(e echo.Context) error {
+	ret := _m.Called(e)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetActiveStudySessionEvents")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(echo.Context) error); ok {
+		r0 = rf(e)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// StudySessionHandler_GetActiveStudySessionEvents_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetActiveStudySessionEvents'
+type StudySessionHandler_GetActiveStudySessionEvents_Call struct {
+	*mock.Call
+}
+
+// GetActiveStudySessionEvents is a helper method to define mock.On call
+//   - e echo.Context
+func (_e *StudySessionHandler_Expecter) GetActiveStudySessionEvents(e interface{}) *StudySessionHandler_GetActiveStudySessionEvents_Call {
+	return &StudySessionHandler_GetActiveStudySessionEvents_Call{Call: _e.mock.On("GetActiveStudySessionEvents", e)}
+}
+
+func (_c *StudySessionHandler_GetActiveStudySessionEvents_Call) Run(run func(e echo.Context)) *StudySessionHandler_GetActiveStudySessionEvents_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(echo.Context))
+	})
+	return _c
+}
+
+func (_c *StudySessionHandler_GetActiveStudySessionEvents_Call) Return(_a0 error) *StudySessionHandler_GetActiveStudySessionEvents_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *StudySessionHandler_GetActiveStudySessionEvents_Call) RunAndReturn(run func(echo.Context) error) *StudySessionHandler_GetActiveStudySessionEvents_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetStudySession provides a mock function with given fields: e
+func (_m *StudySessionHandler) GetStudySession(e echo.Context) error {
+	ret := _m.Called(e)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetStudySession")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(echo.Context) error); ok {
+		r0 = rf(e)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// StudySessionHandler_GetStudySession_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetStudySession'
+type StudySessionHandler_GetStudySession_Call struct {
+	*mock.Call
+}
+
+// GetStudySession is a helper method to define mock.On call
+//   - e echo.Context
+func (_e *StudySessionHandler_Expecter) GetStudySession(e interface{}) *StudySessionHandler_GetStudySession_Call {
+	return &StudySessionHandler_GetStudySession_Call{Call: _e.mock.On("GetStudySession", e)}
+}
+
+func (_c *StudySessionHandler_GetStudySession_Call) Run(run func(e echo.Context)) *StudySessionHandler_GetStudySession_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(echo.Context))
+	})
+	return _c
+}
+
+func (_c *StudySessionHandler_GetStudySession_Call) Return(_a0 error) *StudySessionHandler_GetStudySession_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *StudySessionHandler_GetStudySession_Call) RunAndReturn(run func(echo.Context) error) *StudySessionHandler_GetStudySession_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetStudySessionEvents provides a mock function with given fields: e
+func (_m *StudySessionHandler) GetStudySessionEvents(e echo.Context) error {
+	ret := _m.Called(e)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetStudySessionEvents")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(echo.Context) error); ok {
+		r0 = rf(e)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// StudySessionHandler_GetStudySessionEvents_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetStudySessionEvents'
+type StudySessionHandler_GetStudySessionEvents_Call struct {
+	*mock.Call
+}
+
+// GetStudySessionEvents is a helper method to define mock.On call
+//   - e echo.Context
+func (_e *StudySessionHandler_Expecter) GetStudySessionEvents(e interface{}) *StudySessionHandler_GetStudySessionEvents_Call {
+	return &StudySessionHandler_GetStudySessionEvents_Call{Call: _e.mock.On("GetStudySessionEvents", e)}
+}
+
+func (_c *StudySessionHandler_GetStudySessionEvents_Call) Run(run func(e echo.Context)) *StudySessionHandler_GetStudySessionEvents_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(echo.Context))
+	})
+	return _c
+}
+
+func (_c *StudySessionHandler_GetStudySessionEvents_Call) Return(_a0 error) *StudySessionHandler_GetStudySessionEvents_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *StudySessionHandler_GetStudySessionEvents_Call) RunAndReturn(run func(echo.Context) error) *StudySessionHandler_GetStudySessionEvents_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetStudySessionHistory provides a mock function with given fields: e
+func (_m *StudySessionHandler) GetStudySessionHistory(e echo.Context) error {
+	ret := _m.Called(e)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetStudySessionHistory")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(echo.Context) error); ok {
+		r0 = rf(e)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// StudySessionHandler_GetStudySessionHistory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetStudySessionHistory'
+type StudySessionHandler_GetStudySessionHistory_Call struct {
+	*mock.Call
+}
+
+// GetStudySessionHistory is a helper method to define mock.On call
+//   - e echo.Context
+func (_e *StudySessionHandler_Expecter) GetStudySessionHistory(e interface{}) *StudySessionHandler_GetStudySessionHistory_Call {
+	return &StudySessionHandler_GetStudySessionHistory_Call{Call: _e.mock.On("GetStudySessionHistory", e)}
+}
+
+func (_c *StudySessionHandler_GetStudySessionHistory_Call) Run(run func(e echo.Context)) *StudySessionHandler_GetStudySessionHistory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(echo.Context))
+	})
+	return _c
+}
+
+func (_c *StudySessionHandler_GetStudySessionHistory_Call) Return(_a0 error) *StudySessionHandler_GetStudySessionHistory_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *StudySessionHandler_GetStudySessionHistory_Call) RunAndReturn(run func(echo.Context) error) *StudySessionHandler_GetStudySessionHistory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSubjectTimeTotals provides a mock function with given fields: e
+func (_m *StudySessionHandler) GetSubjectTimeTotals(e echo.Context) error {
+	ret := _m.Called(e)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSubjectTimeTotals")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(echo.Context) error); ok {
+		r0 = rf(e)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// StudySessionHandler_GetSubjectTimeTotals_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSubjectTimeTotals'
+type StudySessionHandler_GetSubjectTimeTotals_Call struct {
+	*mock.Call
+}
+
+// GetSubjectTimeTotals is a helper method to define mock.On call
+//   - e echo.Context
+func (_e *StudySessionHandler_Expecter) GetSubjectTimeTotals(e interface{}) *StudySessionHandler_GetSubjectTimeTotals_Call {
+	return &StudySessionHandler_GetSubjectTimeTotals_Call{Call: _e.mock.On("GetSubjectTimeTotals", e)}
+}
+
+func (_c *StudySessionHandler_GetSubjectTimeTotals_Call) Run(run func(e echo.Context)) *StudySessionHandler_GetSubjectTimeTotals_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(echo.Context))
+	})
+	return _c
+}
+
+func (_c *StudySessionHandler_GetSubjectTimeTotals_Call) Return(_a0 error) *StudySessionHandler_GetSubjectTimeTotals_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *StudySessionHandler_GetSubjectTimeTotals_Call) RunAndReturn(run func(echo.Context) error) *StudySessionHandler_GetSubjectTimeTotals_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ImportStudySessions provides a mock function with given fields: e
+func (_m *StudySessionHandler) ImportStudySessions(e echo.Context) error {
+	ret := _m.Called(e)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ImportStudySessions")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(echo.Context) error); ok {
+		r0 = rf(e)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// StudySessionHandler_ImportStudySessions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ImportStudySessions'
+type StudySessionHandler_ImportStudySessions_Call struct {
+	*mock.Call
+}
+
+// ImportStudySessions is a helper method to define mock.On call
+//   - e echo.Context
+func (_e *StudySessionHandler_Expecter) ImportStudySessions(e interface{}) *StudySessionHandler_ImportStudySessions_Call {
+	return &StudySessionHandler_ImportStudySessions_Call{Call: _e.mock.On("ImportStudySessions", e)}
+}
+
+func (_c *StudySessionHandler_ImportStudySessions_Call) Run(run func(e echo.Context)) *StudySessionHandler_ImportStudySessions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(echo.Context))
+	})
+	return _c
+}
+
+func (_c *StudySessionHandler_ImportStudySessions_Call) Return(_a0 error) *StudySessionHandler_ImportStudySessions_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *StudySessionHandler_ImportStudySessions_Call) RunAndReturn(run func(echo.Context) error) *StudySessionHandler_ImportStudySessions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RestoreStudySession provides a mock function with given fields: e
+func (_m *StudySessionHandler) RestoreStudySession(e echo.Context) error {
+	ret := _m.Called(e)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RestoreStudySession")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(echo.Context) error); ok {
+		r0 = rf(e)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// StudySessionHandler_RestoreStudySession_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RestoreStudySession'
+type StudySessionHandler_RestoreStudySession_Call struct {
+	*mock.Call
+}
+
+// RestoreStudySession is a helper method to define mock.On call
+//   - e echo.Context
+func (_e *StudySessionHandler_Expecter) RestoreStudySession(e interface{}) *StudySessionHandler_RestoreStudySession_Call {
+	return &StudySessionHandler_RestoreStudySession_Call{Call: _e.mock.On("RestoreStudySession", e)}
+}
+
+func (_c *StudySessionHandler_RestoreStudySession_Call) Run(run func(e echo.Context)) *StudySessionHandler_RestoreStudySession_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(echo.Context))
+	})
+	return _c
+}
+
+func (_c *StudySessionHandler_RestoreStudySession_Call) Return(_a0 error) *StudySessionHandler_RestoreStudySession_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *StudySessionHandler_RestoreStudySession_Call) RunAndReturn(run func(echo.Context) error) *StudySessionHandler_RestoreStudySession_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// StartStudySession provides a mock function with given fields: e
+func (_m *StudySessionHandler) StartStudySession(e echo.Context) error {
+	ret := _m.Called(e)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StartStudySession")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(echo.Context) error); ok {
+		r0 = rf(e)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// StudySessionHandler_StartStudySession_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StartStudySession'
+type StudySessionHandler_StartStudySession_Call struct {
+	*mock.Call
+}
+
+// StartStudySession is a helper method to define mock.On call
+//   - e echo.Context
+func (_e *StudySessionHandler_Expecter) StartStudySession(e interface{}) *StudySessionHandler_StartStudySession_Call {
+	return &StudySessionHandler_StartStudySession_Call{Call: _e.mock.On("StartStudySession", e)}
+}
+
+func (_c *StudySessionHandler_StartStudySession_Call) Run(run func(e echo.Context)) *StudySessionHandler_StartStudySession_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(echo.Context))
+	})
+	return _c
+}
+
+func (_c *StudySessionHandler_StartStudySession_Call) Return(_a0 error) *StudySessionHandler_StartStudySession_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *StudySessionHandler_StartStudySession_Call) RunAndReturn(run func(echo.Context) error) *StudySessionHandler_StartStudySession_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// StreamStudySessionNotifications provides a mock function with given fields: e
+func (_m *StudySessionHandler) StreamStudySessionNotifications(e echo.Context) error {
+	ret := _m.Called(e)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StreamStudySessionNotifications")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(echo.Context) error); ok {
+		r0 = rf(e)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// StudySessionHandler_StreamStudySessionNotifications_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StreamStudySessionNotifications'
+type StudySessionHandler_StreamStudySessionNotifications_Call struct {
+	*mock.Call
+}
+
+// StreamStudySessionNotifications is a helper method to define mock.On call
+//   - e echo.Context
+func (_e *StudySessionHandler_Expecter) StreamStudySessionNotifications(e interface{}) *StudySessionHandler_StreamStudySessionNotifications_Call {
+	return &StudySessionHandler_StreamStudySessionNotifications_Call{Call: _e.mock.On("StreamStudySessionNotifications", e)}
+}
+
+func (_c *StudySessionHandler_StreamStudySessionNotifications_Call) Run(run func(e echo.Context)) *StudySessionHandler_StreamStudySessionNotifications_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(echo.Context))
+	})
+	return _c
+}
+
+func (_c *StudySessionHandler_StreamStudySessionNotifications_Call) Return(_a0 error) *StudySessionHandler_StreamStudySessionNotifications_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *StudySessionHandler_StreamStudySessionNotifications_Call) RunAndReturn(run func(echo.Context) error) *StudySessionHandler_StreamStudySessionNotifications_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateActiveStudySession provides a mock function with given fields: e
+func (_m *StudySessionHandler) UpdateActiveStudySession(e echo.Context) error {
+	ret := _m.Called(e)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateActiveStudySession")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(echo.Context) error); ok {
+		r0 = rf(e)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// StudySessionHandler_UpdateActiveStudySession_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateActiveStudySession'
+type StudySessionHandler_UpdateActiveStudySession_Call struct {
+	*mock.Call
+}
+
+// UpdateActiveStudySession is a helper method to define mock.On call
+//   - e echo.Context
+func (_e *StudySessionHandler_Expecter) UpdateActiveStudySession(e interface{}) *StudySessionHandler_UpdateActiveStudySession_Call {
+	return &StudySessionHandler_UpdateActiveStudySession_Call{Call: _e.mock.On("UpdateActiveStudySession", e)}
+}
+
+func (_c *StudySessionHandler_UpdateActiveStudySession_Call) Run(run func(e echo.Context)) *StudySessionHandler_UpdateActiveStudySession_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(echo.Context))
+	})
+	return _c
+}
+
+func (_c *StudySessionHandler_UpdateActiveStudySession_Call) Return(_a0 error) *StudySessionHandler_UpdateActiveStudySession_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *StudySessionHandler_UpdateActiveStudySession_Call) RunAndReturn(run func(echo.Context) error) *StudySessionHandler_UpdateActiveStudySession_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateStudySession provides a mock function with given fields: e
+func (_m *StudySessionHandler) UpdateStudySession(e echo.Context) error {
+	ret := _m.Called(e)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateStudySession")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(echo.Context) error); ok {
+		r0 = rf(e)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// StudySessionHandler_UpdateStudySession_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateStudySession'
+type StudySessionHandler_UpdateStudySession_Call struct {
+	*mock.Call
+}
+
+// UpdateStudySession is a helper method to define mock.On call
+//   - e echo.Context
+func (_e *StudySessionHandler_Expecter) UpdateStudySession(e interface{}) *StudySessionHandler_UpdateStudySession_Call {
+	return &StudySessionHandler_UpdateStudySession_Call{Call: _e.mock.On("UpdateStudySession", e)}
+}
+
+func (_c *StudySessionHandler_UpdateStudySession_Call) Run(run func(e echo.Context)) *StudySessionHandler_UpdateStudySession_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(echo.Context))
+	})
+	return _c
+}
+
+func (_c *StudySessionHandler_UpdateStudySession_Call) Return(_a0 error) *StudySessionHandler_UpdateStudySession_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *StudySessionHandler_UpdateStudySession_Call) RunAndReturn(run func(echo.Context) error) *StudySessionHandler_UpdateStudySession_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewStudySessionHandler creates a new instance of StudySessionHandler. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewStudySessionHandler(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *StudySessionHandler {
+	mock := &StudySessionHandler{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
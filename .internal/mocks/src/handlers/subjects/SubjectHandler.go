@@ -0,0 +1,541 @@
+// Code generated by mockery v2.53.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	echo "github.com/labstack/echo/v4"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// SubjectHandler is an autogenerated mock type for the SubjectHandler type
+type SubjectHandler struct {
+	mock.Mock
+}
+
+type SubjectHandler_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *SubjectHandler) EXPECT() *SubjectHandler_Expecter {
+	return &SubjectHandler_Expecter{mock: &_m.Mock}
+}
+
+// ArchiveSubject provides a mock function with given fields: e
+func (_m *SubjectHandler) ArchiveSubject(e echo.Context) error {
+	ret := _m.Called(e)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ArchiveSubject")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(echo.Context) error); ok {
+		r0 = rf(e)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SubjectHandler_ArchiveSubject_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ArchiveSubject'
+type SubjectHandler_ArchiveSubject_Call struct {
+	*mock.Call
+}
+
+// ArchiveSubject is a helper method to define mock.On call
+//   - e echo.Context
+func (_e *SubjectHandler_Expecter) ArchiveSubject(e interface{}) *SubjectHandler_ArchiveSubject_Call {
+	return &SubjectHandler_ArchiveSubject_Call{Call: _e.mock.On("ArchiveSubject", e)}
+}
+
+func (_c *SubjectHandler_ArchiveSubject_Call) Run(run func(e echo.Context)) *SubjectHandler_ArchiveSubject_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(echo.Context))
+	})
+	return _c
+}
+
+func (_c *SubjectHandler_ArchiveSubject_Call) Return(_a0 error) *SubjectHandler_ArchiveSubject_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *SubjectHandler_ArchiveSubject_Call) RunAndReturn(run func(echo.Context) error) *SubjectHandler_ArchiveSubject_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateRelation provides a mock function with given fields: e
+func (_m *SubjectHandler) CreateRelation(e echo.Context) error {
+	ret := _m.Called(e)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateRelation")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(echo.Context) error); ok {
+		r0 = rf(e)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SubjectHandler_CreateRelation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateRelation'
+type SubjectHandler_CreateRelation_Call struct {
+	*mock.Call
+}
+
+// CreateRelation is a helper method to define mock.On call
+//   - e echo.Context
+func (_e *SubjectHandler_Expecter) CreateRelation(e interface{}) *SubjectHandler_CreateRelation_Call {
+	return &SubjectHandler_CreateRelation_Call{Call: _e.mock.On("CreateRelation", e)}
+}
+
+func (_c *SubjectHandler_CreateRelation_Call) Run(run func(e echo.Context)) *SubjectHandler_CreateRelation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(echo.Context))
+	})
+	return _c
+}
+
+func (_c *SubjectHandler_CreateRelation_Call) Return(_a0 error) *SubjectHandler_CreateRelation_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *SubjectHandler_CreateRelation_Call) RunAndReturn(run func(echo.Context) error) *SubjectHandler_CreateRelation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateSubject provides a mock function with given fields: e
+func (_m *SubjectHandler) CreateSubject(e echo.Context) error {
+	ret := _m.Called(e)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateSubject")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(echo.Context) error); ok {
+		r0 = rf(e)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SubjectHandler_CreateSubject_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateSubject'
+type SubjectHandler_CreateSubject_Call struct {
+	*mock.Call
+}
+
+// CreateSubject is a helper method to define mock.On call
+//   - e echo.Context
+func (_e *SubjectHandler_Expecter) CreateSubject(e interface{}) *SubjectHandler_CreateSubject_Call {
+	return &SubjectHandler_CreateSubject_Call{Call: _e.mock.On("CreateSubject", e)}
+}
+
+func (_c *SubjectHandler_CreateSubject_Call) Run(run func(e echo.Context)) *SubjectHandler_CreateSubject_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(echo.Context))
+	})
+	return _c
+}
+
+func (_c *SubjectHandler_CreateSubject_Call) Return(_a0 error) *SubjectHandler_CreateSubject_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *SubjectHandler_CreateSubject_Call) RunAndReturn(run func(echo.Context) error) *SubjectHandler_CreateSubject_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteRelation provides a mock function with given fields: e
+func (_m *SubjectHandler) DeleteRelation(e echo.Context) error {
+	ret := _m.Called(e)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteRelation")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(echo.Context) error); ok {
+		r0 = rf(e)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SubjectHandler_DeleteRelation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteRelation'
+type SubjectHandler_DeleteRelation_Call struct {
+	*mock.Call
+}
+
+// DeleteRelation is a helper method to define mock.On call
+//   - e echo.Context
+func (_e *SubjectHandler_Expecter) DeleteRelation(e interface{}) *SubjectHandler_DeleteRelation_Call {
+	return &SubjectHandler_DeleteRelation_Call{Call: _e.mock.On("DeleteRelation", e)}
+}
+
+func (_c *SubjectHandler_DeleteRelation_Call) Run(run func(e echo.Context)) *SubjectHandler_DeleteRelation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(echo.Context))
+	})
+	return _c
+}
+
+func (_c *SubjectHandler_DeleteRelation_Call) Return(_a0 error) *SubjectHandler_DeleteRelation_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *SubjectHandler_DeleteRelation_Call) RunAndReturn(run func(echo.Context) error) *SubjectHandler_DeleteRelation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetStudyOrder provides a mock function with given fields: e
+func (_m *SubjectHandler) GetStudyOrder(e echo.Context) error {
+	ret := _m.Called(e)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetStudyOrder")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(echo.Context) error); ok {
+		r0 = rf(e)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SubjectHandler_GetStudyOrder_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetStudyOrder'
+type SubjectHandler_GetStudyOrder_Call struct {
+	*mock.Call
+}
+
+// GetStudyOrder is a helper method to define mock.On call
+//   - e echo.Context
+func (_e *SubjectHandler_Expecter) GetStudyOrder(e interface{}) *SubjectHandler_GetStudyOrder_Call {
+	return &SubjectHandler_GetStudyOrder_Call{Call: _e.mock.On("GetStudyOrder", e)}
+}
+
+func (_c *SubjectHandler_GetStudyOrder_Call) Run(run func(e echo.Context)) *SubjectHandler_GetStudyOrder_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(echo.Context))
+	})
+	return _c
+}
+
+func (_c *SubjectHandler_GetStudyOrder_Call) Return(_a0 error) *SubjectHandler_GetStudyOrder_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *SubjectHandler_GetStudyOrder_Call) RunAndReturn(run func(echo.Context) error) *SubjectHandler_GetStudyOrder_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSubject provides a mock function with given fields: e
+func (_m *SubjectHandler) GetSubject(e echo.Context) error {
+	ret := _m.Called(e)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSubject")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(echo.Context) error); ok {
+		r0 = rf(e)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SubjectHandler_GetSubject_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSubject'
+type SubjectHandler_GetSubject_Call struct {
+	*mock.Call
+}
+
+// GetSubject is a helper method to define mock.On call
+//   - e echo.Context
+func (_e *SubjectHandler_Expecter) GetSubject(e interface{}) *SubjectHandler_GetSubject_Call {
+	return &SubjectHandler_GetSubject_Call{Call: _e.mock.On("GetSubject", e)}
+}
+
+func (_c *SubjectHandler_GetSubject_Call) Run(run func(e echo.Context)) *SubjectHandler_GetSubject_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(echo.Context))
+	})
+	return _c
+}
+
+func (_c *SubjectHandler_GetSubject_Call) Return(_a0 error) *SubjectHandler_GetSubject_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *SubjectHandler_GetSubject_Call) RunAndReturn(run func(echo.Context) error) *SubjectHandler_GetSubject_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSubjectTree provides a mock function with given fields: e
+func (_m *SubjectHandler) GetSubjectTree(e echo.Context) error {
+	ret := _m.Called(e)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSubjectTree")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(echo.Context) error); ok {
+		r0 = rf(e)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SubjectHandler_GetSubjectTree_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSubjectTree'
+type SubjectHandler_GetSubjectTree_Call struct {
+	*mock.Call
+}
+
+// GetSubjectTree is a helper method to define mock.On call
+//   - e echo.Context
+func (_e *SubjectHandler_Expecter) GetSubjectTree(e interface{}) *SubjectHandler_GetSubjectTree_Call {
+	return &SubjectHandler_GetSubjectTree_Call{Call: _e.mock.On("GetSubjectTree", e)}
+}
+
+func (_c *SubjectHandler_GetSubjectTree_Call) Run(run func(e echo.Context)) *SubjectHandler_GetSubjectTree_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(echo.Context))
+	})
+	return _c
+}
+
+func (_c *SubjectHandler_GetSubjectTree_Call) Return(_a0 error) *SubjectHandler_GetSubjectTree_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *SubjectHandler_GetSubjectTree_Call) RunAndReturn(run func(echo.Context) error) *SubjectHandler_GetSubjectTree_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListRelations provides a mock function with given fields: e
+func (_m *SubjectHandler) ListRelations(e echo.Context) error {
+	ret := _m.Called(e)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListRelations")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(echo.Context) error); ok {
+		r0 = rf(e)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SubjectHandler_ListRelations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListRelations'
+type SubjectHandler_ListRelations_Call struct {
+	*mock.Call
+}
+
+// ListRelations is a helper method to define mock.On call
+//   - e echo.Context
+func (_e *SubjectHandler_Expecter) ListRelations(e interface{}) *SubjectHandler_ListRelations_Call {
+	return &SubjectHandler_ListRelations_Call{Call: _e.mock.On("ListRelations", e)}
+}
+
+func (_c *SubjectHandler_ListRelations_Call) Run(run func(e echo.Context)) *SubjectHandler_ListRelations_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(echo.Context))
+	})
+	return _c
+}
+
+func (_c *SubjectHandler_ListRelations_Call) Return(_a0 error) *SubjectHandler_ListRelations_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *SubjectHandler_ListRelations_Call) RunAndReturn(run func(echo.Context) error) *SubjectHandler_ListRelations_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListSubjects provides a mock function with given fields: e
+func (_m *SubjectHandler) ListSubjects(e echo.Context) error {
+	ret := _m.Called(e)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListSubjects")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(echo.Context) error); ok {
+		r0 = rf(e)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SubjectHandler_ListSubjects_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListSubjects'
+type SubjectHandler_ListSubjects_Call struct {
+	*mock.Call
+}
+
+// ListSubjects is a helper method to define mock.On call
+//   - e echo.Context
+func (_e *SubjectHandler_Expecter) ListSubjects(e interface{}) *SubjectHandler_ListSubjects_Call {
+	return &SubjectHandler_ListSubjects_Call{Call: _e.mock.On("ListSubjects", e)}
+}
+
+func (_c *SubjectHandler_ListSubjects_Call) Run(run func(e echo.Context)) *SubjectHandler_ListSubjects_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(echo.Context))
+	})
+	return _c
+}
+
+func (_c *SubjectHandler_ListSubjects_Call) Return(_a0 error) *SubjectHandler_ListSubjects_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *SubjectHandler_ListSubjects_Call) RunAndReturn(run func(echo.Context) error) *SubjectHandler_ListSubjects_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UnarchiveSubject provides a mock function with given fields: e
+func (_m *SubjectHandler) UnarchiveSubject(e echo.Context) error {
+	ret := _m.Called(e)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UnarchiveSubject")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(echo.Context) error); ok {
+		r0 = rf(e)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SubjectHandler_UnarchiveSubject_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UnarchiveSubject'
+type SubjectHandler_UnarchiveSubject_Call struct {
+	*mock.Call
+}
+
+// UnarchiveSubject is a helper method to define mock.On call
+//   - e echo.Context
+func (_e *SubjectHandler_Expecter) UnarchiveSubject(e interface{}) *SubjectHandler_UnarchiveSubject_Call {
+	return &SubjectHandler_UnarchiveSubject_Call{Call: _e.mock.On("UnarchiveSubject", e)}
+}
+
+func (_c *SubjectHandler_UnarchiveSubject_Call) Run(run func(e echo.Context)) *SubjectHandler_UnarchiveSubject_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(echo.Context))
+	})
+	return _c
+}
+
+func (_c *SubjectHandler_UnarchiveSubject_Call) Return(_a0 error) *SubjectHandler_UnarchiveSubject_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *SubjectHandler_UnarchiveSubject_Call) RunAndReturn(run func(echo.Context) error) *SubjectHandler_UnarchiveSubject_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateSubject provides a mock function with given fields: e
+func (_m *SubjectHandler) UpdateSubject(e echo.Context) error {
+	ret := _m.Called(e)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateSubject")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(echo.Context) error); ok {
+		r0 = rf(e)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SubjectHandler_UpdateSubject_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateSubject'
+type SubjectHandler_UpdateSubject_Call struct {
+	*mock.Call
+}
+
+// UpdateSubject is a helper method to define mock.On call
+//   - e echo.Context
+func (_e *SubjectHandler_Expecter) UpdateSubject(e interface{}) *SubjectHandler_UpdateSubject_Call {
+	return &SubjectHandler_UpdateSubject_Call{Call: _e.mock.On("UpdateSubject", e)}
+}
+
+func (_c *SubjectHandler_UpdateSubject_Call) Run(run func(e echo.Context)) *SubjectHandler_UpdateSubject_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(echo.Context))
+	})
+	return _c
+}
+
+func (_c *SubjectHandler_UpdateSubject_Call) Return(_a0 error) *SubjectHandler_UpdateSubject_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *SubjectHandler_UpdateSubject_Call) RunAndReturn(run func(echo.Context) error) *SubjectHandler_UpdateSubject_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewSubjectHandler creates a new instance of SubjectHandler. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewSubjectHandler(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *SubjectHandler {
+	mock := &SubjectHandler{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
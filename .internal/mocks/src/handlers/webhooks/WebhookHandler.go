@@ -0,0 +1,357 @@
+// Code generated by mockery v2.53.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	echo "github.com/labstack/echo/v4"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// WebhookHandler is an autogenerated mock type for the WebhookHandler type
+type WebhookHandler struct {
+	mock.Mock
+}
+
+type WebhookHandler_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *WebhookHandler) EXPECT() *WebhookHandler_Expecter {
+	return &WebhookHandler_Expecter{mock: &_m.Mock}
+}
+
+// CreateWebhookEndpoint provides a mock function with given fields: e
+func (_m *WebhookHandler) CreateWebhookEndpoint(e echo.Context) error {
+	ret := _m.Called(e)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateWebhookEndpoint")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(echo.Context) error); ok {
+		r0 = rf(e)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// WebhookHandler_CreateWebhookEndpoint_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateWebhookEndpoint'
+type WebhookHandler_CreateWebhookEndpoint_Call struct {
+	*mock.Call
+}
+
+// CreateWebhookEndpoint is a helper method to define mock.On call
+//   - e echo.Context
+func (_e *WebhookHandler_Expecter) CreateWebhookEndpoint(e interface{}) *WebhookHandler_CreateWebhookEndpoint_Call {
+	return &WebhookHandler_CreateWebhookEndpoint_Call{Call: _e.mock.On("CreateWebhookEndpoint", e)}
+}
+
+func (_c *WebhookHandler_CreateWebhookEndpoint_Call) Run(run func(e echo.Context)) *WebhookHandler_CreateWebhookEndpoint_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(echo.Context))
+	})
+	return _c
+}
+
+func (_c *WebhookHandler_CreateWebhookEndpoint_Call) Return(_a0 error) *WebhookHandler_CreateWebhookEndpoint_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *WebhookHandler_CreateWebhookEndpoint_Call) RunAndReturn(run func(echo.Context) error) *WebhookHandler_CreateWebhookEndpoint_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteWebhookEndpoint provides a mock function with given fields: e
+func (_m *WebhookHandler) DeleteWebhookEndpoint(e echo.Context) error {
+	ret := _m.Called(e)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteWebhookEndpoint")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(echo.Context) error); ok {
+		r0 = rf(e)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// WebhookHandler_DeleteWebhookEndpoint_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteWebhookEndpoint'
+type WebhookHandler_DeleteWebhookEndpoint_Call struct {
+	*mock.Call
+}
+
+// DeleteWebhookEndpoint is a helper method to define mock.On call
+//   - e echo.Context
+func (_e *WebhookHandler_Expecter) DeleteWebhookEndpoint(e interface{}) *WebhookHandler_DeleteWebhookEndpoint_Call {
+	return &WebhookHandler_DeleteWebhookEndpoint_Call{Call: _e.mock.On("DeleteWebhookEndpoint", e)}
+}
+
+func (_c *WebhookHandler_DeleteWebhookEndpoint_Call) Run(run func(e echo.Context)) *WebhookHandler_DeleteWebhookEndpoint_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(echo.Context))
+	})
+	return _c
+}
+
+func (_c *WebhookHandler_DeleteWebhookEndpoint_Call) Return(_a0 error) *WebhookHandler_DeleteWebhookEndpoint_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *WebhookHandler_DeleteWebhookEndpoint_Call) RunAndReturn(run func(echo.Context) error) *WebhookHandler_DeleteWebhookEndpoint_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetWebhookEndpoint provides a mock function with given fields: e
+func (_m *WebhookHandler) GetWebhookEndpoint(e echo.Context) error {
+	ret := _m.Called(e)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetWebhookEndpoint")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(echo.Context) error); ok {
+		r0 = rf(e)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// WebhookHandler_GetWebhookEndpoint_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetWebhookEndpoint'
+type WebhookHandler_GetWebhookEndpoint_Call struct {
+	*mock.Call
+}
+
+// GetWebhookEndpoint is a helper method to define mock.On call
+//   - e echo.Context
+func (_e *WebhookHandler_Expecter) GetWebhookEndpoint(e interface{}) *WebhookHandler_GetWebhookEndpoint_Call {
+	return &WebhookHandler_GetWebhookEndpoint_Call{Call: _e.mock.On("GetWebhookEndpoint", e)}
+}
+
+func (_c *WebhookHandler_GetWebhookEndpoint_Call) Run(run func(e echo.Context)) *WebhookHandler_GetWebhookEndpoint_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(echo.Context))
+	})
+	return _c
+}
+
+func (_c *WebhookHandler_GetWebhookEndpoint_Call) Return(_a0 error) *WebhookHandler_GetWebhookEndpoint_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *WebhookHandler_GetWebhookEndpoint_Call) RunAndReturn(run func(echo.Context) error) *WebhookHandler_GetWebhookEndpoint_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListWebhookDeliveries provides a mock function with given fields: e
+func (_m *WebhookHandler) ListWebhookDeliveries(e echo.Context) error {
+	ret := _m.Called(e)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListWebhookDeliveries")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(echo.Context) error); ok {
+		r0 = rf(e)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// WebhookHandler_ListWebhookDeliveries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListWebhookDeliveries'
+type WebhookHandler_ListWebhookDeliveries_Call struct {
+	*mock.Call
+}
+
+// ListWebhookDeliveries is a helper method to define mock.On call
+//   - e echo.Context
+func (_e *WebhookHandler_Expecter) ListWebhookDeliveries(e interface{}) *WebhookHandler_ListWebhookDeliveries_Call {
+	return &WebhookHandler_ListWebhookDeliveries_Call{Call: _e.mock.On("ListWebhookDeliveries", e)}
+}
+
+func (_c *WebhookHandler_ListWebhookDeliveries_Call) Run(run func(e echo.Context)) *WebhookHandler_ListWebhookDeliveries_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(echo.Context))
+	})
+	return _c
+}
+
+func (_c *WebhookHandler_ListWebhookDeliveries_Call) Return(_a0 error) *WebhookHandler_ListWebhookDeliveries_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *WebhookHandler_ListWebhookDeliveries_Call) RunAndReturn(run func(echo.Context) error) *WebhookHandler_ListWebhookDeliveries_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListWebhookEndpoints provides a mock function with given fields: e
+func (_m *WebhookHandler) ListWebhookEndpoints(e echo.Context) error {
+	ret := _m.Called(e)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListWebhookEndpoints")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(echo.Context) error); ok {
+		r0 = rf(e)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// WebhookHandler_ListWebhookEndpoints_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListWebhookEndpoints'
+type WebhookHandler_ListWebhookEndpoints_Call struct {
+	*mock.Call
+}
+
+// ListWebhookEndpoints is a helper method to define mock.On call
+//   - e echo.Context
+func (_e *WebhookHandler_Expecter) ListWebhookEndpoints(e interface{}) *WebhookHandler_ListWebhookEndpoints_Call {
+	return &WebhookHandler_ListWebhookEndpoints_Call{Call: _e.mock.On("ListWebhookEndpoints", e)}
+}
+
+func (_c *WebhookHandler_ListWebhookEndpoints_Call) Run(run func(e echo.Context)) *WebhookHandler_ListWebhookEndpoints_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(echo.Context))
+	})
+	return _c
+}
+
+func (_c *WebhookHandler_ListWebhookEndpoints_Call) Return(_a0 error) *WebhookHandler_ListWebhookEndpoints_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *WebhookHandler_ListWebhookEndpoints_Call) RunAndReturn(run func(echo.Context) error) *WebhookHandler_ListWebhookEndpoints_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RedeliverWebhook provides a mock function with given fields: e
+func (_m *WebhookHandler) RedeliverWebhook(e echo.Context) error {
+	ret := _m.Called(e)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RedeliverWebhook")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(echo.Context) error); ok {
+		r0 = rf(e)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// WebhookHandler_RedeliverWebhook_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RedeliverWebhook'
+type WebhookHandler_RedeliverWebhook_Call struct {
+	*mock.Call
+}
+
+// RedeliverWebhook is a helper method to define mock.On call
+//   - e echo.Context
+func (_e *WebhookHandler_Expecter) RedeliverWebhook(e interface{}) *WebhookHandler_RedeliverWebhook_Call {
+	return &WebhookHandler_RedeliverWebhook_Call{Call: _e.mock.On("RedeliverWebhook", e)}
+}
+
+func (_c *WebhookHandler_RedeliverWebhook_Call) Run(run func(e echo.Context)) *WebhookHandler_RedeliverWebhook_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(echo.Context))
+	})
+	return _c
+}
+
+func (_c *WebhookHandler_RedeliverWebhook_Call) Return(_a0 error) *WebhookHandler_RedeliverWebhook_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *WebhookHandler_RedeliverWebhook_Call) RunAndReturn(run func(echo.Context) error) *WebhookHandler_RedeliverWebhook_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateWebhookEndpoint provides a mock function with given fields: e
+func (_m *WebhookHandler) UpdateWebhookEndpoint(e echo.Context) error {
+	ret := _m.Called(e)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateWebhookEndpoint")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(echo.Context) error); ok {
+		r0 = rf(e)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// WebhookHandler_UpdateWebhookEndpoint_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateWebhookEndpoint'
+type WebhookHandler_UpdateWebhookEndpoint_Call struct {
+	*mock.Call
+}
+
+// UpdateWebhookEndpoint is a helper method to define mock.On call
+//   - e echo.Context
+func (_e *WebhookHandler_Expecter) UpdateWebhookEndpoint(e interface{}) *WebhookHandler_UpdateWebhookEndpoint_Call {
+	return &WebhookHandler_UpdateWebhookEndpoint_Call{Call: _e.mock.On("UpdateWebhookEndpoint", e)}
+}
+
+func (_c *WebhookHandler_UpdateWebhookEndpoint_Call) Run(run func(e echo.Context)) *WebhookHandler_UpdateWebhookEndpoint_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(echo.Context))
+	})
+	return _c
+}
+
+func (_c *WebhookHandler_UpdateWebhookEndpoint_Call) Return(_a0 error) *WebhookHandler_UpdateWebhookEndpoint_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *WebhookHandler_UpdateWebhookEndpoint_Call) RunAndReturn(run func(echo.Context) error) *WebhookHandler_UpdateWebhookEndpoint_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewWebhookHandler creates a new instance of WebhookHandler. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewWebhookHandler(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *WebhookHandler {
+	mock := &WebhookHandler{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,326 @@
+// Code generated by mockery v2.53.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	feeds "go-api/src/models/feeds"
+
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// FeedRepository is an autogenerated mock type for the FeedRepository type
+type FeedRepository struct {
+	mock.Mock
+}
+
+type FeedRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *FeedRepository) EXPECT() *FeedRepository_Expecter {
+	return &FeedRepository_Expecter{mock: &_m.Mock}
+}
+
+// CreateFeedToken provides a mock function with given fields: ctx, userID, name, tokenHash, maxActiveTokens
+func (_m *FeedRepository) CreateFeedToken(ctx context.Context, userID uuid.UUID, name string, tokenHash string, maxActiveTokens int) (*feeds.FeedToken, error) {
+	ret := _m.Called(ctx, userID, name, tokenHash, maxActiveTokens)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateFeedToken")
+	}
+
+	var r0 *feeds.FeedToken
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, string, int) (*feeds.FeedToken, error)); ok {
+		return rf(ctx, userID, name, tokenHash, maxActiveTokens)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, string, int) *feeds.FeedToken); ok {
+		r0 = rf(ctx, userID, name, tokenHash, maxActiveTokens)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*feeds.FeedToken)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, string, string, int) error); ok {
+		r1 = rf(ctx, userID, name, tokenHash, maxActiveTokens)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FeedRepository_CreateFeedToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateFeedToken'
+type FeedRepository_CreateFeedToken_Call struct {
+	*mock.Call
+}
+
+// CreateFeedToken is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - name string
+//   - tokenHash string
+//   - maxActiveTokens int
+func (_e *FeedRepository_Expecter) CreateFeedToken(ctx interface{}, userID interface{}, name interface{}, tokenHash interface{}, maxActiveTokens interface{}) *FeedRepository_CreateFeedToken_Call {
+	return &FeedRepository_CreateFeedToken_Call{Call: _e.mock.On("CreateFeedToken", ctx, userID, name, tokenHash, maxActiveTokens)}
+}
+
+func (_c *FeedRepository_CreateFeedToken_Call) Run(run func(ctx context.Context, userID uuid.UUID, name string, tokenHash string, maxActiveTokens int)) *FeedRepository_CreateFeedToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string), args[3].(string), args[4].(int))
+	})
+	return _c
+}
+
+func (_c *FeedRepository_CreateFeedToken_Call) Return(_a0 *feeds.FeedToken, _a1 error) *FeedRepository_CreateFeedToken_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *FeedRepository_CreateFeedToken_Call) RunAndReturn(run func(context.Context, uuid.UUID, string, string, int) (*feeds.FeedToken, error)) *FeedRepository_CreateFeedToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetFeedState provides a mock function with given fields: ctx, userID
+func (_m *FeedRepository) GetFeedState(ctx context.Context, userID uuid.UUID) (*feeds.FeedState, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetFeedState")
+	}
+
+	var r0 *feeds.FeedState
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*feeds.FeedState, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *feeds.FeedState); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*feeds.FeedState)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FeedRepository_GetFeedState_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFeedState'
+type FeedRepository_GetFeedState_Call struct {
+	*mock.Call
+}
+
+// GetFeedState is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+func (_e *FeedRepository_Expecter) GetFeedState(ctx interface{}, userID interface{}) *FeedRepository_GetFeedState_Call {
+	return &FeedRepository_GetFeedState_Call{Call: _e.mock.On("GetFeedState", ctx, userID)}
+}
+
+func (_c *FeedRepository_GetFeedState_Call) Run(run func(ctx context.Context, userID uuid.UUID)) *FeedRepository_GetFeedState_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *FeedRepository_GetFeedState_Call) Return(_a0 *feeds.FeedState, _a1 error) *FeedRepository_GetFeedState_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *FeedRepository_GetFeedState_Call) RunAndReturn(run func(context.Context, uuid.UUID) (*feeds.FeedState, error)) *FeedRepository_GetFeedState_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListFeedTokens provides a mock function with given fields: ctx, userID
+func (_m *FeedRepository) ListFeedTokens(ctx context.Context, userID uuid.UUID) ([]feeds.FeedToken, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListFeedTokens")
+	}
+
+	var r0 []feeds.FeedToken
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]feeds.FeedToken, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []feeds.FeedToken); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]feeds.FeedToken)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FeedRepository_ListFeedTokens_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListFeedTokens'
+type FeedRepository_ListFeedTokens_Call struct {
+	*mock.Call
+}
+
+// ListFeedTokens is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+func (_e *FeedRepository_Expecter) ListFeedTokens(ctx interface{}, userID interface{}) *FeedRepository_ListFeedTokens_Call {
+	return &FeedRepository_ListFeedTokens_Call{Call: _e.mock.On("ListFeedTokens", ctx, userID)}
+}
+
+func (_c *FeedRepository_ListFeedTokens_Call) Run(run func(ctx context.Context, userID uuid.UUID)) *FeedRepository_ListFeedTokens_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *FeedRepository_ListFeedTokens_Call) Return(_a0 []feeds.FeedToken, _a1 error) *FeedRepository_ListFeedTokens_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *FeedRepository_ListFeedTokens_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]feeds.FeedToken, error)) *FeedRepository_ListFeedTokens_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RevokeFeedToken provides a mock function with given fields: ctx, userID, tokenID
+func (_m *FeedRepository) RevokeFeedToken(ctx context.Context, userID uuid.UUID, tokenID uuid.UUID) error {
+	ret := _m.Called(ctx, userID, tokenID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeFeedToken")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r0 = rf(ctx, userID, tokenID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FeedRepository_RevokeFeedToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeFeedToken'
+type FeedRepository_RevokeFeedToken_Call struct {
+	*mock.Call
+}
+
+// RevokeFeedToken is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - tokenID uuid.UUID
+func (_e *FeedRepository_Expecter) RevokeFeedToken(ctx interface{}, userID interface{}, tokenID interface{}) *FeedRepository_RevokeFeedToken_Call {
+	return &FeedRepository_RevokeFeedToken_Call{Call: _e.mock.On("RevokeFeedToken", ctx, userID, tokenID)}
+}
+
+func (_c *FeedRepository_RevokeFeedToken_Call) Run(run func(ctx context.Context, userID uuid.UUID, tokenID uuid.UUID)) *FeedRepository_RevokeFeedToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *FeedRepository_RevokeFeedToken_Call) Return(_a0 error) *FeedRepository_RevokeFeedToken_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *FeedRepository_RevokeFeedToken_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) error) *FeedRepository_RevokeFeedToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UseFeedToken provides a mock function with given fields: ctx, tokenHash
+func (_m *FeedRepository) UseFeedToken(ctx context.Context, tokenHash string) (uuid.UUID, error) {
+	ret := _m.Called(ctx, tokenHash)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UseFeedToken")
+	}
+
+	var r0 uuid.UUID
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (uuid.UUID, error)); ok {
+		return rf(ctx, tokenHash)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) uuid.UUID); ok {
+		r0 = rf(ctx, tokenHash)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(uuid.UUID)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, tokenHash)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FeedRepository_UseFeedToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UseFeedToken'
+type FeedRepository_UseFeedToken_Call struct {
+	*mock.Call
+}
+
+// UseFeedToken is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tokenHash string
+func (_e *FeedRepository_Expecter) UseFeedToken(ctx interface{}, tokenHash interface{}) *FeedRepository_UseFeedToken_Call {
+	return &FeedRepository_UseFeedToken_Call{Call: _e.mock.On("UseFeedToken", ctx, tokenHash)}
+}
+
+func (_c *FeedRepository_UseFeedToken_Call) Run(run func(ctx context.Context, tokenHash string)) *FeedRepository_UseFeedToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *FeedRepository_UseFeedToken_Call) Return(_a0 uuid.UUID, _a1 error) *FeedRepository_UseFeedToken_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *FeedRepository_UseFeedToken_Call) RunAndReturn(run func(context.Context, string) (uuid.UUID, error)) *FeedRepository_UseFeedToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewFeedRepository creates a new instance of FeedRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewFeedRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *FeedRepository {
+	mock := &FeedRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
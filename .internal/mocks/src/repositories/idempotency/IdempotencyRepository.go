@@ -0,0 +1,311 @@
+// Code generated by mockery v2.53.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	idempotency "go-api/src/models/idempotency"
+
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+
+	uuid "github.com/google/uuid"
+)
+
+// IdempotencyRepository is an autogenerated mock type for the IdempotencyRepository type
+type IdempotencyRepository struct {
+	mock.Mock
+}
+
+type IdempotencyRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *IdempotencyRepository) EXPECT() *IdempotencyRepository_Expecter {
+	return &IdempotencyRepository_Expecter{mock: &_m.Mock}
+}
+
+// GetKey provides a mock function with given fields: ctx, userID, key
+func (_m *IdempotencyRepository) GetKey(ctx context.Context, userID uuid.UUID, key string) (*idempotency.Record, error) {
+	ret := _m.Called(ctx, userID, key)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetKey")
+	}
+
+	var r0 *idempotency.Record
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) (*idempotency.Record, error)); ok {
+		return rf(ctx, userID, key)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) *idempotency.Record); ok {
+		r0 = rf(ctx, userID, key)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*idempotency.Record)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, string) error); ok {
+		r1 = rf(ctx, userID, key)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IdempotencyRepository_GetKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetKey'
+type IdempotencyRepository_GetKey_Call struct {
+	*mock.Call
+}
+
+// GetKey is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - key string
+func (_e *IdempotencyRepository_Expecter) GetKey(ctx interface{}, userID interface{}, key interface{}) *IdempotencyRepository_GetKey_Call {
+	return &IdempotencyRepository_GetKey_Call{Call: _e.mock.On("GetKey", ctx, userID, key)}
+}
+
+func (_c *IdempotencyRepository_GetKey_Call) Run(run func(ctx context.Context, userID uuid.UUID, key string)) *IdempotencyRepository_GetKey_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *IdempotencyRepository_GetKey_Call) Return(_a0 *idempotency.Record, _a1 error) *IdempotencyRepository_GetKey_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IdempotencyRepository_GetKey_Call) RunAndReturn(run func(context.Context, uuid.UUID, string) (*idempotency.Record, error)) *IdempotencyRepository_GetKey_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PurgeExpiredKeys provides a mock function with given fields: ctx, expiredBefore
+func (_m *IdempotencyRepository) PurgeExpiredKeys(ctx context.Context, expiredBefore time.Time) (int64, error) {
+	ret := _m.Called(ctx, expiredBefore)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PurgeExpiredKeys")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) (int64, error)); ok {
+		return rf(ctx, expiredBefore)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) int64); ok {
+		r0 = rf(ctx, expiredBefore)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = rf(ctx, expiredBefore)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IdempotencyRepository_PurgeExpiredKeys_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PurgeExpiredKeys'
+type IdempotencyRepository_PurgeExpiredKeys_Call struct {
+	*mock.Call
+}
+
+// PurgeExpiredKeys is a helper method to define mock.On call
+//   - ctx context.Context
+//   - expiredBefore time.Time
+func (_e *IdempotencyRepository_Expecter) PurgeExpiredKeys(ctx interface{}, expiredBefore interface{}) *IdempotencyRepository_PurgeExpiredKeys_Call {
+	return &IdempotencyRepository_PurgeExpiredKeys_Call{Call: _e.mock.On("PurgeExpiredKeys", ctx, expiredBefore)}
+}
+
+func (_c *IdempotencyRepository_PurgeExpiredKeys_Call) Run(run func(ctx context.Context, expiredBefore time.Time)) *IdempotencyRepository_PurgeExpiredKeys_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time))
+	})
+	return _c
+}
+
+func (_c *IdempotencyRepository_PurgeExpiredKeys_Call) Return(_a0 int64, _a1 error) *IdempotencyRepository_PurgeExpiredKeys_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IdempotencyRepository_PurgeExpiredKeys_Call) RunAndReturn(run func(context.Context, time.Time) (int64, error)) *IdempotencyRepository_PurgeExpiredKeys_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReleaseKey provides a mock function with given fields: ctx, record
+func (_m *IdempotencyRepository) ReleaseKey(ctx context.Context, record idempotency.Record) error {
+	ret := _m.Called(ctx, record)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReleaseKey")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, idempotency.Record) error); ok {
+		r0 = rf(ctx, record)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// IdempotencyRepository_ReleaseKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReleaseKey'
+type IdempotencyRepository_ReleaseKey_Call struct {
+	*mock.Call
+}
+
+// ReleaseKey is a helper method to define mock.On call
+//   - ctx context.Context
+//   - record idempotency.Record
+func (_e *IdempotencyRepository_Expecter) ReleaseKey(ctx interface{}, record interface{}) *IdempotencyRepository_ReleaseKey_Call {
+	return &IdempotencyRepository_ReleaseKey_Call{Call: _e.mock.On("ReleaseKey", ctx, record)}
+}
+
+func (_c *IdempotencyRepository_ReleaseKey_Call) Run(run func(ctx context.Context, record idempotency.Record)) *IdempotencyRepository_ReleaseKey_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(idempotency.Record))
+	})
+	return _c
+}
+
+func (_c *IdempotencyRepository_ReleaseKey_Call) Return(_a0 error) *IdempotencyRepository_ReleaseKey_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *IdempotencyRepository_ReleaseKey_Call) RunAndReturn(run func(context.Context, idempotency.Record) error) *IdempotencyRepository_ReleaseKey_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReserveKey provides a mock function with given fields: ctx, record, takeOverBefore
+func (_m *IdempotencyRepository) ReserveKey(ctx context.Context, record idempotency.Record, takeOverBefore time.Time) (bool, error) {
+	ret := _m.Called(ctx, record, takeOverBefore)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReserveKey")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, idempotency.Record, time.Time) (bool, error)); ok {
+		return rf(ctx, record, takeOverBefore)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, idempotency.Record, time.Time) bool); ok {
+		r0 = rf(ctx, record, takeOverBefore)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, idempotency.Record, time.Time) error); ok {
+		r1 = rf(ctx, record, takeOverBefore)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IdempotencyRepository_ReserveKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReserveKey'
+type IdempotencyRepository_ReserveKey_Call struct {
+	*mock.Call
+}
+
+// ReserveKey is a helper method to define mock.On call
+//   - ctx context.Context
+//   - record idempotency.Record
+//   - takeOverBefore time.Time
+func (_e *IdempotencyRepository_Expecter) ReserveKey(ctx interface{}, record interface{}, takeOverBefore interface{}) *IdempotencyRepository_ReserveKey_Call {
+	return &IdempotencyRepository_ReserveKey_Call{Call: _e.mock.On("ReserveKey", ctx, record, takeOverBefore)}
+}
+
+func (_c *IdempotencyRepository_ReserveKey_Call) Run(run func(ctx context.Context, record idempotency.Record, takeOverBefore time.Time)) *IdempotencyRepository_ReserveKey_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(idempotency.Record), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *IdempotencyRepository_ReserveKey_Call) Return(_a0 bool, _a1 error) *IdempotencyRepository_ReserveKey_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IdempotencyRepository_ReserveKey_Call) RunAndReturn(run func(context.Context, idempotency.Record, time.Time) (bool, error)) *IdempotencyRepository_ReserveKey_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SaveResponse provides a mock function with given fields: ctx, record, response
+func (_m *IdempotencyRepository) SaveResponse(ctx context.Context, record idempotency.Record, response idempotency.Response) error {
+	ret := _m.Called(ctx, record, response)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveResponse")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, idempotency.Record, idempotency.Response) error); ok {
+		r0 = rf(ctx, record, response)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// IdempotencyRepository_SaveResponse_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveResponse'
+type IdempotencyRepository_SaveResponse_Call struct {
+	*mock.Call
+}
+
+// SaveResponse is a helper method to define mock.On call
+//   - ctx context.Context
+//   - record idempotency.Record
+//   - response idempotency.Response
+func (_e *IdempotencyRepository_Expecter) SaveResponse(ctx interface{}, record interface{}, response interface{}) *IdempotencyRepository_SaveResponse_Call {
+	return &IdempotencyRepository_SaveResponse_Call{Call: _e.mock.On("SaveResponse", ctx, record, response)}
+}
+
+func (_c *IdempotencyRepository_SaveResponse_Call) Run(run func(ctx context.Context, record idempotency.Record, response idempotency.Response)) *IdempotencyRepository_SaveResponse_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(idempotency.Record), args[2].(idempotency.Response))
+	})
+	return _c
+}
+
+func (_c *IdempotencyRepository_SaveResponse_Call) Return(_a0 error) *IdempotencyRepository_SaveResponse_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *IdempotencyRepository_SaveResponse_Call) RunAndReturn(run func(context.Context, idempotency.Record, idempotency.Response) error) *IdempotencyRepository_SaveResponse_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewIdempotencyRepository creates a new instance of IdempotencyRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewIdempotencyRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *IdempotencyRepository {
+	mock := &IdempotencyRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
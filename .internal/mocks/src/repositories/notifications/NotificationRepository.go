@@ -0,0 +1,275 @@
+// Code generated by mockery v2.53.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	notifications "go-api/src/models/notifications"
+
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+
+	uuid "github.com/google/uuid"
+)
+
+// NotificationRepository is an autogenerated mock type for the NotificationRepository type
+type NotificationRepository struct {
+	mock.Mock
+}
+
+type NotificationRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *NotificationRepository) EXPECT() *NotificationRepository_Expecter {
+	return &NotificationRepository_Expecter{mock: &_m.Mock}
+}
+
+// CreateNotification provides a mock function with given fields: ctx, notification
+func (_m *NotificationRepository) CreateNotification(ctx context.Context, notification notifications.Notification) (*notifications.Notification, error) {
+	ret := _m.Called(ctx, notification)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateNotification")
+	}
+
+	var r0 *notifications.Notification
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, notifications.Notification) (*notifications.Notification, error)); ok {
+		return rf(ctx, notification)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, notifications.Notification) *notifications.Notification); ok {
+		r0 = rf(ctx, notification)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*notifications.Notification)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, notifications.Notification) error); ok {
+		r1 = rf(ctx, notification)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NotificationRepository_CreateNotification_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateNotification'
+type NotificationRepository_CreateNotification_Call struct {
+	*mock.Call
+}
+
+// CreateNotification is a helper method to define mock.On call
+//   - ctx context.Context
+//   - notification notifications.Notification
+func (_e *NotificationRepository_Expecter) CreateNotification(ctx interface{}, notification interface{}) *NotificationRepository_CreateNotification_Call {
+	return &NotificationRepository_CreateNotification_Call{Call: _e.mock.On("CreateNotification", ctx, notification)}
+}
+
+func (_c *NotificationRepository_CreateNotification_Call) Run(run func(ctx context.Context, notification notifications.Notification)) *NotificationRepository_CreateNotification_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(notifications.Notification))
+	})
+	return _c
+}
+
+func (_c *NotificationRepository_CreateNotification_Call) Return(_a0 *notifications.Notification, _a1 error) *NotificationRepository_CreateNotification_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *NotificationRepository_CreateNotification_Call) RunAndReturn(run func(context.Context, notifications.Notification) (*notifications.Notification, error)) *NotificationRepository_CreateNotification_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetLatestNotificationID provides a mock function with given fields: ctx, userID
+func (_m *NotificationRepository) GetLatestNotificationID(ctx context.Context, userID uuid.UUID) (int64, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLatestNotificationID")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (int64, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) int64); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NotificationRepository_GetLatestNotificationID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLatestNotificationID'
+type NotificationRepository_GetLatestNotificationID_Call struct {
+	*mock.Call
+}
+
+// GetLatestNotificationID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+func (_e *NotificationRepository_Expecter) GetLatestNotificationID(ctx interface{}, userID interface{}) *NotificationRepository_GetLatestNotificationID_Call {
+	return &NotificationRepository_GetLatestNotificationID_Call{Call: _e.mock.On("GetLatestNotificationID", ctx, userID)}
+}
+
+func (_c *NotificationRepository_GetLatestNotificationID_Call) Run(run func(ctx context.Context, userID uuid.UUID)) *NotificationRepository_GetLatestNotificationID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *NotificationRepository_GetLatestNotificationID_Call) Return(_a0 int64, _a1 error) *NotificationRepository_GetLatestNotificationID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *NotificationRepository_GetLatestNotificationID_Call) RunAndReturn(run func(context.Context, uuid.UUID) (int64, error)) *NotificationRepository_GetLatestNotificationID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListNotifications provides a mock function with given fields: ctx, userID, afterID, limit
+func (_m *NotificationRepository) ListNotifications(ctx context.Context, userID uuid.UUID, afterID int64, limit int) ([]notifications.Notification, error) {
+	ret := _m.Called(ctx, userID, afterID, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListNotifications")
+	}
+
+	var r0 []notifications.Notification
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int64, int) ([]notifications.Notification, error)); ok {
+		return rf(ctx, userID, afterID, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int64, int) []notifications.Notification); ok {
+		r0 = rf(ctx, userID, afterID, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]notifications.Notification)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, int64, int) error); ok {
+		r1 = rf(ctx, userID, afterID, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NotificationRepository_ListNotifications_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListNotifications'
+type NotificationRepository_ListNotifications_Call struct {
+	*mock.Call
+}
+
+// ListNotifications is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - afterID int64
+//   - limit int
+func (_e *NotificationRepository_Expecter) ListNotifications(ctx interface{}, userID interface{}, afterID interface{}, limit interface{}) *NotificationRepository_ListNotifications_Call {
+	return &NotificationRepository_ListNotifications_Call{Call: _e.mock.On("ListNotifications", ctx, userID, afterID, limit)}
+}
+
+func (_c *NotificationRepository_ListNotifications_Call) Run(run func(ctx context.Context, userID uuid.UUID, afterID int64, limit int)) *NotificationRepository_ListNotifications_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(int64), args[3].(int))
+	})
+	return _c
+}
+
+func (_c *NotificationRepository_ListNotifications_Call) Return(_a0 []notifications.Notification, _a1 error) *NotificationRepository_ListNotifications_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *NotificationRepository_ListNotifications_Call) RunAndReturn(run func(context.Context, uuid.UUID, int64, int) ([]notifications.Notification, error)) *NotificationRepository_ListNotifications_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PurgeNotifications provides a mock function with given fields: ctx, createdBefore
+func (_m *NotificationRepository) PurgeNotifications(ctx context.Context, createdBefore time.Time) (int64, error) {
+	ret := _m.Called(ctx, createdBefore)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PurgeNotifications")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) (int64, error)); ok {
+		return rf(ctx, createdBefore)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) int64); ok {
+		r0 = rf(ctx, createdBefore)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = rf(ctx, createdBefore)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NotificationRepository_PurgeNotifications_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PurgeNotifications'
+type NotificationRepository_PurgeNotifications_Call struct {
+	*mock.Call
+}
+
+// PurgeNotifications is a helper method to define mock.On call
+//   - ctx context.Context
+//   - createdBefore time.Time
+func (_e *NotificationRepository_Expecter) PurgeNotifications(ctx interface{}, createdBefore interface{}) *NotificationRepository_PurgeNotifications_Call {
+	return &NotificationRepository_PurgeNotifications_Call{Call: _e.mock.On("PurgeNotifications", ctx, createdBefore)}
+}
+
+func (_c *NotificationRepository_PurgeNotifications_Call) Run(run func(ctx context.Context, createdBefore time.Time)) *NotificationRepository_PurgeNotifications_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time))
+	})
+	return _c
+}
+
+func (_c *NotificationRepository_PurgeNotifications_Call) Return(_a0 int64, _a1 error) *NotificationRepository_PurgeNotifications_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *NotificationRepository_PurgeNotifications_Call) RunAndReturn(run func(context.Context, time.Time) (int64, error)) *NotificationRepository_PurgeNotifications_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewNotificationRepository creates a new instance of NotificationRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewNotificationRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *NotificationRepository {
+	mock := &NotificationRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,575 @@
+// Code generated by mockery v2.53.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	modelsstats "go-api/src/models/stats"
+
+	mock "github.com/stretchr/testify/mock"
+
+	studysession "go-api/src/models/studysession"
+
+	time "time"
+
+	uuid "github.com/google/uuid"
+)
+
+// StatsRepository is an autogenerated mock type for the StatsRepository type
+type StatsRepository struct {
+	mock.Mock
+}
+
+type StatsRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *StatsRepository) EXPECT() *StatsRepository_Expecter {
+	return &StatsRepository_Expecter{mock: &_m.Mock}
+}
+
+// GetAllDailyFocusedSeconds provides a mock function with given fields: ctx, userID, timezone
+func (_m *StatsRepository) GetAllDailyFocusedSeconds(ctx context.Context, userID uuid.UUID, timezone string) (map[time.Time]int64, error) {
+	ret := _m.Called(ctx, userID, timezone)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAllDailyFocusedSeconds")
+	}
+
+	var r0 map[time.Time]int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) (map[time.Time]int64, error)); ok {
+		return rf(ctx, userID, timezone)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) map[time.Time]int64); ok {
+		r0 = rf(ctx, userID, timezone)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[time.Time]int64)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, string) error); ok {
+		r1 = rf(ctx, userID, timezone)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StatsRepository_GetAllDailyFocusedSeconds_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAllDailyFocusedSeconds'
+type StatsRepository_GetAllDailyFocusedSeconds_Call struct {
+	*mock.Call
+}
+
+// GetAllDailyFocusedSeconds is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - timezone string
+func (_e *StatsRepository_Expecter) GetAllDailyFocusedSeconds(ctx interface{}, userID interface{}, timezone interface{}) *StatsRepository_GetAllDailyFocusedSeconds_Call {
+	return &StatsRepository_GetAllDailyFocusedSeconds_Call{Call: _e.mock.On("GetAllDailyFocusedSeconds", ctx, userID, timezone)}
+}
+
+func (_c *StatsRepository_GetAllDailyFocusedSeconds_Call) Run(run func(ctx context.Context, userID uuid.UUID, timezone string)) *StatsRepository_GetAllDailyFocusedSeconds_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *StatsRepository_GetAllDailyFocusedSeconds_Call) Return(_a0 map[time.Time]int64, _a1 error) *StatsRepository_GetAllDailyFocusedSeconds_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *StatsRepository_GetAllDailyFocusedSeconds_Call) RunAndReturn(run func(context.Context, uuid.UUID, string) (map[time.Time]int64, error)) *StatsRepository_GetAllDailyFocusedSeconds_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDailyFocusedSeconds provides a mock function with given fields: ctx, userID, timezone, fromDate, toDate
+func (_m *StatsRepository) GetDailyFocusedSeconds(ctx context.Context, userID uuid.UUID, timezone string, fromDate time.Time, toDate time.Time) (map[time.Time]int64, error) {
+	ret := _m.Called(ctx, userID, timezone, fromDate, toDate)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDailyFocusedSeconds")
+	}
+
+	var r0 map[time.Time]int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, time.Time, time.Time) (map[time.Time]int64, error)); ok {
+		return rf(ctx, userID, timezone, fromDate, toDate)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, time.Time, time.Time) map[time.Time]int64); ok {
+		r0 = rf(ctx, userID, timezone, fromDate, toDate)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[time.Time]int64)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, string, time.Time, time.Time) error); ok {
+		r1 = rf(ctx, userID, timezone, fromDate, toDate)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StatsRepository_GetDailyFocusedSeconds_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDailyFocusedSeconds'
+type StatsRepository_GetDailyFocusedSeconds_Call struct {
+	*mock.Call
+}
+
+// GetDailyFocusedSeconds is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - timezone string
+//   - fromDate time.Time
+//   - toDate time.Time
+func (_e *StatsRepository_Expecter) GetDailyFocusedSeconds(ctx interface{}, userID interface{}, timezone interface{}, fromDate interface{}, toDate interface{}) *StatsRepository_GetDailyFocusedSeconds_Call {
+	return &StatsRepository_GetDailyFocusedSeconds_Call{Call: _e.mock.On("GetDailyFocusedSeconds", ctx, userID, timezone, fromDate, toDate)}
+}
+
+func (_c *StatsRepository_GetDailyFocusedSeconds_Call) Run(run func(ctx context.Context, userID uuid.UUID, timezone string, fromDate time.Time, toDate time.Time)) *StatsRepository_GetDailyFocusedSeconds_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string), args[3].(time.Time), args[4].(time.Time))
+	})
+	return _c
+}
+
+func (_c *StatsRepository_GetDailyFocusedSeconds_Call) Return(_a0 map[time.Time]int64, _a1 error) *StatsRepository_GetDailyFocusedSeconds_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *StatsRepository_GetDailyFocusedSeconds_Call) RunAndReturn(run func(context.Context, uuid.UUID, string, time.Time, time.Time) (map[time.Time]int64, error)) *StatsRepository_GetDailyFocusedSeconds_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSessionDailyFocusedSeconds provides a mock function with given fields: ctx, sessionID, timezone
+func (_m *StatsRepository) GetSessionDailyFocusedSeconds(ctx context.Context, sessionID uuid.UUID, timezone string) (map[time.Time]int64, error) {
+	ret := _m.Called(ctx, sessionID, timezone)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSessionDailyFocusedSeconds")
+	}
+
+	var r0 map[time.Time]int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) (map[time.Time]int64, error)); ok {
+		return rf(ctx, sessionID, timezone)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) map[time.Time]int64); ok {
+		r0 = rf(ctx, sessionID, timezone)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[time.Time]int64)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, string) error); ok {
+		r1 = rf(ctx, sessionID, timezone)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StatsRepository_GetSessionDailyFocusedSeconds_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSessionDailyFocusedSeconds'
+type StatsRepository_GetSessionDailyFocusedSeconds_Call struct {
+	*mock.Call
+}
+
+// GetSessionDailyFocusedSeconds is a helper method to define mock.On call
+//   - ctx context.Context
+//   - sessionID uuid.UUID
+//   - timezone string
+func (_e *StatsRepository_Expecter) GetSessionDailyFocusedSeconds(ctx interface{}, sessionID interface{}, timezone interface{}) *StatsRepository_GetSessionDailyFocusedSeconds_Call {
+	return &StatsRepository_GetSessionDailyFocusedSeconds_Call{Call: _e.mock.On("GetSessionDailyFocusedSeconds", ctx, sessionID, timezone)}
+}
+
+func (_c *StatsRepository_GetSessionDailyFocusedSeconds_Call) Run(run func(ctx context.Context, sessionID uuid.UUID, timezone string)) *StatsRepository_GetSessionDailyFocusedSeconds_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *StatsRepository_GetSessionDailyFocusedSeconds_Call) Return(_a0 map[time.Time]int64, _a1 error) *StatsRepository_GetSessionDailyFocusedSeconds_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *StatsRepository_GetSessionDailyFocusedSeconds_Call) RunAndReturn(run func(context.Context, uuid.UUID, string) (map[time.Time]int64, error)) *StatsRepository_GetSessionDailyFocusedSeconds_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetStreakSettings provides a mock function with given fields: ctx, userID
+func (_m *StatsRepository) GetStreakSettings(ctx context.Context, userID uuid.UUID) (*modelsstats.StreakSettings, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetStreakSettings")
+	}
+
+	var r0 *modelsstats.StreakSettings
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*modelsstats.StreakSettings, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *modelsstats.StreakSettings); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*modelsstats.StreakSettings)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StatsRepository_GetStreakSettings_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetStreakSettings'
+type StatsRepository_GetStreakSettings_Call struct {
+	*mock.Call
+}
+
+// GetStreakSettings is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+func (_e *StatsRepository_Expecter) GetStreakSettings(ctx interface{}, userID interface{}) *StatsRepository_GetStreakSettings_Call {
+	return &StatsRepository_GetStreakSettings_Call{Call: _e.mock.On("GetStreakSettings", ctx, userID)}
+}
+
+func (_c *StatsRepository_GetStreakSettings_Call) Run(run func(ctx context.Context, userID uuid.UUID)) *StatsRepository_GetStreakSettings_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *StatsRepository_GetStreakSettings_Call) Return(_a0 *modelsstats.StreakSettings, _a1 error) *StatsRepository_GetStreakSettings_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *StatsRepository_GetStreakSettings_Call) RunAndReturn(run func(context.Context, uuid.UUID) (*modelsstats.StreakSettings, error)) *StatsRepository_GetStreakSettings_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListAllSessionEventsWithoutRollups provides a mock function with given fields: ctx, userID, timezone
+func (_m *StatsRepository) ListAllSessionEventsWithoutRollups(ctx context.Context, userID uuid.UUID, timezone string) (map[uuid.UUID][]studysession.SessionEvent, error) {
+	ret := _m.Called(ctx, userID, timezone)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListAllSessionEventsWithoutRollups")
+	}
+
+	var r0 map[uuid.UUID][]studysession.SessionEvent
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) (map[uuid.UUID][]studysession.SessionEvent, error)); ok {
+		return rf(ctx, userID, timezone)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) map[uuid.UUID][]studysession.SessionEvent); ok {
+		r0 = rf(ctx, userID, timezone)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[uuid.UUID][]studysession.SessionEvent)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, string) error); ok {
+		r1 = rf(ctx, userID, timezone)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StatsRepository_ListAllSessionEventsWithoutRollups_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListAllSessionEventsWithoutRollups'
+type StatsRepository_ListAllSessionEventsWithoutRollups_Call struct {
+	*mock.Call
+}
+
+// ListAllSessionEventsWithoutRollups is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - timezone string
+func (_e *StatsRepository_Expecter) ListAllSessionEventsWithoutRollups(ctx interface{}, userID interface{}, timezone interface{}) *StatsRepository_ListAllSessionEventsWithoutRollups_Call {
+	return &StatsRepository_ListAllSessionEventsWithoutRollups_Call{Call: _e.mock.On("ListAllSessionEventsWithoutRollups", ctx, userID, timezone)}
+}
+
+func (_c *StatsRepository_ListAllSessionEventsWithoutRollups_Call) Run(run func(ctx context.Context, userID uuid.UUID, timezone string)) *StatsRepository_ListAllSessionEventsWithoutRollups_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *StatsRepository_ListAllSessionEventsWithoutRollups_Call) Return(_a0 map[uuid.UUID][]studysession.SessionEvent, _a1 error) *StatsRepository_ListAllSessionEventsWithoutRollups_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *StatsRepository_ListAllSessionEventsWithoutRollups_Call) RunAndReturn(run func(context.Context, uuid.UUID, string) (map[uuid.UUID][]studysession.SessionEvent, error)) *StatsRepository_ListAllSessionEventsWithoutRollups_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListCompletedSessionEvents provides a mock function with given fields: ctx, userID, startedFrom, startedBefore
+func (_m *StatsRepository) ListCompletedSessionEvents(ctx context.Context, userID uuid.UUID, startedFrom time.Time, startedBefore time.Time) (map[uuid.UUID][]studysession.SessionEvent, error) {
+	ret := _m.Called(ctx, userID, startedFrom, startedBefore)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListCompletedSessionEvents")
+	}
+
+	var r0 map[uuid.UUID][]studysession.SessionEvent
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, time.Time, time.Time) (map[uuid.UUID][]studysession.SessionEvent, error)); ok {
+		return rf(ctx, userID, startedFrom, startedBefore)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, time.Time, time.Time) map[uuid.UUID][]studysession.SessionEvent); ok {
+		r0 = rf(ctx, userID, startedFrom, startedBefore)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[uuid.UUID][]studysession.SessionEvent)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, time.Time, time.Time) error); ok {
+		r1 = rf(ctx, userID, startedFrom, startedBefore)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StatsRepository_ListCompletedSessionEvents_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListCompletedSessionEvents'
+type StatsRepository_ListCompletedSessionEvents_Call struct {
+	*mock.Call
+}
+
+// ListCompletedSessionEvents is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - startedFrom time.Time
+//   - startedBefore time.Time
+func (_e *StatsRepository_Expecter) ListCompletedSessionEvents(ctx interface{}, userID interface{}, startedFrom interface{}, startedBefore interface{}) *StatsRepository_ListCompletedSessionEvents_Call {
+	return &StatsRepository_ListCompletedSessionEvents_Call{Call: _e.mock.On("ListCompletedSessionEvents", ctx, userID, startedFrom, startedBefore)}
+}
+
+func (_c *StatsRepository_ListCompletedSessionEvents_Call) Run(run func(ctx context.Context, userID uuid.UUID, startedFrom time.Time, startedBefore time.Time)) *StatsRepository_ListCompletedSessionEvents_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(time.Time), args[3].(time.Time))
+	})
+	return _c
+}
+
+func (_c *StatsRepository_ListCompletedSessionEvents_Call) Return(_a0 map[uuid.UUID][]studysession.SessionEvent, _a1 error) *StatsRepository_ListCompletedSessionEvents_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *StatsRepository_ListCompletedSessionEvents_Call) RunAndReturn(run func(context.Context, uuid.UUID, time.Time, time.Time) (map[uuid.UUID][]studysession.SessionEvent, error)) *StatsRepository_ListCompletedSessionEvents_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListSessionEventsWithoutRollups provides a mock function with given fields: ctx, userID, timezone, fromDate, toDate
+func (_m *StatsRepository) ListSessionEventsWithoutRollups(ctx context.Context, userID uuid.UUID, timezone string, fromDate time.Time, toDate time.Time) (map[uuid.UUID][]studysession.SessionEvent, error) {
+	ret := _m.Called(ctx, userID, timezone, fromDate, toDate)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListSessionEventsWithoutRollups")
+	}
+
+	var r0 map[uuid.UUID][]studysession.SessionEvent
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, time.Time, time.Time) (map[uuid.UUID][]studysession.SessionEvent, error)); ok {
+		return rf(ctx, userID, timezone, fromDate, toDate)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, time.Time, time.Time) map[uuid.UUID][]studysession.SessionEvent); ok {
+		r0 = rf(ctx, userID, timezone, fromDate, toDate)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[uuid.UUID][]studysession.SessionEvent)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, string, time.Time, time.Time) error); ok {
+		r1 = rf(ctx, userID, timezone, fromDate, toDate)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StatsRepository_ListSessionEventsWithoutRollups_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListSessionEventsWithoutRollups'
+type StatsRepository_ListSessionEventsWithoutRollups_Call struct {
+	*mock.Call
+}
+
+// ListSessionEventsWithoutRollups is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - timezone string
+//   - fromDate time.Time
+//   - toDate time.Time
+func (_e *StatsRepository_Expecter) ListSessionEventsWithoutRollups(ctx interface{}, userID interface{}, timezone interface{}, fromDate interface{}, toDate interface{}) *StatsRepository_ListSessionEventsWithoutRollups_Call {
+	return &StatsRepository_ListSessionEventsWithoutRollups_Call{Call: _e.mock.On("ListSessionEventsWithoutRollups", ctx, userID, timezone, fromDate, toDate)}
+}
+
+func (_c *StatsRepository_ListSessionEventsWithoutRollups_Call) Run(run func(ctx context.Context, userID uuid.UUID, timezone string, fromDate time.Time, toDate time.Time)) *StatsRepository_ListSessionEventsWithoutRollups_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string), args[3].(time.Time), args[4].(time.Time))
+	})
+	return _c
+}
+
+func (_c *StatsRepository_ListSessionEventsWithoutRollups_Call) Return(_a0 map[uuid.UUID][]studysession.SessionEvent, _a1 error) *StatsRepository_ListSessionEventsWithoutRollups_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *StatsRepository_ListSessionEventsWithoutRollups_Call) RunAndReturn(run func(context.Context, uuid.UUID, string, time.Time, time.Time) (map[uuid.UUID][]studysession.SessionEvent, error)) *StatsRepository_ListSessionEventsWithoutRollups_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SaveSessionDayRollups provides a mock function with given fields: ctx, timezone, rollups
+func (_m *StatsRepository) SaveSessionDayRollups(ctx context.Context, timezone string, rollups []modelsstats.SessionDayRollup) error {
+	ret := _m.Called(ctx, timezone, rollups)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveSessionDayRollups")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, []modelsstats.SessionDayRollup) error); ok {
+		r0 = rf(ctx, timezone, rollups)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// StatsRepository_SaveSessionDayRollups_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveSessionDayRollups'
+type StatsRepository_SaveSessionDayRollups_Call struct {
+	*mock.Call
+}
+
+// SaveSessionDayRollups is a helper method to define mock.On call
+//   - ctx context.Context
+//   - timezone string
+//   - rollups []modelsstats.SessionDayRollup
+func (_e *StatsRepository_Expecter) SaveSessionDayRollups(ctx interface{}, timezone interface{}, rollups interface{}) *StatsRepository_SaveSessionDayRollups_Call {
+	return &StatsRepository_SaveSessionDayRollups_Call{Call: _e.mock.On("SaveSessionDayRollups", ctx, timezone, rollups)}
+}
+
+func (_c *StatsRepository_SaveSessionDayRollups_Call) Run(run func(ctx context.Context, timezone string, rollups []modelsstats.SessionDayRollup)) *StatsRepository_SaveSessionDayRollups_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].([]modelsstats.SessionDayRollup))
+	})
+	return _c
+}
+
+func (_c *StatsRepository_SaveSessionDayRollups_Call) Return(_a0 error) *StatsRepository_SaveSessionDayRollups_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *StatsRepository_SaveSessionDayRollups_Call) RunAndReturn(run func(context.Context, string, []modelsstats.SessionDayRollup) error) *StatsRepository_SaveSessionDayRollups_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpsertStreakSettings provides a mock function with given fields: ctx, userID, settings
+func (_m *StatsRepository) UpsertStreakSettings(ctx context.Context, userID uuid.UUID, settings modelsstats.StreakSettings) (*modelsstats.StreakSettings, error) {
+	ret := _m.Called(ctx, userID, settings)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpsertStreakSettings")
+	}
+
+	var r0 *modelsstats.StreakSettings
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, modelsstats.StreakSettings) (*modelsstats.StreakSettings, error)); ok {
+		return rf(ctx, userID, settings)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, modelsstats.StreakSettings) *modelsstats.StreakSettings); ok {
+		r0 = rf(ctx, userID, settings)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*modelsstats.StreakSettings)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, modelsstats.StreakSettings) error); ok {
+		r1 = rf(ctx, userID, settings)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StatsRepository_UpsertStreakSettings_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpsertStreakSettings'
+type StatsRepository_UpsertStreakSettings_Call struct {
+	*mock.Call
+}
+
+// UpsertStreakSettings is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - settings modelsstats.StreakSettings
+func (_e *StatsRepository_Expecter) UpsertStreakSettings(ctx interface{}, userID interface{}, settings interface{}) *StatsRepository_UpsertStreakSettings_Call {
+	return &StatsRepository_UpsertStreakSettings_Call{Call: _e.mock.On("UpsertStreakSettings", ctx, userID, settings)}
+}
+
+func (_c *StatsRepository_UpsertStreakSettings_Call) Run(run func(ctx context.Context, userID uuid.UUID, settings modelsstats.StreakSettings)) *StatsRepository_UpsertStreakSettings_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(modelsstats.StreakSettings))
+	})
+	return _c
+}
+
+func (_c *StatsRepository_UpsertStreakSettings_Call) Return(_a0 *modelsstats.StreakSettings, _a1 error) *StatsRepository_UpsertStreakSettings_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *StatsRepository_UpsertStreakSettings_Call) RunAndReturn(run func(context.Context, uuid.UUID, modelsstats.StreakSettings) (*modelsstats.StreakSettings, error)) *StatsRepository_UpsertStreakSettings_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewStatsRepository creates a new instance of StatsRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewStatsRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *StatsRepository {
+	mock := &StatsRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -9,6 +9,8 @@ import (
 
 	studysession "go-api/src/models/studysession"
 
+	time "time"
+
 	uuid "github.com/google/uuid"
 )
 
@@ -25,21 +27,81 @@ func (_m *StudySessionRepository) EXPECT() *StudySessionRepository_Expecter {
 	return &StudySessionRepository_Expecter{mock: &_m.Mock}
 }
 
-// GetUserStudySession provides a mock function with given fields: ctx, sessionID
-func (_m *StudySessionRepository) GetUserStudySession(ctx context.Context, sessionID uuid.UUID) (*studysession.StudySession, error) {
-	ret := _m.Called(ctx, sessionID)
+// AddActiveStudySessionEvents provides a mock function with given fields: ctx, userID, events
+func (_m *StudySessionRepository) AddActiveStudySessionEvents(ctx context.Context, userID uuid.UUID, events []studysession.SessionEvent) (*studysession.SessionEventSync, error) {
+	ret := _m.Called(ctx, userID, events)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddActiveStudySessionEvents")
+	}
+
+	var r0 *studysession.SessionEventSync
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, []studysession.SessionEvent) (*studysession.SessionEventSync, error)); ok {
+		return rf(ctx, userID, events)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, []studysession.SessionEvent) *studysession.SessionEventSync); ok {
+		r0 = rf(ctx, userID, events)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*studysession.SessionEventSync)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, []studysession.SessionEvent) error); ok {
+		r1 = rf(ctx, userID, events)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StudySessionRepository_AddActiveStudySessionEvents_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddActiveStudySessionEvents'
+type StudySessionRepository_AddActiveStudySessionEvents_Call struct {
+	*mock.Call
+}
+
+// AddActiveStudySessionEvents is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - events []studysession.SessionEvent
+func (_e *StudySessionRepository_Expecter) AddActiveStudySessionEvents(ctx interface{}, userID interface{}, events interface{}) *StudySessionRepository_AddActiveStudySessionEvents_Call {
+	return &StudySessionRepository_AddActiveStudySessionEvents_Call{Call: _e.mock.On("AddActiveStudySessionEvents", ctx, userID, events)}
+}
+
+func (_c *StudySessionRepository_AddActiveStudySessionEvents_Call) Run(run func(ctx context.Context, userID uuid.UUID, events []studysession.SessionEvent)) *StudySessionRepository_AddActiveStudySessionEvents_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].([]studysession.SessionEvent))
+	})
+	return _c
+}
+
+func (_c *StudySessionRepository_AddActiveStudySessionEvents_Call) Return(_a0 *studysession.SessionEventSync, _a1 error) *StudySessionRepository_AddActiveStudySessionEvents_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *StudySessionRepository_AddActiveStudySessionEvents_Call) RunAndReturn(run func(context.Context, uuid.UUID, []studysession.SessionEvent) (*studysession.SessionEventSync, error)) *StudySessionRepository_AddActiveStudySessionEvents_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CancelActiveStudySession provides a mock function with given fields: ctx, userID
+func (_m *StudySessionRepository) CancelActiveStudySession(ctx context.Context, userID uuid.UUID) (*studysession.StudySession, error) {
+	ret := _m.Called(ctx, userID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetUserStudySession")
+		panic("no return value specified for CancelActiveStudySession")
 	}
 
 	var r0 *studysession.StudySession
 	var r1 error
 	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*studysession.StudySession, error)); ok {
-		return rf(ctx, sessionID)
+		return rf(ctx, userID)
 	}
 	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *studysession.StudySession); ok {
-		r0 = rf(ctx, sessionID)
+		r0 = rf(ctx, userID)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*studysession.StudySession)
@@ -47,7 +109,7 @@ func (_m *StudySessionRepository) GetUserStudySession(ctx context.Context, sessi
 	}
 
 	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
-		r1 = rf(ctx, sessionID)
+		r1 = rf(ctx, userID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -55,53 +117,342 @@ func (_m *StudySessionRepository) GetUserStudySession(ctx context.Context, sessi
 	return r0, r1
 }
 
-// StudySessionRepository_GetUserStudySession_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserStudySession'
-type StudySessionRepository_GetUserStudySession_Call struct {
+// StudySessionRepository_CancelActiveStudySession_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CancelActiveStudySession'
+type StudySessionRepository_CancelActiveStudySession_Call struct {
 	*mock.Call
 }
 
-// GetUserStudySession is a helper method to define mock.On call
+// CancelActiveStudySession is a helper method to define mock.On call
 //   - ctx context.Context
-//   - sessionID uuid.UUID
-func (_e *StudySessionRepository_Expecter) GetUserStudySession(ctx interface{}, sessionID interface{}) *StudySessionRepository_GetUserStudySession_Call {
-	return &StudySessionRepository_GetUserStudySession_Call{Call: _e.mock.On("GetUserStudySession", ctx, sessionID)}
+//   - userID uuid.UUID
+func (_e *StudySessionRepository_Expecter) CancelActiveStudySession(ctx interface{}, userID interface{}) *StudySessionRepository_CancelActiveStudySession_Call {
+	return &StudySessionRepository_CancelActiveStudySession_Call{Call: _e.mock.On("CancelActiveStudySession", ctx, userID)}
 }
 
-func (_c *StudySessionRepository_GetUserStudySession_Call) Run(run func(ctx context.Context, sessionID uuid.UUID)) *StudySessionRepository_GetUserStudySession_Call {
+func (_c *StudySessionRepository_CancelActiveStudySession_Call) Run(run func(ctx context.Context, userID uuid.UUID)) *StudySessionRepository_CancelActiveStudySession_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run(args[0].(context.Context), args[1].(uuid.UUID))
 	})
 	return _c
 }
 
-func (_c *StudySessionRepository_GetUserStudySession_Call) Return(_a0 *studysession.StudySession, _a1 error) *StudySessionRepository_GetUserStudySession_Call {
+func (_c *StudySessionRepository_CancelActiveStudySession_Call) Return(_a0 *studysession.StudySession, _a1 error) *StudySessionRepository_CancelActiveStudySession_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *StudySessionRepository_GetUserStudySession_Call) RunAndReturn(run func(context.Context, uuid.UUID) (*studysession.StudySession, error)) *StudySessionRepository_GetUserStudySession_Call {
+func (_c *StudySessionRepository_CancelActiveStudySession_Call) RunAndReturn(run func(context.Context, uuid.UUID) (*studysession.StudySession, error)) *StudySessionRepository_CancelActiveStudySession_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetUserStudySessions provides a mock function with given fields: ctx, userID
-func (_m *StudySessionRepository) GetUserStudySessions(ctx context.Context, userID uuid.UUID) ([]studysession.StudySession, error) {
-	ret := _m.Called(ctx, userID)
+// CloseStaleStudySessions provides a mock function with given fields: ctx, idleBefore, action, limit
+func (_m *StudySessionRepository) CloseStaleStudySessions(ctx context.Context, idleBefore time.Time, action studysession.StaleSessionAction, limit int) ([]studysession.StudySession, error) {
+	ret := _m.Called(ctx, idleBefore, action, limit)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetUserStudySessions")
+		panic("no return value specified for CloseStaleStudySessions")
 	}
 
 	var r0 []studysession.StudySession
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]studysession.StudySession, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, studysession.StaleSessionAction, int) ([]studysession.StudySession, error)); ok {
+		return rf(ctx, idleBefore, action, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, studysession.StaleSessionAction, int) []studysession.StudySession); ok {
+		r0 = rf(ctx, idleBefore, action, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]studysession.StudySession)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time, studysession.StaleSessionAction, int) error); ok {
+		r1 = rf(ctx, idleBefore, action, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StudySessionRepository_CloseStaleStudySessions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CloseStaleStudySessions'
+type StudySessionRepository_CloseStaleStudySessions_Call struct {
+	*mock.Call
+}
+
+// CloseStaleStudySessions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - idleBefore time.Time
+//   - action studysession.StaleSessionAction
+//   - limit int
+func (_e *StudySessionRepository_Expecter) CloseStaleStudySessions(ctx interface{}, idleBefore interface{}, action interface{}, limit interface{}) *StudySessionRepository_CloseStaleStudySessions_Call {
+	return &StudySessionRepository_CloseStaleStudySessions_Call{Call: _e.mock.On("CloseStaleStudySessions", ctx, idleBefore, action, limit)}
+}
+
+func (_c *StudySessionRepository_CloseStaleStudySessions_Call) Run(run func(ctx context.Context, idleBefore time.Time, action studysession.StaleSessionAction, limit int)) *StudySessionRepository_CloseStaleStudySessions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time), args[2].(studysession.StaleSessionAction), args[3].(int))
+	})
+	return _c
+}
+
+func (_c *StudySessionRepository_CloseStaleStudySessions_Call) Return(_a0 []studysession.StudySession, _a1 error) *StudySessionRepository_CloseStaleStudySessions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *StudySessionRepository_CloseStaleStudySessions_Call) RunAndReturn(run func(context.Context, time.Time, studysession.StaleSessionAction, int) ([]studysession.StudySession, error)) *StudySessionRepository_CloseStaleStudySessions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateManualStudySession provides a mock function with given fields: ctx, session, events
+func (_m *StudySessionRepository) CreateManualStudySession(ctx context.Context, session studysession.StudySession, events []studysession.SessionEvent) (*studysession.StudySession, error) {
+	ret := _m.Called(ctx, session, events)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateManualStudySession")
+	}
+
+	var r0 *studysession.StudySession
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, studysession.StudySession, []studysession.SessionEvent) (*studysession.StudySession, error)); ok {
+		return rf(ctx, session, events)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, studysession.StudySession, []studysession.SessionEvent) *studysession.StudySession); ok {
+		r0 = rf(ctx, session, events)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*studysession.StudySession)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, studysession.StudySession, []studysession.SessionEvent) error); ok {
+		r1 = rf(ctx, session, events)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StudySessionRepository_CreateManualStudySession_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateManualStudySession'
+type StudySessionRepository_CreateManualStudySession_Call struct {
+	*mock.Call
+}
+
+// CreateManualStudySession is a helper method to define mock.On call
+//   - ctx context.Context
+//   - session studysession.StudySession
+//   - events []studysession.SessionEvent
+func (_e *StudySessionRepository_Expecter) CreateManualStudySession(ctx interface{}, session interface{}, events interface{}) *StudySessionRepository_CreateManualStudySession_Call {
+	return &StudySessionRepository_CreateManualStudySession_Call{Call: _e.mock.On("CreateManualStudySession", ctx, session, events)}
+}
+
+func (_c *StudySessionRepository_CreateManualStudySession_Call) Run(run func(ctx context.Context, session studysession.StudySession, events []studysession.SessionEvent)) *StudySessionRepository_CreateManualStudySession_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(studysession.StudySession), args[2].([]studysession.SessionEvent))
+	})
+	return _c
+}
+
+func (_c *StudySessionRepository_CreateManualStudySession_Call) Return(_a0 *studysession.StudySession, _a1 error) *StudySessionRepository_CreateManualStudySession_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *StudySessionRepository_CreateManualStudySession_Call) RunAndReturn(run func(context.Context, studysession.StudySession, []studysession.SessionEvent) (*studysession.StudySession, error)) *StudySessionRepository_CreateManualStudySession_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateStudySession provides a mock function with given fields: ctx, session, startTime
+func (_m *StudySessionRepository) CreateStudySession(ctx context.Context, session studysession.StudySession, startTime time.Time) (*studysession.StudySession, error) {
+	ret := _m.Called(ctx, session, startTime)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateStudySession")
+	}
+
+	var r0 *studysession.StudySession
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, studysession.StudySession, time.Time) (*studysession.StudySession, error)); ok {
+		return rf(ctx, session, startTime)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, studysession.StudySession, time.Time) *studysession.StudySession); ok {
+		r0 = rf(ctx, session, startTime)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*studysession.StudySession)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, studysession.StudySession, time.Time) error); ok {
+		r1 = rf(ctx, session, startTime)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StudySessionRepository_CreateStudySession_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateStudySession'
+type StudySessionRepository_CreateStudySession_Call struct {
+	*mock.Call
+}
+
+// CreateStudySession is a helper method to define mock.On call
+//   - ctx context.Context
+//   - session studysession.StudySession
+//   - startTime time.Time
+func (_e *StudySessionRepository_Expecter) CreateStudySession(ctx interface{}, session interface{}, startTime interface{}) *StudySessionRepository_CreateStudySession_Call {
+	return &StudySessionRepository_CreateStudySession_Call{Call: _e.mock.On("CreateStudySession", ctx, session, startTime)}
+}
+
+func (_c *StudySessionRepository_CreateStudySession_Call) Run(run func(ctx context.Context, session studysession.StudySession, startTime time.Time)) *StudySessionRepository_CreateStudySession_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(studysession.StudySession), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *StudySessionRepository_CreateStudySession_Call) Return(_a0 *studysession.StudySession, _a1 error) *StudySessionRepository_CreateStudySession_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *StudySessionRepository_CreateStudySession_Call) RunAndReturn(run func(context.Context, studysession.StudySession, time.Time) (*studysession.StudySession, error)) *StudySessionRepository_CreateStudySession_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteStudySession provides a mock function with given fields: ctx, userID, sessionID
+func (_m *StudySessionRepository) DeleteStudySession(ctx context.Context, userID uuid.UUID, sessionID uuid.UUID) error {
+	ret := _m.Called(ctx, userID, sessionID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteStudySession")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r0 = rf(ctx, userID, sessionID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// StudySessionRepository_DeleteStudySession_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteStudySession'
+type StudySessionRepository_DeleteStudySession_Call struct {
+	*mock.Call
+}
+
+// DeleteStudySession is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - sessionID uuid.UUID
+func (_e *StudySessionRepository_Expecter) DeleteStudySession(ctx interface{}, userID interface{}, sessionID interface{}) *StudySessionRepository_DeleteStudySession_Call {
+	return &StudySessionRepository_DeleteStudySession_Call{Call: _e.mock.On("DeleteStudySession", ctx, userID, sessionID)}
+}
+
+func (_c *StudySessionRepository_DeleteStudySession_Call) Run(run func(ctx context.Context, userID uuid.UUID, sessionID uuid.UUID)) *StudySessionRepository_DeleteStudySession_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *StudySessionRepository_DeleteStudySession_Call) Return(_a0 error) *StudySessionRepository_DeleteStudySession_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *StudySessionRepository_DeleteStudySession_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) error) *StudySessionRepository_DeleteStudySession_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FinishActiveStudySession provides a mock function with given fields: ctx, userID, options
+func (_m *StudySessionRepository) FinishActiveStudySession(ctx context.Context, userID uuid.UUID, options studysession.FinishOptions) (*studysession.StudySession, error) {
+	ret := _m.Called(ctx, userID, options)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FinishActiveStudySession")
+	}
+
+	var r0 *studysession.StudySession
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, studysession.FinishOptions) (*studysession.StudySession, error)); ok {
+		return rf(ctx, userID, options)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, studysession.FinishOptions) *studysession.StudySession); ok {
+		r0 = rf(ctx, userID, options)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*studysession.StudySession)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, studysession.FinishOptions) error); ok {
+		r1 = rf(ctx, userID, options)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StudySessionRepository_FinishActiveStudySession_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FinishActiveStudySession'
+type StudySessionRepository_FinishActiveStudySession_Call struct {
+	*mock.Call
+}
+
+// FinishActiveStudySession is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - options studysession.FinishOptions
+func (_e *StudySessionRepository_Expecter) FinishActiveStudySession(ctx interface{}, userID interface{}, options interface{}) *StudySessionRepository_FinishActiveStudySession_Call {
+	return &StudySessionRepository_FinishActiveStudySession_Call{Call: _e.mock.On("FinishActiveStudySession", ctx, userID, options)}
+}
+
+func (_c *StudySessionRepository_FinishActiveStudySession_Call) Run(run func(ctx context.Context, userID uuid.UUID, options studysession.FinishOptions)) *StudySessionRepository_FinishActiveStudySession_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(studysession.FinishOptions))
+	})
+	return _c
+}
+
+func (_c *StudySessionRepository_FinishActiveStudySession_Call) Return(_a0 *studysession.StudySession, _a1 error) *StudySessionRepository_FinishActiveStudySession_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *StudySessionRepository_FinishActiveStudySession_Call) RunAndReturn(run func(context.Context, uuid.UUID, studysession.FinishOptions) (*studysession.StudySession, error)) *StudySessionRepository_FinishActiveStudySession_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetActiveStudySession provides a mock function with given fields: ctx, userID
+func (_m *StudySessionRepository) GetActiveStudySession(ctx context.Context, userID uuid.UUID) (*studysession.StudySession, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetActiveStudySession")
+	}
+
+	var r0 *studysession.StudySession
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*studysession.StudySession, error)); ok {
 		return rf(ctx, userID)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []studysession.StudySession); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *studysession.StudySession); ok {
 		r0 = rf(ctx, userID)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]studysession.StudySession)
+			r0 = ret.Get(0).(*studysession.StudySession)
 		}
 	}
 
@@ -114,31 +465,857 @@ func (_m *StudySessionRepository) GetUserStudySessions(ctx context.Context, user
 	return r0, r1
 }
 
-// StudySessionRepository_GetUserStudySessions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserStudySessions'
-type StudySessionRepository_GetUserStudySessions_Call struct {
+// StudySessionRepository_GetActiveStudySession_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetActiveStudySession'
+type StudySessionRepository_GetActiveStudySession_Call struct {
 	*mock.Call
 }
 
-// GetUserStudySessions is a helper method to define mock.On call
+// GetActiveStudySession is a helper method to define mock.On call
 //   - ctx context.Context
 //   - userID uuid.UUID
-func (_e *StudySessionRepository_Expecter) GetUserStudySessions(ctx interface{}, userID interface{}) *StudySessionRepository_GetUserStudySessions_Call {
-	return &StudySessionRepository_GetUserStudySessions_Call{Call: _e.mock.On("GetUserStudySessions", ctx, userID)}
+func (_e *StudySessionRepository_Expecter) GetActiveStudySession(ctx interface{}, userID interface{}) *StudySessionRepository_GetActiveStudySession_Call {
+	return &StudySessionRepository_GetActiveStudySession_Call{Call: _e.mock.On("GetActiveStudySession", ctx, userID)}
 }
 
-func (_c *StudySessionRepository_GetUserStudySessions_Call) Run(run func(ctx context.Context, userID uuid.UUID)) *StudySessionRepository_GetUserStudySessions_Call {
+func (_c *StudySessionRepository_GetActiveStudySession_Call) Run(run func(ctx context.Context, userID uuid.UUID)) *StudySessionRepository_GetActiveStudySession_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run(args[0].(context.Context), args[1].(uuid.UUID))
 	})
 	return _c
 }
 
-func (_c *StudySessionRepository_GetUserStudySessions_Call) Return(_a0 []studysession.StudySession, _a1 error) *StudySessionRepository_GetUserStudySessions_Call {
+func (_c *StudySessionRepository_GetActiveStudySession_Call) Return(_a0 *studysession.StudySession, _a1 error) *StudySessionRepository_GetActiveStudySession_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *StudySessionRepository_GetActiveStudySession_Call) RunAndReturn(run func(context.Context, uuid.UUID) (*studysession.StudySession, error)) *StudySessionRepository_GetActiveStudySession_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetActiveStudySessionEvents provides a mock function with given fields: ctx, userID, after
+func (_m *StudySessionRepository) GetActiveStudySessionEvents(ctx context.Context, userID uuid.UUID, after *studysession.SyncCursor) (*studysession.SessionEventSync, error) {
+	ret := _m.Called(ctx, userID, after)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetActiveStudySessionEvents")
+	}
+
+	var r0 *studysession.SessionEventSync
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, *studysession.SyncCursor) (*studysession.SessionEventSync, error)); ok {
+		return rf(ctx, userID, after)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, *studysession.SyncCursor) *studysession.SessionEventSync); ok {
+		r0 = rf(ctx, userID, after)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*studysession.SessionEventSync)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, *studysession.SyncCursor) error); ok {
+		r1 = rf(ctx, userID, after)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StudySessionRepository_GetActiveStudySessionEvents_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetActiveStudySessionEvents'
+type StudySessionRepository_GetActiveStudySessionEvents_Call struct {
+	*mock.Call
+}
+
+// GetActiveStudySessionEvents is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - after *studysession.SyncCursor
+func (_e *StudySessionRepository_Expecter) GetActiveStudySessionEvents(ctx interface{}, userID interface{}, after interface{}) *StudySessionRepository_GetActiveStudySessionEvents_Call {
+	return &StudySessionRepository_GetActiveStudySessionEvents_Call{Call: _e.mock.On("GetActiveStudySessionEvents", ctx, userID, after)}
+}
+
+func (_c *StudySessionRepository_GetActiveStudySessionEvents_Call) Run(run func(ctx context.Context, userID uuid.UUID, after *studysession.SyncCursor)) *StudySessionRepository_GetActiveStudySessionEvents_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(*studysession.SyncCursor))
+	})
+	return _c
+}
+
+func (_c *StudySessionRepository_GetActiveStudySessionEvents_Call) Return(_a0 *studysession.SessionEventSync, _a1 error) *StudySessionRepository_GetActiveStudySessionEvents_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *StudySessionRepository_GetActiveStudySessionEvents_Call) RunAndReturn(run func(context.Context, uuid.UUID, *studysession.SyncCursor) (*studysession.SessionEventSync, error)) *StudySessionRepository_GetActiveStudySessionEvents_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetStudySession provides a mock function with given fields: ctx, userID, sessionID
+func (_m *StudySessionRepository) GetStudySession(ctx context.Context, userID uuid.UUID, sessionID uuid.UUID) (*studysession.StudySession, error) {
+	ret := _m.Called(ctx, userID, sessionID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetStudySession")
+	}
+
+	var r0 *studysession.StudySession
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) (*studysession.StudySession, error)); ok {
+		return rf(ctx, userID, sessionID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) *studysession.StudySession); ok {
+		r0 = rf(ctx, userID, sessionID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*studysession.StudySession)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r1 = rf(ctx, userID, sessionID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StudySessionRepository_GetStudySession_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetStudySession'
+type StudySessionRepository_GetStudySession_Call struct {
+	*mock.Call
+}
+
+// GetStudySession is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - sessionID uuid.UUID
+func (_e *StudySessionRepository_Expecter) GetStudySession(ctx interface{}, userID interface{}, sessionID interface{}) *StudySessionRepository_GetStudySession_Call {
+	return &StudySessionRepository_GetStudySession_Call{Call: _e.mock.On("GetStudySession", ctx, userID, sessionID)}
+}
+
+func (_c *StudySessionRepository_GetStudySession_Call) Run(run func(ctx context.Context, userID uuid.UUID, sessionID uuid.UUID)) *StudySessionRepository_GetStudySession_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *StudySessionRepository_GetStudySession_Call) Return(_a0 *studysession.StudySession, _a1 error) *StudySessionRepository_GetStudySession_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *StudySessionRepository_GetStudySession_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) (*studysession.StudySession, error)) *StudySessionRepository_GetStudySession_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetStudySessionEvents provides a mock function with given fields: ctx, userID, sessionID
+func (_m *StudySessionRepository) GetStudySessionEvents(ctx context.Context, userID uuid.UUID, sessionID uuid.UUID) ([]studysession.SessionEvent, error) {
+	ret := _m.Called(ctx, userID, sessionID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetStudySessionEvents")
+	}
+
+	var r0 []studysession.SessionEvent
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) ([]studysession.SessionEvent, error)); ok {
+		return rf(ctx, userID, sessionID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) []studysession.SessionEvent); ok {
+		r0 = rf(ctx, userID, sessionID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]studysession.SessionEvent)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r1 = rf(ctx, userID, sessionID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StudySessionRepository_GetStudySessionEvents_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetStudySessionEvents'
+type StudySessionRepository_GetStudySessionEvents_Call struct {
+	*mock.Call
+}
+
+// GetStudySessionEvents is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - sessionID uuid.UUID
+func (_e *StudySessionRepository_Expecter) GetStudySessionEvents(ctx interface{}, userID interface{}, sessionID interface{}) *StudySessionRepository_GetStudySessionEvents_Call {
+	return &StudySessionRepository_GetStudySessionEvents_Call{Call: _e.mock.On("GetStudySessionEvents", ctx, userID, sessionID)}
+}
+
+func (_c *StudySessionRepository_GetStudySessionEvents_Call) Run(run func(ctx context.Context, userID uuid.UUID, sessionID uuid.UUID)) *StudySessionRepository_GetStudySessionEvents_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *StudySessionRepository_GetStudySessionEvents_Call) Return(_a0 []studysession.SessionEvent, _a1 error) *StudySessionRepository_GetStudySessionEvents_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *StudySessionRepository_GetStudySessionEvents_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) ([]studysession.SessionEvent, error)) *StudySessionRepository_GetStudySessionEvents_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ImportStudySessions provides a mock function with given fields: ctx, userID, sessions
+func (_m *StudySessionRepository) ImportStudySessions(ctx context.Context, userID uuid.UUID, sessions []studysession.ImportedSession) ([]string, error) {
+	ret := _m.Called(ctx, userID, sessions)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ImportStudySessions")
+	}
+
+	var r0 []string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, []studysession.ImportedSession) ([]string, error)); ok {
+		return rf(ctx, userID, sessions)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, []studysession.ImportedSession) []string); ok {
+		r0 = rf(ctx, userID, sessions)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, []studysession.ImportedSession) error); ok {
+		r1 = rf(ctx, userID, sessions)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StudySessionRepository_ImportStudySessions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ImportStudySessions'
+type StudySessionRepository_ImportStudySessions_Call struct {
+	*mock.Call
+}
+
+// ImportStudySessions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - sessions []studysession.ImportedSession
+func (_e *StudySessionRepository_Expecter) ImportStudySessions(ctx interface{}, userID interface{}, sessions interface{}) *StudySessionRepository_ImportStudySessions_Call {
+	return &StudySessionRepository_ImportStudySessions_Call{Call: _e.mock.On("ImportStudySessions", ctx, userID, sessions)}
+}
+
+func (_c *StudySessionRepository_ImportStudySessions_Call) Run(run func(ctx context.Context, userID uuid.UUID, sessions []studysession.ImportedSession)) *StudySessionRepository_ImportStudySessions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].([]studysession.ImportedSession))
+	})
+	return _c
+}
+
+func (_c *StudySessionRepository_ImportStudySessions_Call) Return(_a0 []string, _a1 error) *StudySessionRepository_ImportStudySessions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *StudySessionRepository_ImportStudySessions_Call) RunAndReturn(run func(context.Context, uuid.UUID, []studysession.ImportedSession) ([]string, error)) *StudySessionRepository_ImportStudySessions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListImportKeys provides a mock function with given fields: ctx, userID, importKeys
+func (_m *StudySessionRepository) ListImportKeys(ctx context.Context, userID uuid.UUID, importKeys []string) ([]string, error) {
+	ret := _m.Called(ctx, userID, importKeys)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListImportKeys")
+	}
+
+	var r0 []string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, []string) ([]string, error)); ok {
+		return rf(ctx, userID, importKeys)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, []string) []string); ok {
+		r0 = rf(ctx, userID, importKeys)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, []string) error); ok {
+		r1 = rf(ctx, userID, importKeys)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StudySessionRepository_ListImportKeys_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListImportKeys'
+type StudySessionRepository_ListImportKeys_Call struct {
+	*mock.Call
+}
+
+// ListImportKeys is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - importKeys []string
+func (_e *StudySessionRepository_Expecter) ListImportKeys(ctx interface{}, userID interface{}, importKeys interface{}) *StudySessionRepository_ListImportKeys_Call {
+	return &StudySessionRepository_ListImportKeys_Call{Call: _e.mock.On("ListImportKeys", ctx, userID, importKeys)}
+}
+
+func (_c *StudySessionRepository_ListImportKeys_Call) Run(run func(ctx context.Context, userID uuid.UUID, importKeys []string)) *StudySessionRepository_ListImportKeys_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].([]string))
+	})
+	return _c
+}
+
+func (_c *StudySessionRepository_ListImportKeys_Call) Return(_a0 []string, _a1 error) *StudySessionRepository_ListImportKeys_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *StudySessionRepository_ListImportKeys_Call) RunAndReturn(run func(context.Context, uuid.UUID, []string) ([]string, error)) *StudySessionRepository_ListImportKeys_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListSessionEvents provides a mock function with given fields: ctx, sessionIDs
+func (_m *StudySessionRepository) ListSessionEvents(ctx context.Context, sessionIDs []uuid.UUID) (map[uuid.UUID][]studysession.SessionEvent, error) {
+	ret := _m.Called(ctx, sessionIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListSessionEvents")
+	}
+
+	var r0 map[uuid.UUID][]studysession.SessionEvent
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []uuid.UUID) (map[uuid.UUID][]studysession.SessionEvent, error)); ok {
+		return rf(ctx, sessionIDs)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []uuid.UUID) map[uuid.UUID][]studysession.SessionEvent); ok {
+		r0 = rf(ctx, sessionIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[uuid.UUID][]studysession.SessionEvent)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []uuid.UUID) error); ok {
+		r1 = rf(ctx, sessionIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StudySessionRepository_ListSessionEvents_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListSessionEvents'
+type StudySessionRepository_ListSessionEvents_Call struct {
+	*mock.Call
+}
+
+// ListSessionEvents is a helper method to define mock.On call
+//   - ctx context.Context
+//   - sessionIDs []uuid.UUID
+func (_e *StudySessionRepository_Expecter) ListSessionEvents(ctx interface{}, sessionIDs interface{}) *StudySessionRepository_ListSessionEvents_Call {
+	return &StudySessionRepository_ListSessionEvents_Call{Call: _e.mock.On("ListSessionEvents", ctx, sessionIDs)}
+}
+
+func (_c *StudySessionRepository_ListSessionEvents_Call) Run(run func(ctx context.Context, sessionIDs []uuid.UUID)) *StudySessionRepository_ListSessionEvents_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *StudySessionRepository_ListSessionEvents_Call) Return(_a0 map[uuid.UUID][]studysession.SessionEvent, _a1 error) *StudySessionRepository_ListSessionEvents_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *StudySessionRepository_ListSessionEvents_Call) RunAndReturn(run func(context.Context, []uuid.UUID) (map[uuid.UUID][]studysession.SessionEvent, error)) *StudySessionRepository_ListSessionEvents_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListSessionIntervals provides a mock function with given fields: ctx, userID, from, to
+func (_m *StudySessionRepository) ListSessionIntervals(ctx context.Context, userID uuid.UUID, from time.Time, to time.Time) ([]studysession.SessionInterval, error) {
+	ret := _m.Called(ctx, userID, from, to)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListSessionIntervals")
+	}
+
+	var r0 []studysession.SessionInterval
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, time.Time, time.Time) ([]studysession.SessionInterval, error)); ok {
+		return rf(ctx, userID, from, to)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, time.Time, time.Time) []studysession.SessionInterval); ok {
+		r0 = rf(ctx, userID, from, to)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]studysession.SessionInterval)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, time.Time, time.Time) error); ok {
+		r1 = rf(ctx, userID, from, to)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StudySessionRepository_ListSessionIntervals_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListSessionIntervals'
+type StudySessionRepository_ListSessionIntervals_Call struct {
+	*mock.Call
+}
+
+// ListSessionIntervals is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - from time.Time
+//   - to time.Time
+func (_e *StudySessionRepository_Expecter) ListSessionIntervals(ctx interface{}, userID interface{}, from interface{}, to interface{}) *StudySessionRepository_ListSessionIntervals_Call {
+	return &StudySessionRepository_ListSessionIntervals_Call{Call: _e.mock.On("ListSessionIntervals", ctx, userID, from, to)}
+}
+
+func (_c *StudySessionRepository_ListSessionIntervals_Call) Run(run func(ctx context.Context, userID uuid.UUID, from time.Time, to time.Time)) *StudySessionRepository_ListSessionIntervals_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(time.Time), args[3].(time.Time))
+	})
+	return _c
+}
+
+func (_c *StudySessionRepository_ListSessionIntervals_Call) Return(_a0 []studysession.SessionInterval, _a1 error) *StudySessionRepository_ListSessionIntervals_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *StudySessionRepository_ListSessionIntervals_Call) RunAndReturn(run func(context.Context, uuid.UUID, time.Time, time.Time) ([]studysession.SessionInterval, error)) *StudySessionRepository_ListSessionIntervals_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListSessionSubjects provides a mock function with given fields: ctx, sessionIDs
+func (_m *StudySessionRepository) ListSessionSubjects(ctx context.Context, sessionIDs []uuid.UUID) (map[uuid.UUID][]studysession.SessionSubject, error) {
+	ret := _m.Called(ctx, sessionIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListSessionSubjects")
+	}
+
+	var r0 map[uuid.UUID][]studysession.SessionSubject
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []uuid.UUID) (map[uuid.UUID][]studysession.SessionSubject, error)); ok {
+		return rf(ctx, sessionIDs)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []uuid.UUID) map[uuid.UUID][]studysession.SessionSubject); ok {
+		r0 = rf(ctx, sessionIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[uuid.UUID][]studysession.SessionSubject)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []uuid.UUID) error); ok {
+		r1 = rf(ctx, sessionIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StudySessionRepository_ListSessionSubjects_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListSessionSubjects'
+type StudySessionRepository_ListSessionSubjects_Call struct {
+	*mock.Call
+}
+
+// ListSessionSubjects is a helper method to define mock.On call
+//   - ctx context.Context
+//   - sessionIDs []uuid.UUID
+func (_e *StudySessionRepository_Expecter) ListSessionSubjects(ctx interface{}, sessionIDs interface{}) *StudySessionRepository_ListSessionSubjects_Call {
+	return &StudySessionRepository_ListSessionSubjects_Call{Call: _e.mock.On("ListSessionSubjects", ctx, sessionIDs)}
+}
+
+func (_c *StudySessionRepository_ListSessionSubjects_Call) Run(run func(ctx context.Context, sessionIDs []uuid.UUID)) *StudySessionRepository_ListSessionSubjects_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *StudySessionRepository_ListSessionSubjects_Call) Return(_a0 map[uuid.UUID][]studysession.SessionSubject, _a1 error) *StudySessionRepository_ListSessionSubjects_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *StudySessionRepository_ListSessionSubjects_Call) RunAndReturn(run func(context.Context, []uuid.UUID) (map[uuid.UUID][]studysession.SessionSubject, error)) *StudySessionRepository_ListSessionSubjects_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListStudySessions provides a mock function with given fields: ctx, userID, filter
+func (_m *StudySessionRepository) ListStudySessions(ctx context.Context, userID uuid.UUID, filter studysession.HistoryFilter) ([]studysession.StudySession, error) {
+	ret := _m.Called(ctx, userID, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListStudySessions")
+	}
+
+	var r0 []studysession.StudySession
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, studysession.HistoryFilter) ([]studysession.StudySession, error)); ok {
+		return rf(ctx, userID, filter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, studysession.HistoryFilter) []studysession.StudySession); ok {
+		r0 = rf(ctx, userID, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]studysession.StudySession)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, studysession.HistoryFilter) error); ok {
+		r1 = rf(ctx, userID, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StudySessionRepository_ListStudySessions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListStudySessions'
+type StudySessionRepository_ListStudySessions_Call struct {
+	*mock.Call
+}
+
+// ListStudySessions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - filter studysession.HistoryFilter
+func (_e *StudySessionRepository_Expecter) ListStudySessions(ctx interface{}, userID interface{}, filter interface{}) *StudySessionRepository_ListStudySessions_Call {
+	return &StudySessionRepository_ListStudySessions_Call{Call: _e.mock.On("ListStudySessions", ctx, userID, filter)}
+}
+
+func (_c *StudySessionRepository_ListStudySessions_Call) Run(run func(ctx context.Context, userID uuid.UUID, filter studysession.HistoryFilter)) *StudySessionRepository_ListStudySessions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(studysession.HistoryFilter))
+	})
+	return _c
+}
+
+func (_c *StudySessionRepository_ListStudySessions_Call) Return(_a0 []studysession.StudySession, _a1 error) *StudySessionRepository_ListStudySessions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *StudySessionRepository_ListStudySessions_Call) RunAndReturn(run func(context.Context, uuid.UUID, studysession.HistoryFilter) ([]studysession.StudySession, error)) *StudySessionRepository_ListStudySessions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PurgeDeletedStudySessions provides a mock function with given fields: ctx, deletedBefore
+func (_m *StudySessionRepository) PurgeDeletedStudySessions(ctx context.Context, deletedBefore time.Time) (int64, error) {
+	ret := _m.Called(ctx, deletedBefore)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PurgeDeletedStudySessions")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) (int64, error)); ok {
+		return rf(ctx, deletedBefore)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) int64); ok {
+		r0 = rf(ctx, deletedBefore)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = rf(ctx, deletedBefore)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StudySessionRepository_PurgeDeletedStudySessions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PurgeDeletedStudySessions'
+type StudySessionRepository_PurgeDeletedStudySessions_Call struct {
+	*mock.Call
+}
+
+// PurgeDeletedStudySessions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - deletedBefore time.Time
+func (_e *StudySessionRepository_Expecter) PurgeDeletedStudySessions(ctx interface{}, deletedBefore interface{}) *StudySessionRepository_PurgeDeletedStudySessions_Call {
+	return &StudySessionRepository_PurgeDeletedStudySessions_Call{Call: _e.mock.On("PurgeDeletedStudySessions", ctx, deletedBefore)}
+}
+
+func (_c *StudySessionRepository_PurgeDeletedStudySessions_Call) Run(run func(ctx context.Context, deletedBefore time.Time)) *StudySessionRepository_PurgeDeletedStudySessions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time))
+	})
+	return _c
+}
+
+func (_c *StudySessionRepository_PurgeDeletedStudySessions_Call) Return(_a0 int64, _a1 error) *StudySessionRepository_PurgeDeletedStudySessions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *StudySessionRepository_PurgeDeletedStudySessions_Call) RunAndReturn(run func(context.Context, time.Time) (int64, error)) *StudySessionRepository_PurgeDeletedStudySessions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RestoreStudySession provides a mock function with given fields: ctx, userID, sessionID, deletedAfter
+func (_m *StudySessionRepository) RestoreStudySession(ctx context.Context, userID uuid.UUID, sessionID uuid.UUID, deletedAfter time.Time) (*studysession.StudySession, error) {
+	ret := _m.Called(ctx, userID, sessionID, deletedAfter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RestoreStudySession")
+	}
+
+	var r0 *studysession.StudySession
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, time.Time) (*studysession.StudySession, error)); ok {
+		return rf(ctx, userID, sessionID, deletedAfter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, time.Time) *studysession.StudySession); ok {
+		r0 = rf(ctx, userID, sessionID, deletedAfter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*studysession.StudySession)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, time.Time) error); ok {
+		r1 = rf(ctx, userID, sessionID, deletedAfter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StudySessionRepository_RestoreStudySession_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RestoreStudySession'
+type StudySessionRepository_RestoreStudySession_Call struct {
+	*mock.Call
+}
+
+// RestoreStudySession is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - sessionID uuid.UUID
+//   - deletedAfter time.Time
+func (_e *StudySessionRepository_Expecter) RestoreStudySession(ctx interface{}, userID interface{}, sessionID interface{}, deletedAfter interface{}) *StudySessionRepository_RestoreStudySession_Call {
+	return &StudySessionRepository_RestoreStudySession_Call{Call: _e.mock.On("RestoreStudySession", ctx, userID, sessionID, deletedAfter)}
+}
+
+func (_c *StudySessionRepository_RestoreStudySession_Call) Run(run func(ctx context.Context, userID uuid.UUID, sessionID uuid.UUID, deletedAfter time.Time)) *StudySessionRepository_RestoreStudySession_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(time.Time))
+	})
+	return _c
+}
+
+func (_c *StudySessionRepository_RestoreStudySession_Call) Return(_a0 *studysession.StudySession, _a1 error) *StudySessionRepository_RestoreStudySession_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *StudySessionRepository_RestoreStudySession_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, time.Time) (*studysession.StudySession, error)) *StudySessionRepository_RestoreStudySession_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// StreamStudySessions provides a mock function with given fields: ctx, userID, fn
+func (_m *StudySessionRepository) StreamStudySessions(ctx context.Context, userID uuid.UUID, fn func(studysession.StudySessionDetails) error) error {
+	ret := _m.Called(ctx, userID, fn)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StreamStudySessions")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, func(studysession.StudySessionDetails) error) error); ok {
+		r0 = rf(ctx, userID, fn)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// StudySessionRepository_StreamStudySessions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StreamStudySessions'
+type StudySessionRepository_StreamStudySessions_Call struct {
+	*mock.Call
+}
+
+// StreamStudySessions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - fn func(studysession.StudySessionDetails) error
+func (_e *StudySessionRepository_Expecter) StreamStudySessions(ctx interface{}, userID interface{}, fn interface{}) *StudySessionRepository_StreamStudySessions_Call {
+	return &StudySessionRepository_StreamStudySessions_Call{Call: _e.mock.On("StreamStudySessions", ctx, userID, fn)}
+}
+
+func (_c *StudySessionRepository_StreamStudySessions_Call) Run(run func(ctx context.Context, userID uuid.UUID, fn func(studysession.StudySessionDetails) error)) *StudySessionRepository_StreamStudySessions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(func(studysession.StudySessionDetails) error))
+	})
+	return _c
+}
+
+func (_c *StudySessionRepository_StreamStudySessions_Call) Return(_a0 error) *StudySessionRepository_StreamStudySessions_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *StudySessionRepository_StreamStudySessions_Call) RunAndReturn(run func(context.Context, uuid.UUID, func(studysession.StudySessionDetails) error) error) *StudySessionRepository_StreamStudySessions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateActiveStudySession provides a mock function with given fields: ctx, userID, update
+func (_m *StudySessionRepository) UpdateActiveStudySession(ctx context.Context, userID uuid.UUID, update studysession.SessionUpdate) (*studysession.StudySession, error) {
+	ret := _m.Called(ctx, userID, update)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateActiveStudySession")
+	}
+
+	var r0 *studysession.StudySession
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, studysession.SessionUpdate) (*studysession.StudySession, error)); ok {
+		return rf(ctx, userID, update)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, studysession.SessionUpdate) *studysession.StudySession); ok {
+		r0 = rf(ctx, userID, update)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*studysession.StudySession)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, studysession.SessionUpdate) error); ok {
+		r1 = rf(ctx, userID, update)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StudySessionRepository_UpdateActiveStudySession_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateActiveStudySession'
+type StudySessionRepository_UpdateActiveStudySession_Call struct {
+	*mock.Call
+}
+
+// UpdateActiveStudySession is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - update studysession.SessionUpdate
+func (_e *StudySessionRepository_Expecter) UpdateActiveStudySession(ctx interface{}, userID interface{}, update interface{}) *StudySessionRepository_UpdateActiveStudySession_Call {
+	return &StudySessionRepository_UpdateActiveStudySession_Call{Call: _e.mock.On("UpdateActiveStudySession", ctx, userID, update)}
+}
+
+func (_c *StudySessionRepository_UpdateActiveStudySession_Call) Run(run func(ctx context.Context, userID uuid.UUID, update studysession.SessionUpdate)) *StudySessionRepository_UpdateActiveStudySession_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(studysession.SessionUpdate))
+	})
+	return _c
+}
+
+func (_c *StudySessionRepository_UpdateActiveStudySession_Call) Return(_a0 *studysession.StudySession, _a1 error) *StudySessionRepository_UpdateActiveStudySession_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *StudySessionRepository_UpdateActiveStudySession_Call) RunAndReturn(run func(context.Context, uuid.UUID, studysession.SessionUpdate) (*studysession.StudySession, error)) *StudySessionRepository_UpdateActiveStudySession_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateStudySession provides a mock function with given fields: ctx, userID, sessionID, update
+func (_m *StudySessionRepository) UpdateStudySession(ctx context.Context, userID uuid.UUID, sessionID uuid.UUID, update studysession.SessionUpdate) (*studysession.StudySession, error) {
+	ret := _m.Called(ctx, userID, sessionID, update)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateStudySession")
+	}
+
+	var r0 *studysession.StudySession
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, studysession.SessionUpdate) (*studysession.StudySession, error)); ok {
+		return rf(ctx, userID, sessionID, update)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, studysession.SessionUpdate) *studysession.StudySession); ok {
+		r0 = rf(ctx, userID, sessionID, update)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*studysession.StudySession)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, studysession.SessionUpdate) error); ok {
+		r1 = rf(ctx, userID, sessionID, update)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StudySessionRepository_UpdateStudySession_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateStudySession'
+type StudySessionRepository_UpdateStudySession_Call struct {
+	*mock.Call
+}
+
+// UpdateStudySession is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - sessionID uuid.UUID
+//   - update studysession.SessionUpdate
+func (_e *StudySessionRepository_Expecter) UpdateStudySession(ctx interface{}, userID interface{}, sessionID interface{}, update interface{}) *StudySessionRepository_UpdateStudySession_Call {
+	return &StudySessionRepository_UpdateStudySession_Call{Call: _e.mock.On("UpdateStudySession", ctx, userID, sessionID, update)}
+}
+
+func (_c *StudySessionRepository_UpdateStudySession_Call) Run(run func(ctx context.Context, userID uuid.UUID, sessionID uuid.UUID, update studysession.SessionUpdate)) *StudySessionRepository_UpdateStudySession_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(studysession.SessionUpdate))
+	})
+	return _c
+}
+
+func (_c *StudySessionRepository_UpdateStudySession_Call) Return(_a0 *studysession.StudySession, _a1 error) *StudySessionRepository_UpdateStudySession_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *StudySessionRepository_GetUserStudySessions_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]studysession.StudySession, error)) *StudySessionRepository_GetUserStudySessions_Call {
+func (_c *StudySessionRepository_UpdateStudySession_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, studysession.SessionUpdate) (*studysession.StudySession, error)) *StudySessionRepository_UpdateStudySession_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -0,0 +1,507 @@
+// Code generated by mockery v2.53.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	subjects "go-api/src/models/subjects"
+
+	uuid "github.com/google/uuid"
+)
+
+// SubjectRepository is an autogenerated mock type for the SubjectRepository type
+type SubjectRepository struct {
+	mock.Mock
+}
+
+type SubjectRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *SubjectRepository) EXPECT() *SubjectRepository_Expecter {
+	return &SubjectRepository_Expecter{mock: &_m.Mock}
+}
+
+// CreateRelation provides a mock function with given fields: ctx, relation
+func (_m *SubjectRepository) CreateRelation(ctx context.Context, relation subjects.SubjectRelation) (*subjects.SubjectRelation, error) {
+	ret := _m.Called(ctx, relation)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateRelation")
+	}
+
+	var r0 *subjects.SubjectRelation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, subjects.SubjectRelation) (*subjects.SubjectRelation, error)); ok {
+		return rf(ctx, relation)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, subjects.SubjectRelation) *subjects.SubjectRelation); ok {
+		r0 = rf(ctx, relation)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*subjects.SubjectRelation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, subjects.SubjectRelation) error); ok {
+		r1 = rf(ctx, relation)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SubjectRepository_CreateRelation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateRelation'
+type SubjectRepository_CreateRelation_Call struct {
+	*mock.Call
+}
+
+// CreateRelation is a helper method to define mock.On call
+//   - ctx context.Context
+//   - relation subjects.SubjectRelation
+func (_e *SubjectRepository_Expecter) CreateRelation(ctx interface{}, relation interface{}) *SubjectRepository_CreateRelation_Call {
+	return &SubjectRepository_CreateRelation_Call{Call: _e.mock.On("CreateRelation", ctx, relation)}
+}
+
+func (_c *SubjectRepository_CreateRelation_Call) Run(run func(ctx context.Context, relation subjects.SubjectRelation)) *SubjectRepository_CreateRelation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(subjects.SubjectRelation))
+	})
+	return _c
+}
+
+func (_c *SubjectRepository_CreateRelation_Call) Return(_a0 *subjects.SubjectRelation, _a1 error) *SubjectRepository_CreateRelation_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SubjectRepository_CreateRelation_Call) RunAndReturn(run func(context.Context, subjects.SubjectRelation) (*subjects.SubjectRelation, error)) *SubjectRepository_CreateRelation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateSubject provides a mock function with given fields: ctx, subject
+func (_m *SubjectRepository) CreateSubject(ctx context.Context, subject subjects.Subject) (*subjects.Subject, error) {
+	ret := _m.Called(ctx, subject)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateSubject")
+	}
+
+	var r0 *subjects.Subject
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, subjects.Subject) (*subjects.Subject, error)); ok {
+		return rf(ctx, subject)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, subjects.Subject) *subjects.Subject); ok {
+		r0 = rf(ctx, subject)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*subjects.Subject)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, subjects.Subject) error); ok {
+		r1 = rf(ctx, subject)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SubjectRepository_CreateSubject_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateSubject'
+type SubjectRepository_CreateSubject_Call struct {
+	*mock.Call
+}
+
+// CreateSubject is a helper method to define mock.On call
+//   - ctx context.Context
+//   - subject subjects.Subject
+func (_e *SubjectRepository_Expecter) CreateSubject(ctx interface{}, subject interface{}) *SubjectRepository_CreateSubject_Call {
+	return &SubjectRepository_CreateSubject_Call{Call: _e.mock.On("CreateSubject", ctx, subject)}
+}
+
+func (_c *SubjectRepository_CreateSubject_Call) Run(run func(ctx context.Context, subject subjects.Subject)) *SubjectRepository_CreateSubject_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(subjects.Subject))
+	})
+	return _c
+}
+
+func (_c *SubjectRepository_CreateSubject_Call) Return(_a0 *subjects.Subject, _a1 error) *SubjectRepository_CreateSubject_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SubjectRepository_CreateSubject_Call) RunAndReturn(run func(context.Context, subjects.Subject) (*subjects.Subject, error)) *SubjectRepository_CreateSubject_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteRelation provides a mock function with given fields: ctx, userID, relationID
+func (_m *SubjectRepository) DeleteRelation(ctx context.Context, userID uuid.UUID, relationID uuid.UUID) error {
+	ret := _m.Called(ctx, userID, relationID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteRelation")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r0 = rf(ctx, userID, relationID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SubjectRepository_DeleteRelation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteRelation'
+type SubjectRepository_DeleteRelation_Call struct {
+	*mock.Call
+}
+
+// DeleteRelation is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - relationID uuid.UUID
+func (_e *SubjectRepository_Expecter) DeleteRelation(ctx interface{}, userID interface{}, relationID interface{}) *SubjectRepository_DeleteRelation_Call {
+	return &SubjectRepository_DeleteRelation_Call{Call: _e.mock.On("DeleteRelation", ctx, userID, relationID)}
+}
+
+func (_c *SubjectRepository_DeleteRelation_Call) Run(run func(ctx context.Context, userID uuid.UUID, relationID uuid.UUID)) *SubjectRepository_DeleteRelation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *SubjectRepository_DeleteRelation_Call) Return(_a0 error) *SubjectRepository_DeleteRelation_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *SubjectRepository_DeleteRelation_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) error) *SubjectRepository_DeleteRelation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSubject provides a mock function with given fields: ctx, userID, subjectID
+func (_m *SubjectRepository) GetSubject(ctx context.Context, userID uuid.UUID, subjectID uuid.UUID) (*subjects.Subject, error) {
+	ret := _m.Called(ctx, userID, subjectID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSubject")
+	}
+
+	var r0 *subjects.Subject
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) (*subjects.Subject, error)); ok {
+		return rf(ctx, userID, subjectID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) *subjects.Subject); ok {
+		r0 = rf(ctx, userID, subjectID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*subjects.Subject)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r1 = rf(ctx, userID, subjectID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SubjectRepository_GetSubject_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSubject'
+type SubjectRepository_GetSubject_Call struct {
+	*mock.Call
+}
+
+// GetSubject is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - subjectID uuid.UUID
+func (_e *SubjectRepository_Expecter) GetSubject(ctx interface{}, userID interface{}, subjectID interface{}) *SubjectRepository_GetSubject_Call {
+	return &SubjectRepository_GetSubject_Call{Call: _e.mock.On("GetSubject", ctx, userID, subjectID)}
+}
+
+func (_c *SubjectRepository_GetSubject_Call) Run(run func(ctx context.Context, userID uuid.UUID, subjectID uuid.UUID)) *SubjectRepository_GetSubject_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *SubjectRepository_GetSubject_Call) Return(_a0 *subjects.Subject, _a1 error) *SubjectRepository_GetSubject_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SubjectRepository_GetSubject_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) (*subjects.Subject, error)) *SubjectRepository_GetSubject_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListRelations provides a mock function with given fields: ctx, userID
+func (_m *SubjectRepository) ListRelations(ctx context.Context, userID uuid.UUID) ([]subjects.SubjectRelation, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListRelations")
+	}
+
+	var r0 []subjects.SubjectRelation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]subjects.SubjectRelation, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []subjects.SubjectRelation); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]subjects.SubjectRelation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SubjectRepository_ListRelations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListRelations'
+type SubjectRepository_ListRelations_Call struct {
+	*mock.Call
+}
+
+// ListRelations is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+func (_e *SubjectRepository_Expecter) ListRelations(ctx interface{}, userID interface{}) *SubjectRepository_ListRelations_Call {
+	return &SubjectRepository_ListRelations_Call{Call: _e.mock.On("ListRelations", ctx, userID)}
+}
+
+func (_c *SubjectRepository_ListRelations_Call) Run(run func(ctx context.Context, userID uuid.UUID)) *SubjectRepository_ListRelations_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *SubjectRepository_ListRelations_Call) Return(_a0 []subjects.SubjectRelation, _a1 error) *SubjectRepository_ListRelations_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SubjectRepository_ListRelations_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]subjects.SubjectRelation, error)) *SubjectRepository_ListRelations_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListSubjects provides a mock function with given fields: ctx, userID, includeArchived
+func (_m *SubjectRepository) ListSubjects(ctx context.Context, userID uuid.UUID, includeArchived bool) ([]subjects.Subject, error) {
+	ret := _m.Called(ctx, userID, includeArchived)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListSubjects")
+	}
+
+	var r0 []subjects.Subject
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, bool) ([]subjects.Subject, error)); ok {
+		return rf(ctx, userID, includeArchived)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, bool) []subjects.Subject); ok {
+		r0 = rf(ctx, userID, includeArchived)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]subjects.Subject)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, bool) error); ok {
+		r1 = rf(ctx, userID, includeArchived)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SubjectRepository_ListSubjects_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListSubjects'
+type SubjectRepository_ListSubjects_Call struct {
+	*mock.Call
+}
+
+// ListSubjects is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - includeArchived bool
+func (_e *SubjectRepository_Expecter) ListSubjects(ctx interface{}, userID interface{}, includeArchived interface{}) *SubjectRepository_ListSubjects_Call {
+	return &SubjectRepository_ListSubjects_Call{Call: _e.mock.On("ListSubjects", ctx, userID, includeArchived)}
+}
+
+func (_c *SubjectRepository_ListSubjects_Call) Run(run func(ctx context.Context, userID uuid.UUID, includeArchived bool)) *SubjectRepository_ListSubjects_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(bool))
+	})
+	return _c
+}
+
+func (_c *SubjectRepository_ListSubjects_Call) Return(_a0 []subjects.Subject, _a1 error) *SubjectRepository_ListSubjects_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SubjectRepository_ListSubjects_Call) RunAndReturn(run func(context.Context, uuid.UUID, bool) ([]subjects.Subject, error)) *SubjectRepository_ListSubjects_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetSubjectArchived provides a mock function with given fields: ctx, userID, subjectID, archived
+func (_m *SubjectRepository) SetSubjectArchived(ctx context.Context, userID uuid.UUID, subjectID uuid.UUID, archived bool) (*subjects.Subject, error) {
+	ret := _m.Called(ctx, userID, subjectID, archived)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetSubjectArchived")
+	}
+
+	var r0 *subjects.Subject
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, bool) (*subjects.Subject, error)); ok {
+		return rf(ctx, userID, subjectID, archived)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, bool) *subjects.Subject); ok {
+		r0 = rf(ctx, userID, subjectID, archived)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*subjects.Subject)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, bool) error); ok {
+		r1 = rf(ctx, userID, subjectID, archived)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SubjectRepository_SetSubjectArchived_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetSubjectArchived'
+type SubjectRepository_SetSubjectArchived_Call struct {
+	*mock.Call
+}
+
+// SetSubjectArchived is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - subjectID uuid.UUID
+//   - archived bool
+func (_e *SubjectRepository_Expecter) SetSubjectArchived(ctx interface{}, userID interface{}, subjectID interface{}, archived interface{}) *SubjectRepository_SetSubjectArchived_Call {
+	return &SubjectRepository_SetSubjectArchived_Call{Call: _e.mock.On("SetSubjectArchived", ctx, userID, subjectID, archived)}
+}
+
+func (_c *SubjectRepository_SetSubjectArchived_Call) Run(run func(ctx context.Context, userID uuid.UUID, subjectID uuid.UUID, archived bool)) *SubjectRepository_SetSubjectArchived_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(bool))
+	})
+	return _c
+}
+
+func (_c *SubjectRepository_SetSubjectArchived_Call) Return(_a0 *subjects.Subject, _a1 error) *SubjectRepository_SetSubjectArchived_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SubjectRepository_SetSubjectArchived_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, bool) (*subjects.Subject, error)) *SubjectRepository_SetSubjectArchived_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateSubject provides a mock function with given fields: ctx, userID, subjectID, update
+func (_m *SubjectRepository) UpdateSubject(ctx context.Context, userID uuid.UUID, subjectID uuid.UUID, update subjects.SubjectUpdate) (*subjects.Subject, error) {
+	ret := _m.Called(ctx, userID, subjectID, update)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateSubject")
+	}
+
+	var r0 *subjects.Subject
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, subjects.SubjectUpdate) (*subjects.Subject, error)); ok {
+		return rf(ctx, userID, subjectID, update)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, subjects.SubjectUpdate) *subjects.Subject); ok {
+		r0 = rf(ctx, userID, subjectID, update)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*subjects.Subject)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, subjects.SubjectUpdate) error); ok {
+		r1 = rf(ctx, userID, subjectID, update)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SubjectRepository_UpdateSubject_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateSubject'
+type SubjectRepository_UpdateSubject_Call struct {
+	*mock.Call
+}
+
+// UpdateSubject is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - subjectID uuid.UUID
+//   - update subjects.SubjectUpdate
+func (_e *SubjectRepository_Expecter) UpdateSubject(ctx interface{}, userID interface{}, subjectID interface{}, update interface{}) *SubjectRepository_UpdateSubject_Call {
+	return &SubjectRepository_UpdateSubject_Call{Call: _e.mock.On("UpdateSubject", ctx, userID, subjectID, update)}
+}
+
+func (_c *SubjectRepository_UpdateSubject_Call) Run(run func(ctx context.Context, userID uuid.UUID, subjectID uuid.UUID, update subjects.SubjectUpdate)) *SubjectRepository_UpdateSubject_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(subjects.SubjectUpdate))
+	})
+	return _c
+}
+
+func (_c *SubjectRepository_UpdateSubject_Call) Return(_a0 *subjects.Subject, _a1 error) *SubjectRepository_UpdateSubject_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SubjectRepository_UpdateSubject_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, subjects.SubjectUpdate) (*subjects.Subject, error)) *SubjectRepository_UpdateSubject_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewSubjectRepository creates a new instance of SubjectRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewSubjectRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *SubjectRepository {
+	mock := &SubjectRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,618 @@
+// Code generated by mockery v2.53.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+
+	uuid "github.com/google/uuid"
+
+	webhooks "go-api/src/models/webhooks"
+)
+
+// WebhookRepository is an autogenerated mock type for the WebhookRepository type
+type WebhookRepository struct {
+	mock.Mock
+}
+
+type WebhookRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *WebhookRepository) EXPECT() *WebhookRepository_Expecter {
+	return &WebhookRepository_Expecter{mock: &_m.Mock}
+}
+
+// ClaimDueDeliveries provides a mock function with given fields: ctx, now, leaseUntil, limit
+func (_m *WebhookRepository) ClaimDueDeliveries(ctx context.Context, now time.Time, leaseUntil time.Time, limit int) ([]webhooks.PendingDelivery, error) {
+	ret := _m.Called(ctx, now, leaseUntil, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ClaimDueDeliveries")
+	}
+
+	var r0 []webhooks.PendingDelivery
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, time.Time, int) ([]webhooks.PendingDelivery, error)); ok {
+		return rf(ctx, now, leaseUntil, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, time.Time, int) []webhooks.PendingDelivery); ok {
+		r0 = rf(ctx, now, leaseUntil, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]webhooks.PendingDelivery)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time, time.Time, int) error); ok {
+		r1 = rf(ctx, now, leaseUntil, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// WebhookRepository_ClaimDueDeliveries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ClaimDueDeliveries'
+type WebhookRepository_ClaimDueDeliveries_Call struct {
+	*mock.Call
+}
+
+// ClaimDueDeliveries is a helper method to define mock.On call
+//   - ctx context.Context
+//   - now time.Time
+//   - leaseUntil time.Time
+//   - limit int
+func (_e *WebhookRepository_Expecter) ClaimDueDeliveries(ctx interface{}, now interface{}, leaseUntil interface{}, limit interface{}) *WebhookRepository_ClaimDueDeliveries_Call {
+	return &WebhookRepository_ClaimDueDeliveries_Call{Call: _e.mock.On("ClaimDueDeliveries", ctx, now, leaseUntil, limit)}
+}
+
+func (_c *WebhookRepository_ClaimDueDeliveries_Call) Run(run func(ctx context.Context, now time.Time, leaseUntil time.Time, limit int)) *WebhookRepository_ClaimDueDeliveries_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time), args[2].(time.Time), args[3].(int))
+	})
+	return _c
+}
+
+func (_c *WebhookRepository_ClaimDueDeliveries_Call) Return(_a0 []webhooks.PendingDelivery, _a1 error) *WebhookRepository_ClaimDueDeliveries_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *WebhookRepository_ClaimDueDeliveries_Call) RunAndReturn(run func(context.Context, time.Time, time.Time, int) ([]webhooks.PendingDelivery, error)) *WebhookRepository_ClaimDueDeliveries_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateEndpoint provides a mock function with given fields: ctx, endpoint, secret, maxEndpoints
+func (_m *WebhookRepository) CreateEndpoint(ctx context.Context, endpoint webhooks.Endpoint, secret string, maxEndpoints int) (*webhooks.Endpoint, error) {
+	ret := _m.Called(ctx, endpoint, secret, maxEndpoints)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateEndpoint")
+	}
+
+	var r0 *webhooks.Endpoint
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, webhooks.Endpoint, string, int) (*webhooks.Endpoint, error)); ok {
+		return rf(ctx, endpoint, secret, maxEndpoints)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, webhooks.Endpoint, string, int) *webhooks.Endpoint); ok {
+		r0 = rf(ctx, endpoint, secret, maxEndpoints)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*webhooks.Endpoint)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, webhooks.Endpoint, string, int) error); ok {
+		r1 = rf(ctx, endpoint, secret, maxEndpoints)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// WebhookRepository_CreateEndpoint_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateEndpoint'
+type WebhookRepository_CreateEndpoint_Call struct {
+	*mock.Call
+}
+
+// CreateEndpoint is a helper method to define mock.On call
+//   - ctx context.Context
+//   - endpoint webhooks.Endpoint
+//   - secret string
+//   - maxEndpoints int
+func (_e *WebhookRepository_Expecter) CreateEndpoint(ctx interface{}, endpoint interface{}, secret interface{}, maxEndpoints interface{}) *WebhookRepository_CreateEndpoint_Call {
+	return &WebhookRepository_CreateEndpoint_Call{Call: _e.mock.On("CreateEndpoint", ctx, endpoint, secret, maxEndpoints)}
+}
+
+func (_c *WebhookRepository_CreateEndpoint_Call) Run(run func(ctx context.Context, endpoint webhooks.Endpoint, secret string, maxEndpoints int)) *WebhookRepository_CreateEndpoint_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(webhooks.Endpoint), args[2].(string), args[3].(int))
+	})
+	return _c
+}
+
+func (_c *WebhookRepository_CreateEndpoint_Call) Return(_a0 *webhooks.Endpoint, _a1 error) *WebhookRepository_CreateEndpoint_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *WebhookRepository_CreateEndpoint_Call) RunAndReturn(run func(context.Context, webhooks.Endpoint, string, int) (*webhooks.Endpoint, error)) *WebhookRepository_CreateEndpoint_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteEndpoint provides a mock function with given fields: ctx, userID, endpointID
+func (_m *WebhookRepository) DeleteEndpoint(ctx context.Context, userID uuid.UUID, endpointID uuid.UUID) error {
+	ret := _m.Called(ctx, userID, endpointID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteEndpoint")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r0 = rf(ctx, userID, endpointID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// WebhookRepository_DeleteEndpoint_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteEndpoint'
+type WebhookRepository_DeleteEndpoint_Call struct {
+	*mock.Call
+}
+
+// DeleteEndpoint is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - endpointID uuid.UUID
+func (_e *WebhookRepository_Expecter) DeleteEndpoint(ctx interface{}, userID interface{}, endpointID interface{}) *WebhookRepository_DeleteEndpoint_Call {
+	return &WebhookRepository_DeleteEndpoint_Call{Call: _e.mock.On("DeleteEndpoint", ctx, userID, endpointID)}
+}
+
+func (_c *WebhookRepository_DeleteEndpoint_Call) Run(run func(ctx context.Context, userID uuid.UUID, endpointID uuid.UUID)) *WebhookRepository_DeleteEndpoint_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *WebhookRepository_DeleteEndpoint_Call) Return(_a0 error) *WebhookRepository_DeleteEndpoint_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *WebhookRepository_DeleteEndpoint_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) error) *WebhookRepository_DeleteEndpoint_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetEndpoint provides a mock function with given fields: ctx, userID, endpointID
+func (_m *WebhookRepository) GetEndpoint(ctx context.Context, userID uuid.UUID, endpointID uuid.UUID) (*webhooks.Endpoint, error) {
+	ret := _m.Called(ctx, userID, endpointID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetEndpoint")
+	}
+
+	var r0 *webhooks.Endpoint
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) (*webhooks.Endpoint, error)); ok {
+		return rf(ctx, userID, endpointID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) *webhooks.Endpoint); ok {
+		r0 = rf(ctx, userID, endpointID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*webhooks.Endpoint)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r1 = rf(ctx, userID, endpointID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// WebhookRepository_GetEndpoint_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetEndpoint'
+type WebhookRepository_GetEndpoint_Call struct {
+	*mock.Call
+}
+
+// GetEndpoint is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - endpointID uuid.UUID
+func (_e *WebhookRepository_Expecter) GetEndpoint(ctx interface{}, userID interface{}, endpointID interface{}) *WebhookRepository_GetEndpoint_Call {
+	return &WebhookRepository_GetEndpoint_Call{Call: _e.mock.On("GetEndpoint", ctx, userID, endpointID)}
+}
+
+func (_c *WebhookRepository_GetEndpoint_Call) Run(run func(ctx context.Context, userID uuid.UUID, endpointID uuid.UUID)) *WebhookRepository_GetEndpoint_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *WebhookRepository_GetEndpoint_Call) Return(_a0 *webhooks.Endpoint, _a1 error) *WebhookRepository_GetEndpoint_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *WebhookRepository_GetEndpoint_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) (*webhooks.Endpoint, error)) *WebhookRepository_GetEndpoint_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListDeliveries provides a mock function with given fields: ctx, userID, endpointID, limit
+func (_m *WebhookRepository) ListDeliveries(ctx context.Context, userID uuid.UUID, endpointID uuid.UUID, limit int) ([]webhooks.Delivery, error) {
+	ret := _m.Called(ctx, userID, endpointID, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListDeliveries")
+	}
+
+	var r0 []webhooks.Delivery
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, int) ([]webhooks.Delivery, error)); ok {
+		return rf(ctx, userID, endpointID, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, int) []webhooks.Delivery); ok {
+		r0 = rf(ctx, userID, endpointID, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]webhooks.Delivery)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, int) error); ok {
+		r1 = rf(ctx, userID, endpointID, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// WebhookRepository_ListDeliveries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListDeliveries'
+type WebhookRepository_ListDeliveries_Call struct {
+	*mock.Call
+}
+
+// ListDeliveries is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - endpointID uuid.UUID
+//   - limit int
+func (_e *WebhookRepository_Expecter) ListDeliveries(ctx interface{}, userID interface{}, endpointID interface{}, limit interface{}) *WebhookRepository_ListDeliveries_Call {
+	return &WebhookRepository_ListDeliveries_Call{Call: _e.mock.On("ListDeliveries", ctx, userID, endpointID, limit)}
+}
+
+func (_c *WebhookRepository_ListDeliveries_Call) Run(run func(ctx context.Context, userID uuid.UUID, endpointID uuid.UUID, limit int)) *WebhookRepository_ListDeliveries_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(int))
+	})
+	return _c
+}
+
+func (_c *WebhookRepository_ListDeliveries_Call) Return(_a0 []webhooks.Delivery, _a1 error) *WebhookRepository_ListDeliveries_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *WebhookRepository_ListDeliveries_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, int) ([]webhooks.Delivery, error)) *WebhookRepository_ListDeliveries_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListEndpoints provides a mock function with given fields: ctx, userID
+func (_m *WebhookRepository) ListEndpoints(ctx context.Context, userID uuid.UUID) ([]webhooks.Endpoint, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListEndpoints")
+	}
+
+	var r0 []webhooks.Endpoint
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]webhooks.Endpoint, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []webhooks.Endpoint); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]webhooks.Endpoint)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// WebhookRepository_ListEndpoints_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListEndpoints'
+type WebhookRepository_ListEndpoints_Call struct {
+	*mock.Call
+}
+
+// ListEndpoints is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+func (_e *WebhookRepository_Expecter) ListEndpoints(ctx interface{}, userID interface{}) *WebhookRepository_ListEndpoints_Call {
+	return &WebhookRepository_ListEndpoints_Call{Call: _e.mock.On("ListEndpoints", ctx, userID)}
+}
+
+func (_c *WebhookRepository_ListEndpoints_Call) Run(run func(ctx context.Context, userID uuid.UUID)) *WebhookRepository_ListEndpoints_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *WebhookRepository_ListEndpoints_Call) Return(_a0 []webhooks.Endpoint, _a1 error) *WebhookRepository_ListEndpoints_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *WebhookRepository_ListEndpoints_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]webhooks.Endpoint, error)) *WebhookRepository_ListEndpoints_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PurgeEvents provides a mock function with given fields: ctx, createdBefore
+func (_m *WebhookRepository) PurgeEvents(ctx context.Context, createdBefore time.Time) (int64, error) {
+	ret := _m.Called(ctx, createdBefore)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PurgeEvents")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) (int64, error)); ok {
+		return rf(ctx, createdBefore)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) int64); ok {
+		r0 = rf(ctx, createdBefore)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = rf(ctx, createdBefore)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// WebhookRepository_PurgeEvents_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PurgeEvents'
+type WebhookRepository_PurgeEvents_Call struct {
+	*mock.Call
+}
+
+// PurgeEvents is a helper method to define mock.On call
+//   - ctx context.Context
+//   - createdBefore time.Time
+func (_e *WebhookRepository_Expecter) PurgeEvents(ctx interface{}, createdBefore interface{}) *WebhookRepository_PurgeEvents_Call {
+	return &WebhookRepository_PurgeEvents_Call{Call: _e.mock.On("PurgeEvents", ctx, createdBefore)}
+}
+
+func (_c *WebhookRepository_PurgeEvents_Call) Run(run func(ctx context.Context, createdBefore time.Time)) *WebhookRepository_PurgeEvents_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time))
+	})
+	return _c
+}
+
+func (_c *WebhookRepository_PurgeEvents_Call) Return(_a0 int64, _a1 error) *WebhookRepository_PurgeEvents_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *WebhookRepository_PurgeEvents_Call) RunAndReturn(run func(context.Context, time.Time) (int64, error)) *WebhookRepository_PurgeEvents_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RecordDeliveryResult provides a mock function with given fields: ctx, result
+func (_m *WebhookRepository) RecordDeliveryResult(ctx context.Context, result webhooks.DeliveryResult) error {
+	ret := _m.Called(ctx, result)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecordDeliveryResult")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, webhooks.DeliveryResult) error); ok {
+		r0 = rf(ctx, result)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// WebhookRepository_RecordDeliveryResult_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RecordDeliveryResult'
+type WebhookRepository_RecordDeliveryResult_Call struct {
+	*mock.Call
+}
+
+// RecordDeliveryResult is a helper method to define mock.On call
+//   - ctx context.Context
+//   - result webhooks.DeliveryResult
+func (_e *WebhookRepository_Expecter) RecordDeliveryResult(ctx interface{}, result interface{}) *WebhookRepository_RecordDeliveryResult_Call {
+	return &WebhookRepository_RecordDeliveryResult_Call{Call: _e.mock.On("RecordDeliveryResult", ctx, result)}
+}
+
+func (_c *WebhookRepository_RecordDeliveryResult_Call) Run(run func(ctx context.Context, result webhooks.DeliveryResult)) *WebhookRepository_RecordDeliveryResult_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(webhooks.DeliveryResult))
+	})
+	return _c
+}
+
+func (_c *WebhookRepository_RecordDeliveryResult_Call) Return(_a0 error) *WebhookRepository_RecordDeliveryResult_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *WebhookRepository_RecordDeliveryResult_Call) RunAndReturn(run func(context.Context, webhooks.DeliveryResult) error) *WebhookRepository_RecordDeliveryResult_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Redeliver provides a mock function with given fields: ctx, userID, endpointID, deliveryID
+func (_m *WebhookRepository) Redeliver(ctx context.Context, userID uuid.UUID, endpointID uuid.UUID, deliveryID uuid.UUID) (*webhooks.Delivery, error) {
+	ret := _m.Called(ctx, userID, endpointID, deliveryID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Redeliver")
+	}
+
+	var r0 *webhooks.Delivery
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID) (*webhooks.Delivery, error)); ok {
+		return rf(ctx, userID, endpointID, deliveryID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID) *webhooks.Delivery); ok {
+		r0 = rf(ctx, userID, endpointID, deliveryID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*webhooks.Delivery)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID) error); ok {
+		r1 = rf(ctx, userID, endpointID, deliveryID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// WebhookRepository_Redeliver_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Redeliver'
+type WebhookRepository_Redeliver_Call struct {
+	*mock.Call
+}
+
+// Redeliver is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - endpointID uuid.UUID
+//   - deliveryID uuid.UUID
+func (_e *WebhookRepository_Expecter) Redeliver(ctx interface{}, userID interface{}, endpointID interface{}, deliveryID interface{}) *WebhookRepository_Redeliver_Call {
+	return &WebhookRepository_Redeliver_Call{Call: _e.mock.On("Redeliver", ctx, userID, endpointID, deliveryID)}
+}
+
+func (_c *WebhookRepository_Redeliver_Call) Run(run func(ctx context.Context, userID uuid.UUID, endpointID uuid.UUID, deliveryID uuid.UUID)) *WebhookRepository_Redeliver_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *WebhookRepository_Redeliver_Call) Return(_a0 *webhooks.Delivery, _a1 error) *WebhookRepository_Redeliver_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *WebhookRepository_Redeliver_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID) (*webhooks.Delivery, error)) *WebhookRepository_Redeliver_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateEndpoint provides a mock function with given fields: ctx, userID, endpointID, update
+func (_m *WebhookRepository) UpdateEndpoint(ctx context.Context, userID uuid.UUID, endpointID uuid.UUID, update webhooks.EndpointUpdate) (*webhooks.Endpoint, error) {
+	ret := _m.Called(ctx, userID, endpointID, update)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateEndpoint")
+	}
+
+	var r0 *webhooks.Endpoint
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, webhooks.EndpointUpdate) (*webhooks.Endpoint, error)); ok {
+		return rf(ctx, userID, endpointID, update)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, webhooks.EndpointUpdate) *webhooks.Endpoint); ok {
+		r0 = rf(ctx, userID, endpointID, update)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*webhooks.Endpoint)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, webhooks.EndpointUpdate) error); ok {
+		r1 = rf(ctx, userID, endpointID, update)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// WebhookRepository_UpdateEndpoint_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateEndpoint'
+type WebhookRepository_UpdateEndpoint_Call struct {
+	*mock.Call
+}
+
+// UpdateEndpoint is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - endpointID uuid.UUID
+//   - update webhooks.EndpointUpdate
+func (_e *WebhookRepository_Expecter) UpdateEndpoint(ctx interface{}, userID interface{}, endpointID interface{}, update interface{}) *WebhookRepository_UpdateEndpoint_Call {
+	return &WebhookRepository_UpdateEndpoint_Call{Call: _e.mock.On("UpdateEndpoint", ctx, userID, endpointID, update)}
+}
+
+func (_c *WebhookRepository_UpdateEndpoint_Call) Run(run func(ctx context.Context, userID uuid.UUID, endpointID uuid.UUID, update webhooks.EndpointUpdate)) *WebhookRepository_UpdateEndpoint_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(webhooks.EndpointUpdate))
+	})
+	return _c
+}
+
+func (_c *WebhookRepository_UpdateEndpoint_Call) Return(_a0 *webhooks.Endpoint, _a1 error) *WebhookRepository_UpdateEndpoint_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *WebhookRepository_UpdateEndpoint_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, webhooks.EndpointUpdate) (*webhooks.Endpoint, error)) *WebhookRepository_UpdateEndpoint_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewWebhookRepository creates a new instance of WebhookRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewWebhookRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *WebhookRepository {
+	mock := &WebhookRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
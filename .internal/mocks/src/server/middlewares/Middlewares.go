@@ -68,6 +68,53 @@ func (_c *Middlewares_AuthMiddleware_Call) RunAndReturn(run func() echo.Middlewa
 	return _c
 }
 
+// IdempotencyMiddleware provides a mock function with no fields
+func (_m *Middlewares) IdempotencyMiddleware() echo.MiddlewareFunc {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for IdempotencyMiddleware")
+	}
+
+	var r0 echo.MiddlewareFunc
+	if rf, ok := ret.Get(0).(func() echo.MiddlewareFunc); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(echo.MiddlewareFunc)
+		}
+	}
+
+	return r0
+}
+
+// Middlewares_IdempotencyMiddleware_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IdempotencyMiddleware'
+type Middlewares_IdempotencyMiddleware_Call struct {
+	*mock.Call
+}
+
+// IdempotencyMiddleware is a helper method to define mock.On call
+func (_e *Middlewares_Expecter) IdempotencyMiddleware() *Middlewares_IdempotencyMiddleware_Call {
+	return &Middlewares_IdempotencyMiddleware_Call{Call: _e.mock.On("IdempotencyMiddleware")}
+}
+
+func (_c *Middlewares_IdempotencyMiddleware_Call) Run(run func()) *Middlewares_IdempotencyMiddleware_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Middlewares_IdempotencyMiddleware_Call) Return(_a0 echo.MiddlewareFunc) *Middlewares_IdempotencyMiddleware_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Middlewares_IdempotencyMiddleware_Call) RunAndReturn(run func() echo.MiddlewareFunc) *Middlewares_IdempotencyMiddleware_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMiddlewares creates a new instance of Middlewares. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMiddlewares(t interface {
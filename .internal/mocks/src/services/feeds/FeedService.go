@@ -0,0 +1,323 @@
+// Code generated by mockery v2.53.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	feeds "go-api/src/services/feeds"
+
+	mock "github.com/stretchr/testify/mock"
+
+	modelsfeeds "go-api/src/models/feeds"
+
+	uuid "github.com/google/uuid"
+)
+
+// FeedService is an autogenerated mock type for the FeedService type
+type FeedService struct {
+	mock.Mock
+}
+
+type FeedService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *FeedService) EXPECT() *FeedService_Expecter {
+	return &FeedService_Expecter{mock: &_m.Mock}
+}
+
+// CreateFeedToken provides a mock function with given fields: ctx, request
+func (_m *FeedService) CreateFeedToken(ctx context.Context, request feeds.CreateFeedTokenRequest) (*modelsfeeds.CreatedFeedToken, error) {
+	ret := _m.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateFeedToken")
+	}
+
+	var r0 *modelsfeeds.CreatedFeedToken
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, feeds.CreateFeedTokenRequest) (*modelsfeeds.CreatedFeedToken, error)); ok {
+		return rf(ctx, request)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, feeds.CreateFeedTokenRequest) *modelsfeeds.CreatedFeedToken); ok {
+		r0 = rf(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*modelsfeeds.CreatedFeedToken)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, feeds.CreateFeedTokenRequest) error); ok {
+		r1 = rf(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FeedService_CreateFeedToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateFeedToken'
+type FeedService_CreateFeedToken_Call struct {
+	*mock.Call
+}
+
+// CreateFeedToken is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request feeds.CreateFeedTokenRequest
+func (_e *FeedService_Expecter) CreateFeedToken(ctx interface{}, request interface{}) *FeedService_CreateFeedToken_Call {
+	return &FeedService_CreateFeedToken_Call{Call: _e.mock.On("CreateFeedToken", ctx, request)}
+}
+
+func (_c *FeedService_CreateFeedToken_Call) Run(run func(ctx context.Context, request feeds.CreateFeedTokenRequest)) *FeedService_CreateFeedToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(feeds.CreateFeedTokenRequest))
+	})
+	return _c
+}
+
+func (_c *FeedService_CreateFeedToken_Call) Return(_a0 *modelsfeeds.CreatedFeedToken, _a1 error) *FeedService_CreateFeedToken_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *FeedService_CreateFeedToken_Call) RunAndReturn(run func(context.Context, feeds.CreateFeedTokenRequest) (*modelsfeeds.CreatedFeedToken, error)) *FeedService_CreateFeedToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListFeedTokens provides a mock function with given fields: ctx
+func (_m *FeedService) ListFeedTokens(ctx context.Context) ([]modelsfeeds.FeedToken, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListFeedTokens")
+	}
+
+	var r0 []modelsfeeds.FeedToken
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]modelsfeeds.FeedToken, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []modelsfeeds.FeedToken); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]modelsfeeds.FeedToken)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FeedService_ListFeedTokens_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListFeedTokens'
+type FeedService_ListFeedTokens_Call struct {
+	*mock.Call
+}
+
+// ListFeedTokens is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *FeedService_Expecter) ListFeedTokens(ctx interface{}) *FeedService_ListFeedTokens_Call {
+	return &FeedService_ListFeedTokens_Call{Call: _e.mock.On("ListFeedTokens", ctx)}
+}
+
+func (_c *FeedService_ListFeedTokens_Call) Run(run func(ctx context.Context)) *FeedService_ListFeedTokens_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *FeedService_ListFeedTokens_Call) Return(_a0 []modelsfeeds.FeedToken, _a1 error) *FeedService_ListFeedTokens_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *FeedService_ListFeedTokens_Call) RunAndReturn(run func(context.Context) ([]modelsfeeds.FeedToken, error)) *FeedService_ListFeedTokens_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RenderCalendarFeed provides a mock function with given fields: ctx, version
+func (_m *FeedService) RenderCalendarFeed(ctx context.Context, version modelsfeeds.FeedVersion) ([]byte, error) {
+	ret := _m.Called(ctx, version)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RenderCalendarFeed")
+	}
+
+	var r0 []byte
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, modelsfeeds.FeedVersion) ([]byte, error)); ok {
+		return rf(ctx, version)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, modelsfeeds.FeedVersion) []byte); ok {
+		r0 = rf(ctx, version)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]byte)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, modelsfeeds.FeedVersion) error); ok {
+		r1 = rf(ctx, version)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FeedService_RenderCalendarFeed_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RenderCalendarFeed'
+type FeedService_RenderCalendarFeed_Call struct {
+	*mock.Call
+}
+
+// RenderCalendarFeed is a helper method to define mock.On call
+//   - ctx context.Context
+//   - version modelsfeeds.FeedVersion
+func (_e *FeedService_Expecter) RenderCalendarFeed(ctx interface{}, version interface{}) *FeedService_RenderCalendarFeed_Call {
+	return &FeedService_RenderCalendarFeed_Call{Call: _e.mock.On("RenderCalendarFeed", ctx, version)}
+}
+
+func (_c *FeedService_RenderCalendarFeed_Call) Run(run func(ctx context.Context, version modelsfeeds.FeedVersion)) *FeedService_RenderCalendarFeed_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(modelsfeeds.FeedVersion))
+	})
+	return _c
+}
+
+func (_c *FeedService_RenderCalendarFeed_Call) Return(_a0 []byte, _a1 error) *FeedService_RenderCalendarFeed_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *FeedService_RenderCalendarFeed_Call) RunAndReturn(run func(context.Context, modelsfeeds.FeedVersion) ([]byte, error)) *FeedService_RenderCalendarFeed_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ResolveCalendarFeed provides a mock function with given fields: ctx, token
+func (_m *FeedService) ResolveCalendarFeed(ctx context.Context, token string) (*modelsfeeds.FeedVersion, error) {
+	ret := _m.Called(ctx, token)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ResolveCalendarFeed")
+	}
+
+	var r0 *modelsfeeds.FeedVersion
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*modelsfeeds.FeedVersion, error)); ok {
+		return rf(ctx, token)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *modelsfeeds.FeedVersion); ok {
+		r0 = rf(ctx, token)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*modelsfeeds.FeedVersion)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, token)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FeedService_ResolveCalendarFeed_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ResolveCalendarFeed'
+type FeedService_ResolveCalendarFeed_Call struct {
+	*mock.Call
+}
+
+// ResolveCalendarFeed is a helper method to define mock.On call
+//   - ctx context.Context
+//   - token string
+func (_e *FeedService_Expecter) ResolveCalendarFeed(ctx interface{}, token interface{}) *FeedService_ResolveCalendarFeed_Call {
+	return &FeedService_ResolveCalendarFeed_Call{Call: _e.mock.On("ResolveCalendarFeed", ctx, token)}
+}
+
+func (_c *FeedService_ResolveCalendarFeed_Call) Run(run func(ctx context.Context, token string)) *FeedService_ResolveCalendarFeed_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *FeedService_ResolveCalendarFeed_Call) Return(_a0 *modelsfeeds.FeedVersion, _a1 error) *FeedService_ResolveCalendarFeed_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *FeedService_ResolveCalendarFeed_Call) RunAndReturn(run func(context.Context, string) (*modelsfeeds.FeedVersion, error)) *FeedService_ResolveCalendarFeed_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RevokeFeedToken provides a mock function with given fields: ctx, tokenID
+func (_m *FeedService) RevokeFeedToken(ctx context.Context, tokenID uuid.UUID) error {
+	ret := _m.Called(ctx, tokenID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeFeedToken")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = rf(ctx, tokenID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FeedService_RevokeFeedToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeFeedToken'
+type FeedService_RevokeFeedToken_Call struct {
+	*mock.Call
+}
+
+// RevokeFeedToken is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tokenID uuid.UUID
+func (_e *FeedService_Expecter) RevokeFeedToken(ctx interface{}, tokenID interface{}) *FeedService_RevokeFeedToken_Call {
+	return &FeedService_RevokeFeedToken_Call{Call: _e.mock.On("RevokeFeedToken", ctx, tokenID)}
+}
+
+func (_c *FeedService_RevokeFeedToken_Call) Run(run func(ctx context.Context, tokenID uuid.UUID)) *FeedService_RevokeFeedToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *FeedService_RevokeFeedToken_Call) Return(_a0 error) *FeedService_RevokeFeedToken_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *FeedService_RevokeFeedToken_Call) RunAndReturn(run func(context.Context, uuid.UUID) error) *FeedService_RevokeFeedToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewFeedService creates a new instance of FeedService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewFeedService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *FeedService {
+	mock := &FeedService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,248 @@
+// Code generated by mockery v2.53.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	idempotency "go-api/src/models/idempotency"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// IdempotencyService is an autogenerated mock type for the IdempotencyService type
+type IdempotencyService struct {
+	mock.Mock
+}
+
+type IdempotencyService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *IdempotencyService) EXPECT() *IdempotencyService_Expecter {
+	return &IdempotencyService_Expecter{mock: &_m.Mock}
+}
+
+// CompleteKey provides a mock function with given fields: ctx, record, response
+func (_m *IdempotencyService) CompleteKey(ctx context.Context, record idempotency.Record, response idempotency.Response) error {
+	ret := _m.Called(ctx, record, response)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CompleteKey")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, idempotency.Record, idempotency.Response) error); ok {
+		r0 = rf(ctx, record, response)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// IdempotencyService_CompleteKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CompleteKey'
+type IdempotencyService_CompleteKey_Call struct {
+	*mock.Call
+}
+
+// CompleteKey is a helper method to define mock.On call
+//   - ctx context.Context
+//   - record idempotency.Record
+//   - response idempotency.Response
+func (_e *IdempotencyService_Expecter) CompleteKey(ctx interface{}, record interface{}, response interface{}) *IdempotencyService_CompleteKey_Call {
+	return &IdempotencyService_CompleteKey_Call{Call: _e.mock.On("CompleteKey", ctx, record, response)}
+}
+
+func (_c *IdempotencyService_CompleteKey_Call) Run(run func(ctx context.Context, record idempotency.Record, response idempotency.Response)) *IdempotencyService_CompleteKey_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(idempotency.Record), args[2].(idempotency.Response))
+	})
+	return _c
+}
+
+func (_c *IdempotencyService_CompleteKey_Call) Return(_a0 error) *IdempotencyService_CompleteKey_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *IdempotencyService_CompleteKey_Call) RunAndReturn(run func(context.Context, idempotency.Record, idempotency.Response) error) *IdempotencyService_CompleteKey_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PurgeExpiredKeys provides a mock function with given fields: ctx
+func (_m *IdempotencyService) PurgeExpiredKeys(ctx context.Context) (int64, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PurgeExpiredKeys")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (int64, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) int64); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IdempotencyService_PurgeExpiredKeys_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PurgeExpiredKeys'
+type IdempotencyService_PurgeExpiredKeys_Call struct {
+	*mock.Call
+}
+
+// PurgeExpiredKeys is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *IdempotencyService_Expecter) PurgeExpiredKeys(ctx interface{}) *IdempotencyService_PurgeExpiredKeys_Call {
+	return &IdempotencyService_PurgeExpiredKeys_Call{Call: _e.mock.On("PurgeExpiredKeys", ctx)}
+}
+
+func (_c *IdempotencyService_PurgeExpiredKeys_Call) Run(run func(ctx context.Context)) *IdempotencyService_PurgeExpiredKeys_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *IdempotencyService_PurgeExpiredKeys_Call) Return(_a0 int64, _a1 error) *IdempotencyService_PurgeExpiredKeys_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IdempotencyService_PurgeExpiredKeys_Call) RunAndReturn(run func(context.Context) (int64, error)) *IdempotencyService_PurgeExpiredKeys_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReleaseKey provides a mock function with given fields: ctx, record
+func (_m *IdempotencyService) ReleaseKey(ctx context.Context, record idempotency.Record) error {
+	ret := _m.Called(ctx, record)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReleaseKey")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, idempotency.Record) error); ok {
+		r0 = rf(ctx, record)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// IdempotencyService_ReleaseKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReleaseKey'
+type IdempotencyService_ReleaseKey_Call struct {
+	*mock.Call
+}
+
+// ReleaseKey is a helper method to define mock.On call
+//   - ctx context.Context
+//   - record idempotency.Record
+func (_e *IdempotencyService_Expecter) ReleaseKey(ctx interface{}, record interface{}) *IdempotencyService_ReleaseKey_Call {
+	return &IdempotencyService_ReleaseKey_Call{Call: _e.mock.On("ReleaseKey", ctx, record)}
+}
+
+func (_c *IdempotencyService_ReleaseKey_Call) Run(run func(ctx context.Context, record idempotency.Record)) *IdempotencyService_ReleaseKey_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(idempotency.Record))
+	})
+	return _c
+}
+
+func (_c *IdempotencyService_ReleaseKey_Call) Return(_a0 error) *IdempotencyService_ReleaseKey_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *IdempotencyService_ReleaseKey_Call) RunAndReturn(run func(context.Context, idempotency.Record) error) *IdempotencyService_ReleaseKey_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReserveKey provides a mock function with given fields: ctx, key, requestHash
+func (_m *IdempotencyService) ReserveKey(ctx context.Context, key string, requestHash string) (*idempotency.Record, error) {
+	ret := _m.Called(ctx, key, requestHash)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReserveKey")
+	}
+
+	var r0 *idempotency.Record
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*idempotency.Record, error)); ok {
+		return rf(ctx, key, requestHash)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *idempotency.Record); ok {
+		r0 = rf(ctx, key, requestHash)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*idempotency.Record)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, key, requestHash)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IdempotencyService_ReserveKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReserveKey'
+type IdempotencyService_ReserveKey_Call struct {
+	*mock.Call
+}
+
+// ReserveKey is a helper method to define mock.On call
+//   - ctx context.Context
+//   - key string
+//   - requestHash string
+func (_e *IdempotencyService_Expecter) ReserveKey(ctx interface{}, key interface{}, requestHash interface{}) *IdempotencyService_ReserveKey_Call {
+	return &IdempotencyService_ReserveKey_Call{Call: _e.mock.On("ReserveKey", ctx, key, requestHash)}
+}
+
+func (_c *IdempotencyService_ReserveKey_Call) Run(run func(ctx context.Context, key string, requestHash string)) *IdempotencyService_ReserveKey_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *IdempotencyService_ReserveKey_Call) Return(_a0 *idempotency.Record, _a1 error) *IdempotencyService_ReserveKey_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IdempotencyService_ReserveKey_Call) RunAndReturn(run func(context.Context, string, string) (*idempotency.Record, error)) *IdempotencyService_ReserveKey_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewIdempotencyService creates a new instance of IdempotencyService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewIdempotencyService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *IdempotencyService {
+	mock := &IdempotencyService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
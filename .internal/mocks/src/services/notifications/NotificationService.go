@@ -0,0 +1,247 @@
+// Code generated by mockery v2.53.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	modelsnotifications "go-api/src/models/notifications"
+
+	mock "github.com/stretchr/testify/mock"
+
+	notifications "go-api/src/services/notifications"
+)
+
+// NotificationService is an autogenerated mock type for the NotificationService type
+type NotificationService struct {
+	mock.Mock
+}
+
+type NotificationService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *NotificationService) EXPECT() *NotificationService_Expecter {
+	return &NotificationService_Expecter{mock: &_m.Mock}
+}
+
+// ListenNotifications provides a mock function with given fields: ctx
+func (_m *NotificationService) ListenNotifications(ctx context.Context) error {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListenNotifications")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NotificationService_ListenNotifications_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListenNotifications'
+type NotificationService_ListenNotifications_Call struct {
+	*mock.Call
+}
+
+// ListenNotifications is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *NotificationService_Expecter) ListenNotifications(ctx interface{}) *NotificationService_ListenNotifications_Call {
+	return &NotificationService_ListenNotifications_Call{Call: _e.mock.On("ListenNotifications", ctx)}
+}
+
+func (_c *NotificationService_ListenNotifications_Call) Run(run func(ctx context.Context)) *NotificationService_ListenNotifications_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *NotificationService_ListenNotifications_Call) Return(_a0 error) *NotificationService_ListenNotifications_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *NotificationService_ListenNotifications_Call) RunAndReturn(run func(context.Context) error) *NotificationService_ListenNotifications_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PublishNotification provides a mock function with given fields: ctx, notification
+func (_m *NotificationService) PublishNotification(ctx context.Context, notification modelsnotifications.Notification) error {
+	ret := _m.Called(ctx, notification)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PublishNotification")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, modelsnotifications.Notification) error); ok {
+		r0 = rf(ctx, notification)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NotificationService_PublishNotification_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PublishNotification'
+type NotificationService_PublishNotification_Call struct {
+	*mock.Call
+}
+
+// PublishNotification is a helper method to define mock.On call
+//   - ctx context.Context
+//   - notification modelsnotifications.Notification
+func (_e *NotificationService_Expecter) PublishNotification(ctx interface{}, notification interface{}) *NotificationService_PublishNotification_Call {
+	return &NotificationService_PublishNotification_Call{Call: _e.mock.On("PublishNotification", ctx, notification)}
+}
+
+func (_c *NotificationService_PublishNotification_Call) Run(run func(ctx context.Context, notification modelsnotifications.Notification)) *NotificationService_PublishNotification_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(modelsnotifications.Notification))
+	})
+	return _c
+}
+
+func (_c *NotificationService_PublishNotification_Call) Return(_a0 error) *NotificationService_PublishNotification_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *NotificationService_PublishNotification_Call) RunAndReturn(run func(context.Context, modelsnotifications.Notification) error) *NotificationService_PublishNotification_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PurgeNotifications provides a mock function with given fields: ctx
+func (_m *NotificationService) PurgeNotifications(ctx context.Context) (int64, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PurgeNotifications")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (int64, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) int64); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NotificationService_PurgeNotifications_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PurgeNotifications'
+type NotificationService_PurgeNotifications_Call struct {
+	*mock.Call
+}
+
+// PurgeNotifications is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *NotificationService_Expecter) PurgeNotifications(ctx interface{}) *NotificationService_PurgeNotifications_Call {
+	return &NotificationService_PurgeNotifications_Call{Call: _e.mock.On("PurgeNotifications", ctx)}
+}
+
+func (_c *NotificationService_PurgeNotifications_Call) Run(run func(ctx context.Context)) *NotificationService_PurgeNotifications_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *NotificationService_PurgeNotifications_Call) Return(_a0 int64, _a1 error) *NotificationService_PurgeNotifications_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *NotificationService_PurgeNotifications_Call) RunAndReturn(run func(context.Context) (int64, error)) *NotificationService_PurgeNotifications_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Subscribe provides a mock function with given fields: ctx, request
+func (_m *NotificationService) Subscribe(ctx context.Context, request notifications.SubscribeRequest) (<-chan modelsnotifications.Notification, error) {
+	ret := _m.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Subscribe")
+	}
+
+	var r0 <-chan modelsnotifications.Notification
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, notifications.SubscribeRequest) (<-chan modelsnotifications.Notification, error)); ok {
+		return rf(ctx, request)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, notifications.SubscribeRequest) <-chan modelsnotifications.Notification); ok {
+		r0 = rf(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(<-chan modelsnotifications.Notification)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, notifications.SubscribeRequest) error); ok {
+		r1 = rf(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NotificationService_Subscribe_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Subscribe'
+type NotificationService_Subscribe_Call struct {
+	*mock.Call
+}
+
+// Subscribe is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request notifications.SubscribeRequest
+func (_e *NotificationService_Expecter) Subscribe(ctx interface{}, request interface{}) *NotificationService_Subscribe_Call {
+	return &NotificationService_Subscribe_Call{Call: _e.mock.On("Subscribe", ctx, request)}
+}
+
+func (_c *NotificationService_Subscribe_Call) Run(run func(ctx context.Context, request notifications.SubscribeRequest)) *NotificationService_Subscribe_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(notifications.SubscribeRequest))
+	})
+	return _c
+}
+
+func (_c *NotificationService_Subscribe_Call) Return(_a0 <-chan modelsnotifications.Notification, _a1 error) *NotificationService_Subscribe_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *NotificationService_Subscribe_Call) RunAndReturn(run func(context.Context, notifications.SubscribeRequest) (<-chan modelsnotifications.Notification, error)) *NotificationService_Subscribe_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewNotificationService creates a new instance of NotificationService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewNotificationService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *NotificationService {
+	mock := &NotificationService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,335 @@
+// Code generated by mockery v2.53.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	modelsstats "go-api/src/models/stats"
+
+	mock "github.com/stretchr/testify/mock"
+
+	stats "go-api/src/services/stats"
+
+	uuid "github.com/google/uuid"
+)
+
+// StatsService is an autogenerated mock type for the StatsService type
+type StatsService struct {
+	mock.Mock
+}
+
+type StatsService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *StatsService) EXPECT() *StatsService_Expecter {
+	return &StatsService_Expecter{mock: &_m.Mock}
+}
+
+// GetHeatmap provides a mock function with given fields: ctx, request
+func (_m *StatsService) GetHeatmap(ctx context.Context, request stats.GetHeatmapRequest) (*modelsstats.Heatmap, error) {
+	ret := _m.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetHeatmap")
+	}
+
+	var r0 *modelsstats.Heatmap
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, stats.GetHeatmapRequest) (*modelsstats.Heatmap, error)); ok {
+		return rf(ctx, request)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, stats.GetHeatmapRequest) *modelsstats.Heatmap); ok {
+		r0 = rf(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*modelsstats.Heatmap)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, stats.GetHeatmapRequest) error); ok {
+		r1 = rf(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StatsService_GetHeatmap_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetHeatmap'
+type StatsService_GetHeatmap_Call struct {
+	*mock.Call
+}
+
+// GetHeatmap is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request stats.GetHeatmapRequest
+func (_e *StatsService_Expecter) GetHeatmap(ctx interface{}, request interface{}) *StatsService_GetHeatmap_Call {
+	return &StatsService_GetHeatmap_Call{Call: _e.mock.On("GetHeatmap", ctx, request)}
+}
+
+func (_c *StatsService_GetHeatmap_Call) Run(run func(ctx context.Context, request stats.GetHeatmapRequest)) *StatsService_GetHeatmap_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(stats.GetHeatmapRequest))
+	})
+	return _c
+}
+
+func (_c *StatsService_GetHeatmap_Call) Return(_a0 *modelsstats.Heatmap, _a1 error) *StatsService_GetHeatmap_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *StatsService_GetHeatmap_Call) RunAndReturn(run func(context.Context, stats.GetHeatmapRequest) (*modelsstats.Heatmap, error)) *StatsService_GetHeatmap_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetStreak provides a mock function with given fields: ctx
+func (_m *StatsService) GetStreak(ctx context.Context) (*modelsstats.Streak, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetStreak")
+	}
+
+	var r0 *modelsstats.Streak
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (*modelsstats.Streak, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) *modelsstats.Streak); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*modelsstats.Streak)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StatsService_GetStreak_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetStreak'
+type StatsService_GetStreak_Call struct {
+	*mock.Call
+}
+
+// GetStreak is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *StatsService_Expecter) GetStreak(ctx interface{}) *StatsService_GetStreak_Call {
+	return &StatsService_GetStreak_Call{Call: _e.mock.On("GetStreak", ctx)}
+}
+
+func (_c *StatsService_GetStreak_Call) Run(run func(ctx context.Context)) *StatsService_GetStreak_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *StatsService_GetStreak_Call) Return(_a0 *modelsstats.Streak, _a1 error) *StatsService_GetStreak_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *StatsService_GetStreak_Call) RunAndReturn(run func(context.Context) (*modelsstats.Streak, error)) *StatsService_GetStreak_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetStreakUpdate provides a mock function with given fields: ctx, sessionID
+func (_m *StatsService) GetStreakUpdate(ctx context.Context, sessionID uuid.UUID) (*modelsstats.StreakUpdate, error) {
+	ret := _m.Called(ctx, sessionID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetStreakUpdate")
+	}
+
+	var r0 *modelsstats.StreakUpdate
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*modelsstats.StreakUpdate, error)); ok {
+		return rf(ctx, sessionID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *modelsstats.StreakUpdate); ok {
+		r0 = rf(ctx, sessionID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*modelsstats.StreakUpdate)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, sessionID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StatsService_GetStreakUpdate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetStreakUpdate'
+type StatsService_GetStreakUpdate_Call struct {
+	*mock.Call
+}
+
+// GetStreakUpdate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - sessionID uuid.UUID
+func (_e *StatsService_Expecter) GetStreakUpdate(ctx interface{}, sessionID interface{}) *StatsService_GetStreakUpdate_Call {
+	return &StatsService_GetStreakUpdate_Call{Call: _e.mock.On("GetStreakUpdate", ctx, sessionID)}
+}
+
+func (_c *StatsService_GetStreakUpdate_Call) Run(run func(ctx context.Context, sessionID uuid.UUID)) *StatsService_GetStreakUpdate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *StatsService_GetStreakUpdate_Call) Return(_a0 *modelsstats.StreakUpdate, _a1 error) *StatsService_GetStreakUpdate_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *StatsService_GetStreakUpdate_Call) RunAndReturn(run func(context.Context, uuid.UUID) (*modelsstats.StreakUpdate, error)) *StatsService_GetStreakUpdate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetStudyStats provides a mock function with given fields: ctx, request
+func (_m *StatsService) GetStudyStats(ctx context.Context, request stats.GetStudyStatsRequest) (*modelsstats.StudyStats, error) {
+	ret := _m.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetStudyStats")
+	}
+
+	var r0 *modelsstats.StudyStats
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, stats.GetStudyStatsRequest) (*modelsstats.StudyStats, error)); ok {
+		return rf(ctx, request)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, stats.GetStudyStatsRequest) *modelsstats.StudyStats); ok {
+		r0 = rf(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*modelsstats.StudyStats)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, stats.GetStudyStatsRequest) error); ok {
+		r1 = rf(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StatsService_GetStudyStats_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetStudyStats'
+type StatsService_GetStudyStats_Call struct {
+	*mock.Call
+}
+
+// GetStudyStats is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request stats.GetStudyStatsRequest
+func (_e *StatsService_Expecter) GetStudyStats(ctx interface{}, request interface{}) *StatsService_GetStudyStats_Call {
+	return &StatsService_GetStudyStats_Call{Call: _e.mock.On("GetStudyStats", ctx, request)}
+}
+
+func (_c *StatsService_GetStudyStats_Call) Run(run func(ctx context.Context, request stats.GetStudyStatsRequest)) *StatsService_GetStudyStats_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(stats.GetStudyStatsRequest))
+	})
+	return _c
+}
+
+func (_c *StatsService_GetStudyStats_Call) Return(_a0 *modelsstats.StudyStats, _a1 error) *StatsService_GetStudyStats_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *StatsService_GetStudyStats_Call) RunAndReturn(run func(context.Context, stats.GetStudyStatsRequest) (*modelsstats.StudyStats, error)) *StatsService_GetStudyStats_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateStreakSettings provides a mock function with given fields: ctx, request
+func (_m *StatsService) UpdateStreakSettings(ctx context.Context, request stats.UpdateStreakSettingsRequest) (*modelsstats.StreakSettings, error) {
+	ret := _m.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateStreakSettings")
+	}
+
+	var r0 *modelsstats.StreakSettings
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, stats.UpdateStreakSettingsRequest) (*modelsstats.StreakSettings, error)); ok {
+		return rf(ctx, request)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, stats.UpdateStreakSettingsRequest) *modelsstats.StreakSettings); ok {
+		r0 = rf(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*modelsstats.StreakSettings)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, stats.UpdateStreakSettingsRequest) error); ok {
+		r1 = rf(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StatsService_UpdateStreakSettings_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateStreakSettings'
+type StatsService_UpdateStreakSettings_Call struct {
+	*mock.Call
+}
+
+// UpdateStreakSettings is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request stats.UpdateStreakSettingsRequest
+func (_e *StatsService_Expecter) UpdateStreakSettings(ctx interface{}, request interface{}) *StatsService_UpdateStreakSettings_Call {
+	return &StatsService_UpdateStreakSettings_Call{Call: _e.mock.On("UpdateStreakSettings", ctx, request)}
+}
+
+func (_c *StatsService_UpdateStreakSettings_Call) Run(run func(ctx context.Context, request stats.UpdateStreakSettingsRequest)) *StatsService_UpdateStreakSettings_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(stats.UpdateStreakSettingsRequest))
+	})
+	return _c
+}
+
+func (_c *StatsService_UpdateStreakSettings_Call) Return(_a0 *modelsstats.StreakSettings, _a1 error) *StatsService_UpdateStreakSettings_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *StatsService_UpdateStreakSettings_Call) RunAndReturn(run func(context.Context, stats.UpdateStreakSettingsRequest) (*modelsstats.StreakSettings, error)) *StatsService_UpdateStreakSettings_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewStatsService creates a new instance of StatsService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewStatsService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *StatsService {
+	mock := &StatsService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
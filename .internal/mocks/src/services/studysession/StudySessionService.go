@@ -2,7 +2,18 @@
 
 package mocks
 
-import mock "github.com/stretchr/testify/mock"
+import (
+	context "context"
+	io "io"
+
+	mock "github.com/stretchr/testify/mock"
+
+	modelsstudysession "go-api/src/models/studysession"
+
+	studysession "go-api/src/services/studysession"
+
+	uuid "github.com/google/uuid"
+)
 
 // StudySessionService is an autogenerated mock type for the StudySessionService type
 type StudySessionService struct {
@@ -17,35 +28,1154 @@ func (_m *StudySessionService) EXPECT() *StudySessionService_Expecter {
 	return &StudySessionService_Expecter{mock: &_m.Mock}
 }
 
-// Sample provides a mock function with no fields
-func (_m *StudySessionService) Sample() {
-	_m.Called()
+// AddStudySessionEvents provides a mock function with given fields: ctx, request
+func (_m *StudySessionService) AddStudySessionEvents(ctx context.Context, request studysession.AddStudySessionEventsRequest) (*studysession.SessionEventsResponse, error) {
+	ret := _m.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddStudySessionEvents")
+	}
+
+	var r0 *studysession.SessionEventsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, studysession.AddStudySessionEventsRequest) (*studysession.SessionEventsResponse, error)); ok {
+		return rf(ctx, request)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, studysession.AddStudySessionEventsRequest) *studysession.SessionEventsResponse); ok {
+		r0 = rf(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*studysession.SessionEventsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, studysession.AddStudySessionEventsRequest) error); ok {
+		r1 = rf(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StudySessionService_AddStudySessionEvents_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddStudySessionEvents'
+type StudySessionService_AddStudySessionEvents_Call struct {
+	*mock.Call
+}
+
+// AddStudySessionEvents is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request studysession.AddStudySessionEventsRequest
+func (_e *StudySessionService_Expecter) AddStudySessionEvents(ctx interface{}, request interface{}) *StudySessionService_AddStudySessionEvents_Call {
+	return &StudySessionService_AddStudySessionEvents_Call{Call: _e.mock.On("AddStudySessionEvents", ctx, request)}
+}
+
+func (_c *StudySessionService_AddStudySessionEvents_Call) Run(run func(ctx context.Context, request studysession.AddStudySessionEventsRequest)) *StudySessionService_AddStudySessionEvents_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(studysession.AddStudySessionEventsRequest))
+	})
+	return _c
+}
+
+func (_c *StudySessionService_AddStudySessionEvents_Call) Return(_a0 *studysession.SessionEventsResponse, _a1 error) *StudySessionService_AddStudySessionEvents_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *StudySessionService_AddStudySessionEvents_Call) RunAndReturn(run func(context.Context, studysession.AddStudySessionEventsRequest) (*studysession.SessionEventsResponse, error)) *StudySessionService_AddStudySessionEvents_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CancelActiveStudySession provides a mock function with given fields: ctx
+func (_m *StudySessionService) CancelActiveStudySession(ctx context.Context) (*modelsstudysession.StudySession, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CancelActiveStudySession")
+	}
+
+	var r0 *modelsstudysession.StudySession
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (*modelsstudysession.StudySession, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) *modelsstudysession.StudySession); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*modelsstudysession.StudySession)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StudySessionService_CancelActiveStudySession_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CancelActiveStudySession'
+type StudySessionService_CancelActiveStudySession_Call struct {
+	*mock.Call
+}
+
+// CancelActiveStudySession is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *StudySessionService_Expecter) CancelActiveStudySession(ctx interface{}) *StudySessionService_CancelActiveStudySession_Call {
+	return &StudySessionService_CancelActiveStudySession_Call{Call: _e.mock.On("CancelActiveStudySession", ctx)}
+}
+
+func (_c *StudySessionService_CancelActiveStudySession_Call) Run(run func(ctx context.Context)) *StudySessionService_CancelActiveStudySession_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *StudySessionService_CancelActiveStudySession_Call) Return(_a0 *modelsstudysession.StudySession, _a1 error) *StudySessionService_CancelActiveStudySession_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *StudySessionService_CancelActiveStudySession_Call) RunAndReturn(run func(context.Context) (*modelsstudysession.StudySession, error)) *StudySessionService_CancelActiveStudySession_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CloseStaleStudySessions provides a mock function with given fields: ctx
+func (_m *StudySessionService) CloseStaleStudySessions(ctx context.Context) (int, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CloseStaleStudySessions")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (int, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) int); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StudySessionService_CloseStaleStudySessions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CloseStaleStudySessions'
+type StudySessionService_CloseStaleStudySessions_Call struct {
+	*mock.Call
+}
+
+// CloseStaleStudySessions is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *StudySessionService_Expecter) CloseStaleStudySessions(ctx interface{}) *StudySessionService_CloseStaleStudySessions_Call {
+	return &StudySessionService_CloseStaleStudySessions_Call{Call: _e.mock.On("CloseStaleStudySessions", ctx)}
+}
+
+func (_c *StudySessionService_CloseStaleStudySessions_Call) Run(run func(ctx context.Context)) *StudySessionService_CloseStaleStudySessions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *StudySessionService_CloseStaleStudySessions_Call) Return(_a0 int, _a1 error) *StudySessionService_CloseStaleStudySessions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *StudySessionService_CloseStaleStudySessions_Call) RunAndReturn(run func(context.Context) (int, error)) *StudySessionService_CloseStaleStudySessions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateManualStudySession provides a mock function with given fields: ctx, request
+func (_m *StudySessionService) CreateManualStudySession(ctx context.Context, request studysession.CreateManualStudySessionRequest) (*modelsstudysession.StudySession, error) {
+	ret := _m.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateManualStudySession")
+	}
+
+	var r0 *modelsstudysession.StudySession
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, studysession.CreateManualStudySessionRequest) (*modelsstudysession.StudySession, error)); ok {
+		return rf(ctx, request)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, studysession.CreateManualStudySessionRequest) *modelsstudysession.StudySession); ok {
+		r0 = rf(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*modelsstudysession.StudySession)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, studysession.CreateManualStudySessionRequest) error); ok {
+		r1 = rf(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StudySessionService_CreateManualStudySession_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateManualStudySession'
+type StudySessionService_CreateManualStudySession_Call struct {
+	*mock.Call
+}
+
+// CreateManualStudySession is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request studysession.CreateManualStudySessionRequest
+func (_e *StudySessionService_Expecter) CreateManualStudySession(ctx interface{}, request interface{}) *StudySessionService_CreateManualStudySession_Call {
+	return &StudySessionService_CreateManualStudySession_Call{Call: _e.mock.On("CreateManualStudySession", ctx, request)}
+}
+
+func (_c *StudySessionService_CreateManualStudySession_Call) Run(run func(ctx context.Context, request studysession.CreateManualStudySessionRequest)) *StudySessionService_CreateManualStudySession_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(studysession.CreateManualStudySessionRequest))
+	})
+	return _c
+}
+
+func (_c *StudySessionService_CreateManualStudySession_Call) Return(_a0 *modelsstudysession.StudySession, _a1 error) *StudySessionService_CreateManualStudySession_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *StudySessionService_CreateManualStudySession_Call) RunAndReturn(run func(context.Context, studysession.CreateManualStudySessionRequest) (*modelsstudysession.StudySession, error)) *StudySessionService_CreateManualStudySession_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateStudySession provides a mock function with given fields: ctx, request
+func (_m *StudySessionService) CreateStudySession(ctx context.Context, request studysession.UpsertActiveStudySessionRequest) (*modelsstudysession.StudySession, error) {
+	ret := _m.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateStudySession")
+	}
+
+	var r0 *modelsstudysession.StudySession
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, studysession.UpsertActiveStudySessionRequest) (*modelsstudysession.StudySession, error)); ok {
+		return rf(ctx, request)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, studysession.UpsertActiveStudySessionRequest) *modelsstudysession.StudySession); ok {
+		r0 = rf(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*modelsstudysession.StudySession)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, studysession.UpsertActiveStudySessionRequest) error); ok {
+		r1 = rf(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StudySessionService_CreateStudySession_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateStudySession'
+type StudySessionService_CreateStudySession_Call struct {
+	*mock.Call
+}
+
+// CreateStudySession is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request studysession.UpsertActiveStudySessionRequest
+func (_e *StudySessionService_Expecter) CreateStudySession(ctx interface{}, request interface{}) *StudySessionService_CreateStudySession_Call {
+	return &StudySessionService_CreateStudySession_Call{Call: _e.mock.On("CreateStudySession", ctx, request)}
+}
+
+func (_c *StudySessionService_CreateStudySession_Call) Run(run func(ctx context.Context, request studysession.UpsertActiveStudySessionRequest)) *StudySessionService_CreateStudySession_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(studysession.UpsertActiveStudySessionRequest))
+	})
+	return _c
+}
+
+func (_c *StudySessionService_CreateStudySession_Call) Return(_a0 *modelsstudysession.StudySession, _a1 error) *StudySessionService_CreateStudySession_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *StudySessionService_CreateStudySession_Call) RunAndReturn(run func(context.Context, studysession.UpsertActiveStudySessionRequest) (*modelsstudysession.StudySession, error)) *StudySessionService_CreateStudySession_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteStudySession provides a mock function with given fields: ctx, sessionID
+func (_m *StudySessionService) DeleteStudySession(ctx context.Context, sessionID uuid.UUID) error {
+	ret := _m.Called(ctx, sessionID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteStudySession")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = rf(ctx, sessionID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// StudySessionService_DeleteStudySession_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteStudySession'
+type StudySessionService_DeleteStudySession_Call struct {
+	*mock.Call
+}
+
+// DeleteStudySession is a helper method to define mock.On call
+//   - ctx context.Context
+//   - sessionID uuid.UUID
+func (_e *StudySessionService_Expecter) DeleteStudySession(ctx interface{}, sessionID interface{}) *StudySessionService_DeleteStudySession_Call {
+	return &StudySessionService_DeleteStudySession_Call{Call: _e.mock.On("DeleteStudySession", ctx, sessionID)}
+}
+
+func (_c *StudySessionService_DeleteStudySession_Call) Run(run func(ctx context.Context, sessionID uuid.UUID)) *StudySessionService_DeleteStudySession_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *StudySessionService_DeleteStudySession_Call) Return(_a0 error) *StudySessionService_DeleteStudySession_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *StudySessionService_DeleteStudySession_Call) RunAndReturn(run func(context.Context, uuid.UUID) error) *StudySessionService_DeleteStudySession_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ExportStudySessionData provides a mock function with given fields: ctx, request, w
+func (_m *StudySessionService) ExportStudySessionData(ctx context.Context, request studysession.ExportStudySessionDataRequest, w io.Writer) error {
+	ret := _m.Called(ctx, request, w)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExportStudySessionData")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, studysession.ExportStudySessionDataRequest, io.Writer) error); ok {
+		r0 = rf(ctx, request, w)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// StudySessionService_ExportStudySessionData_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExportStudySessionData'
+type StudySessionService_ExportStudySessionData_Call struct {
+	*mock.Call
+}
+
+// ExportStudySessionData is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request studysession.ExportStudySessionDataRequest
+//   - w io.Writer
+func (_e *StudySessionService_Expecter) ExportStudySessionData(ctx interface{}, request interface{}, w interface{}) *StudySessionService_ExportStudySessionData_Call {
+	return &StudySessionService_ExportStudySessionData_Call{Call: _e.mock.On("ExportStudySessionData", ctx, request, w)}
+}
+
+func (_c *StudySessionService_ExportStudySessionData_Call) Run(run func(ctx context.Context, request studysession.ExportStudySessionDataRequest, w io.Writer)) *StudySessionService_ExportStudySessionData_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(studysession.ExportStudySessionDataRequest), args[2].(io.Writer))
+	})
+	return _c
+}
+
+func (_c *StudySessionService_ExportStudySessionData_Call) Return(_a0 error) *StudySessionService_ExportStudySessionData_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *StudySessionService_ExportStudySessionData_Call) RunAndReturn(run func(context.Context, studysession.ExportStudySessionDataRequest, io.Writer) error) *StudySessionService_ExportStudySessionData_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ExportStudySessionsICal provides a mock function with given fields: ctx, request
+func (_m *StudySessionService) ExportStudySessionsICal(ctx context.Context, request studysession.ExportStudySessionsRequest) ([]byte, error) {
+	ret := _m.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExportStudySessionsICal")
+	}
+
+	var r0 []byte
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, studysession.ExportStudySessionsRequest) ([]byte, error)); ok {
+		return rf(ctx, request)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, studysession.ExportStudySessionsRequest) []byte); ok {
+		r0 = rf(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]byte)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, studysession.ExportStudySessionsRequest) error); ok {
+		r1 = rf(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StudySessionService_ExportStudySessionsICal_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExportStudySessionsICal'
+type StudySessionService_ExportStudySessionsICal_Call struct {
+	*mock.Call
+}
+
+// ExportStudySessionsICal is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request studysession.ExportStudySessionsRequest
+func (_e *StudySessionService_Expecter) ExportStudySessionsICal(ctx interface{}, request interface{}) *StudySessionService_ExportStudySessionsICal_Call {
+	return &StudySessionService_ExportStudySessionsICal_Call{Call: _e.mock.On("ExportStudySessionsICal", ctx, request)}
+}
+
+func (_c *StudySessionService_ExportStudySessionsICal_Call) Run(run func(ctx context.Context, request studysession.ExportStudySessionsRequest)) *StudySessionService_ExportStudySessionsICal_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(studysession.ExportStudySessionsRequest))
+	})
+	return _c
+}
+
+func (_c *StudySessionService_ExportStudySessionsICal_Call) Return(_a0 []byte, _a1 error) *StudySessionService_ExportStudySessionsICal_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *StudySessionService_ExportStudySessionsICal_Call) RunAndReturn(run func(context.Context, studysession.ExportStudySessionsRequest) ([]byte, error)) *StudySessionService_ExportStudySessionsICal_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FinishStudySession provides a mock function with given fields: ctx, request
+func (_m *StudySessionService) FinishStudySession(ctx context.Context, request studysession.FinishStudySessionRequest) (*studysession.FinishStudySessionResponse, error) {
+	ret := _m.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FinishStudySession")
+	}
+
+	var r0 *studysession.FinishStudySessionResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, studysession.FinishStudySessionRequest) (*studysession.FinishStudySessionResponse, error)); ok {
+		return rf(ctx, request)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, studysession.FinishStudySessionRequest) *studysession.FinishStudySessionResponse); ok {
+		r0 = rf(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*studysession.FinishStudySessionResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, studysession.FinishStudySessionRequest) error); ok {
+		r1 = rf(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StudySessionService_FinishStudySession_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FinishStudySession'
+type StudySessionService_FinishStudySession_Call struct {
+	*mock.Call
+}
+
+// FinishStudySession is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request studysession.FinishStudySessionRequest
+func (_e *StudySessionService_Expecter) FinishStudySession(ctx interface{}, request interface{}) *StudySessionService_FinishStudySession_Call {
+	return &StudySessionService_FinishStudySession_Call{Call: _e.mock.On("FinishStudySession", ctx, request)}
+}
+
+func (_c *StudySessionService_FinishStudySession_Call) Run(run func(ctx context.Context, request studysession.FinishStudySessionRequest)) *StudySessionService_FinishStudySession_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(studysession.FinishStudySessionRequest))
+	})
+	return _c
+}
+
+func (_c *StudySessionService_FinishStudySession_Call) Return(_a0 *studysession.FinishStudySessionResponse, _a1 error) *StudySessionService_FinishStudySession_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *StudySessionService_FinishStudySession_Call) RunAndReturn(run func(context.Context, studysession.FinishStudySessionRequest) (*studysession.FinishStudySessionResponse, error)) *StudySessionService_FinishStudySession_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetActiveStudySession provides a mock function with given fields: ctx
+func (_m *StudySessionService) GetActiveStudySession(ctx context.Context) (*modelsstudysession.StudySession, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetActiveStudySession")
+	}
+
+	var r0 *modelsstudysession.StudySession
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (*modelsstudysession.StudySession, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) *modelsstudysession.StudySession); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*modelsstudysession.StudySession)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StudySessionService_GetActiveStudySession_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetActiveStudySession'
+type StudySessionService_GetActiveStudySession_Call struct {
+	*mock.Call
+}
+
+// GetActiveStudySession is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *StudySessionService_Expecter) GetActiveStudySession(ctx interface{}) *StudySessionService_GetActiveStudySession_Call {
+	return &StudySessionService_GetActiveStudySession_Call{Call: _e.mock.On("GetActiveStudySession", ctx)}
+}
+
+func (_c *StudySessionService_GetActiveStudySession_Call) Run(run func(ctx context.Context)) *StudySessionService_GetActiveStudySession_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *StudySessionService_GetActiveStudySession_Call) Return(_a0 *modelsstudysession.StudySession, _a1 error) *StudySessionService_GetActiveStudySession_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *StudySessionService_GetActiveStudySession_Call) RunAndReturn(run func(context.Context) (*modelsstudysession.StudySession, error)) *StudySessionService_GetActiveStudySession_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetActiveStudySessionEvents provides a mock function with given fields: ctx, request
+func (_m *StudySessionService) GetActiveStudySessionEvents(ctx context.Context, request studysession.GetActiveStudySessionEventsRequest) (*studysession.SessionEventsResponse, error) {
+	ret := _m.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetActiveStudySessionEvents")
+	}
+
+	var r0 *studysession.SessionEventsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, studysession.GetActiveStudySessionEventsRequest) (*studysession.SessionEventsResponse, error)); ok {
+		return rf(ctx, request)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, studysession.GetActiveStudySessionEventsRequest) *studysession.SessionEventsResponse); ok {
+		r0 = rf(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*studysession.SessionEventsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, studysession.GetActiveStudySessionEventsRequest) error); ok {
+		r1 = rf(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StudySessionService_GetActiveStudySessionEvents_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetActiveStudySessionEvents'
+type StudySessionService_GetActiveStudySessionEvents_Call struct {
+	*mock.Call
+}
+
+// GetActiveStudySessionEvents is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request studysession.GetActiveStudySessionEventsRequest
+func (_e *StudySessionService_Expecter) GetActiveStudySessionEvents(ctx interface{}, request interface{}) *StudySessionService_GetActiveStudySessionEvents_Call {
+	return &StudySessionService_GetActiveStudySessionEvents_Call{Call: _e.mock.On("GetActiveStudySessionEvents", ctx, request)}
+}
+
+func (_c *StudySessionService_GetActiveStudySessionEvents_Call) Run(run func(ctx context.Context, request studysession.GetActiveStudySessionEventsRequest)) *StudySessionService_GetActiveStudySessionEvents_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(studysession.GetActiveStudySessionEventsRequest))
+	})
+	return _c
+}
+
+func (_c *StudySessionService_GetActiveStudySessionEvents_Call) Return(_a0 *studysession.SessionEventsResponse, _a1 error) *StudySessionService_GetActiveStudySessionEvents_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *StudySessionService_GetActiveStudySessionEvents_Call) RunAndReturn(run func(context.Context, studysession.GetActiveStudySessionEventsRequest) (*studysession.SessionEventsResponse, error)) *StudySessionService_GetActiveStudySessionEvents_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetStudySession provides a mock function with given fields: ctx, sessionID
+func (_m *StudySessionService) GetStudySession(ctx context.Context, sessionID uuid.UUID) (*modelsstudysession.StudySessionDetails, error) {
+	ret := _m.Called(ctx, sessionID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetStudySession")
+	}
+
+	var r0 *modelsstudysession.StudySessionDetails
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*modelsstudysession.StudySessionDetails, error)); ok {
+		return rf(ctx, sessionID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *modelsstudysession.StudySessionDetails); ok {
+		r0 = rf(ctx, sessionID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*modelsstudysession.StudySessionDetails)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, sessionID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StudySessionService_GetStudySession_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetStudySession'
+type StudySessionService_GetStudySession_Call struct {
+	*mock.Call
+}
+
+// GetStudySession is a helper method to define mock.On call
+//   - ctx context.Context
+//   - sessionID uuid.UUID
+func (_e *StudySessionService_Expecter) GetStudySession(ctx interface{}, sessionID interface{}) *StudySessionService_GetStudySession_Call {
+	return &StudySessionService_GetStudySession_Call{Call: _e.mock.On("GetStudySession", ctx, sessionID)}
+}
+
+func (_c *StudySessionService_GetStudySession_Call) Run(run func(ctx context.Context, sessionID uuid.UUID)) *StudySessionService_GetStudySession_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *StudySessionService_GetStudySession_Call) Return(_a0 *modelsstudysession.StudySessionDetails, _a1 error) *StudySessionService_GetStudySession_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *StudySessionService_GetStudySession_Call) RunAndReturn(run func(context.Context, uuid.UUID) (*modelsstudysession.StudySessionDetails, error)) *StudySessionService_GetStudySession_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetStudySessionEvents provides a mock function with given fields: ctx, sessionID
+func (_m *StudySessionService) GetStudySessionEvents(ctx context.Context, sessionID uuid.UUID) ([]modelsstudysession.SessionEvent, error) {
+	ret := _m.Called(ctx, sessionID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetStudySessionEvents")
+	}
+
+	var r0 []modelsstudysession.SessionEvent
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]modelsstudysession.SessionEvent, error)); ok {
+		return rf(ctx, sessionID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []modelsstudysession.SessionEvent); ok {
+		r0 = rf(ctx, sessionID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]modelsstudysession.SessionEvent)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, sessionID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StudySessionService_GetStudySessionEvents_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetStudySessionEvents'
+type StudySessionService_GetStudySessionEvents_Call struct {
+	*mock.Call
+}
+
+// GetStudySessionEvents is a helper method to define mock.On call
+//   - ctx context.Context
+//   - sessionID uuid.UUID
+func (_e *StudySessionService_Expecter) GetStudySessionEvents(ctx interface{}, sessionID interface{}) *StudySessionService_GetStudySessionEvents_Call {
+	return &StudySessionService_GetStudySessionEvents_Call{Call: _e.mock.On("GetStudySessionEvents", ctx, sessionID)}
+}
+
+func (_c *StudySessionService_GetStudySessionEvents_Call) Run(run func(ctx context.Context, sessionID uuid.UUID)) *StudySessionService_GetStudySessionEvents_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *StudySessionService_GetStudySessionEvents_Call) Return(_a0 []modelsstudysession.SessionEvent, _a1 error) *StudySessionService_GetStudySessionEvents_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *StudySessionService_GetStudySessionEvents_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]modelsstudysession.SessionEvent, error)) *StudySessionService_GetStudySessionEvents_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetStudySessionHistory provides a mock function with given fields: ctx, request
+func (_m *StudySessionService) GetStudySessionHistory(ctx context.Context, request studysession.GetStudySessionHistoryRequest) (*modelsstudysession.StudySessionPage, error) {
+	ret := _m.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetStudySessionHistory")
+	}
+
+	var r0 *modelsstudysession.StudySessionPage
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, studysession.GetStudySessionHistoryRequest) (*modelsstudysession.StudySessionPage, error)); ok {
+		return rf(ctx, request)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, studysession.GetStudySessionHistoryRequest) *modelsstudysession.StudySessionPage); ok {
+		r0 = rf(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*modelsstudysession.StudySessionPage)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, studysession.GetStudySessionHistoryRequest) error); ok {
+		r1 = rf(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StudySessionService_GetStudySessionHistory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetStudySessionHistory'
+type StudySessionService_GetStudySessionHistory_Call struct {
+	*mock.Call
+}
+
+// GetStudySessionHistory is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request studysession.GetStudySessionHistoryRequest
+func (_e *StudySessionService_Expecter) GetStudySessionHistory(ctx interface{}, request interface{}) *StudySessionService_GetStudySessionHistory_Call {
+	return &StudySessionService_GetStudySessionHistory_Call{Call: _e.mock.On("GetStudySessionHistory", ctx, request)}
+}
+
+func (_c *StudySessionService_GetStudySessionHistory_Call) Run(run func(ctx context.Context, request studysession.GetStudySessionHistoryRequest)) *StudySessionService_GetStudySessionHistory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(studysession.GetStudySessionHistoryRequest))
+	})
+	return _c
+}
+
+func (_c *StudySessionService_GetStudySessionHistory_Call) Return(_a0 *modelsstudysession.StudySessionPage, _a1 error) *StudySessionService_GetStudySessionHistory_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *StudySessionService_GetStudySessionHistory_Call) RunAndReturn(run func(context.Context, studysession.GetStudySessionHistoryRequest) (*modelsstudysession.StudySessionPage, error)) *StudySessionService_GetStudySessionHistory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSubjectTimeTotals provides a mock function with given fields: ctx, request
+func (_m *StudySessionService) GetSubjectTimeTotals(ctx context.Context, request studysession.GetSubjectTimeTotalsRequest) (*modelsstudysession.SubjectTimeReport, error) {
+	ret := _m.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSubjectTimeTotals")
+	}
+
+	var r0 *modelsstudysession.SubjectTimeReport
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, studysession.GetSubjectTimeTotalsRequest) (*modelsstudysession.SubjectTimeReport, error)); ok {
+		return rf(ctx, request)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, studysession.GetSubjectTimeTotalsRequest) *modelsstudysession.SubjectTimeReport); ok {
+		r0 = rf(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*modelsstudysession.SubjectTimeReport)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, studysession.GetSubjectTimeTotalsRequest) error); ok {
+		r1 = rf(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StudySessionService_GetSubjectTimeTotals_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSubjectTimeTotals'
+type StudySessionService_GetSubjectTimeTotals_Call struct {
+	*mock.Call
+}
+
+// GetSubjectTimeTotals is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request studysession.GetSubjectTimeTotalsRequest
+func (_e *StudySessionService_Expecter) GetSubjectTimeTotals(ctx interface{}, request interface{}) *StudySessionService_GetSubjectTimeTotals_Call {
+	return &StudySessionService_GetSubjectTimeTotals_Call{Call: _e.mock.On("GetSubjectTimeTotals", ctx, request)}
+}
+
+func (_c *StudySessionService_GetSubjectTimeTotals_Call) Run(run func(ctx context.Context, request studysession.GetSubjectTimeTotalsRequest)) *StudySessionService_GetSubjectTimeTotals_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(studysession.GetSubjectTimeTotalsRequest))
+	})
+	return _c
+}
+
+func (_c *StudySessionService_GetSubjectTimeTotals_Call) Return(_a0 *modelsstudysession.SubjectTimeReport, _a1 error) *StudySessionService_GetSubjectTimeTotals_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *StudySessionService_GetSubjectTimeTotals_Call) RunAndReturn(run func(context.Context, studysession.GetSubjectTimeTotalsRequest) (*modelsstudysession.SubjectTimeReport, error)) *StudySessionService_GetSubjectTimeTotals_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ImportStudySessions provides a mock function with given fields: ctx, request, file
+func (_m *StudySessionService) ImportStudySessions(ctx context.Context, request studysession.ImportStudySessionsRequest, file io.Reader) (*modelsstudysession.ImportReport, error) {
+	ret := _m.Called(ctx, request, file)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ImportStudySessions")
+	}
+
+	var r0 *modelsstudysession.ImportReport
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, studysession.ImportStudySessionsRequest, io.Reader) (*modelsstudysession.ImportReport, error)); ok {
+		return rf(ctx, request, file)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, studysession.ImportStudySessionsRequest, io.Reader) *modelsstudysession.ImportReport); ok {
+		r0 = rf(ctx, request, file)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*modelsstudysession.ImportReport)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, studysession.ImportStudySessionsRequest, io.Reader) error); ok {
+		r1 = rf(ctx, request, file)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StudySessionService_ImportStudySessions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ImportStudySessions'
+type StudySessionService_ImportStudySessions_Call struct {
+	*mock.Call
+}
+
+// ImportStudySessions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request studysession.ImportStudySessionsRequest
+//   - file io.Reader
+func (_e *StudySessionService_Expecter) ImportStudySessions(ctx interface{}, request interface{}, file interface{}) *StudySessionService_ImportStudySessions_Call {
+	return &StudySessionService_ImportStudySessions_Call{Call: _e.mock.On("ImportStudySessions", ctx, request, file)}
+}
+
+func (_c *StudySessionService_ImportStudySessions_Call) Run(run func(ctx context.Context, request studysession.ImportStudySessionsRequest, file io.Reader)) *StudySessionService_ImportStudySessions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(studysession.ImportStudySessionsRequest), args[2].(io.Reader))
+	})
+	return _c
+}
+
+func (_c *StudySessionService_ImportStudySessions_Call) Return(_a0 *modelsstudysession.ImportReport, _a1 error) *StudySessionService_ImportStudySessions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *StudySessionService_ImportStudySessions_Call) RunAndReturn(run func(context.Context, studysession.ImportStudySessionsRequest, io.Reader) (*modelsstudysession.ImportReport, error)) *StudySessionService_ImportStudySessions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PurgeDeletedStudySessions provides a mock function with given fields: ctx
+func (_m *StudySessionService) PurgeDeletedStudySessions(ctx context.Context) (int64, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PurgeDeletedStudySessions")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (int64, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) int64); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StudySessionService_PurgeDeletedStudySessions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PurgeDeletedStudySessions'
+type StudySessionService_PurgeDeletedStudySessions_Call struct {
+	*mock.Call
+}
+
+// PurgeDeletedStudySessions is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *StudySessionService_Expecter) PurgeDeletedStudySessions(ctx interface{}) *StudySessionService_PurgeDeletedStudySessions_Call {
+	return &StudySessionService_PurgeDeletedStudySessions_Call{Call: _e.mock.On("PurgeDeletedStudySessions", ctx)}
+}
+
+func (_c *StudySessionService_PurgeDeletedStudySessions_Call) Run(run func(ctx context.Context)) *StudySessionService_PurgeDeletedStudySessions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *StudySessionService_PurgeDeletedStudySessions_Call) Return(_a0 int64, _a1 error) *StudySessionService_PurgeDeletedStudySessions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *StudySessionService_PurgeDeletedStudySessions_Call) RunAndReturn(run func(context.Context) (int64, error)) *StudySessionService_PurgeDeletedStudySessions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RestoreStudySession provides a mock function with given fields: ctx, sessionID
+func (_m *StudySessionService) RestoreStudySession(ctx context.Context, sessionID uuid.UUID) (*modelsstudysession.StudySession, error) {
+	ret := _m.Called(ctx, sessionID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RestoreStudySession")
+	}
+
+	var r0 *modelsstudysession.StudySession
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*modelsstudysession.StudySession, error)); ok {
+		return rf(ctx, sessionID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *modelsstudysession.StudySession); ok {
+		r0 = rf(ctx, sessionID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*modelsstudysession.StudySession)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, sessionID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StudySessionService_RestoreStudySession_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RestoreStudySession'
+type StudySessionService_RestoreStudySession_Call struct {
+	*mock.Call
+}
+
+// RestoreStudySession is a helper method to define mock.On call
+//   - ctx context.Context
+//   - sessionID uuid.UUID
+func (_e *StudySessionService_Expecter) RestoreStudySession(ctx interface{}, sessionID interface{}) *StudySessionService_RestoreStudySession_Call {
+	return &StudySessionService_RestoreStudySession_Call{Call: _e.mock.On("RestoreStudySession", ctx, sessionID)}
+}
+
+func (_c *StudySessionService_RestoreStudySession_Call) Run(run func(ctx context.Context, sessionID uuid.UUID)) *StudySessionService_RestoreStudySession_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *StudySessionService_RestoreStudySession_Call) Return(_a0 *modelsstudysession.StudySession, _a1 error) *StudySessionService_RestoreStudySession_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *StudySessionService_RestoreStudySession_Call) RunAndReturn(run func(context.Context, uuid.UUID) (*modelsstudysession.StudySession, error)) *StudySessionService_RestoreStudySession_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateActiveStudySession provides a mock function with given fields: ctx, request
+func (_m *StudySessionService) UpdateActiveStudySession(ctx context.Context, request studysession.UpdateStudySessionRequest) (*modelsstudysession.StudySession, error) {
+	ret := _m.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateActiveStudySession")
+	}
+
+	var r0 *modelsstudysession.StudySession
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, studysession.UpdateStudySessionRequest) (*modelsstudysession.StudySession, error)); ok {
+		return rf(ctx, request)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, studysession.UpdateStudySessionRequest) *modelsstudysession.StudySession); ok {
+		r0 = rf(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*modelsstudysession.StudySession)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, studysession.UpdateStudySessionRequest) error); ok {
+		r1 = rf(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StudySessionService_UpdateActiveStudySession_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateActiveStudySession'
+type StudySessionService_UpdateActiveStudySession_Call struct {
+	*mock.Call
+}
+
+// UpdateActiveStudySession is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request studysession.UpdateStudySessionRequest
+func (_e *StudySessionService_Expecter) UpdateActiveStudySession(ctx interface{}, request interface{}) *StudySessionService_UpdateActiveStudySession_Call {
+	return &StudySessionService_UpdateActiveStudySession_Call{Call: _e.mock.On("UpdateActiveStudySession", ctx, request)}
+}
+
+func (_c *StudySessionService_UpdateActiveStudySession_Call) Run(run func(ctx context.Context, request studysession.UpdateStudySessionRequest)) *StudySessionService_UpdateActiveStudySession_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(studysession.UpdateStudySessionRequest))
+	})
+	return _c
+}
+
+func (_c *StudySessionService_UpdateActiveStudySession_Call) Return(_a0 *modelsstudysession.StudySession, _a1 error) *StudySessionService_UpdateActiveStudySession_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *StudySessionService_UpdateActiveStudySession_Call) RunAndReturn(run func(context.Context, studysession.UpdateStudySessionRequest) (*modelsstudysession.StudySession, error)) *StudySessionService_UpdateActiveStudySession_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateStudySession provides a mock function with given fields: ctx, sessionID, request
+func (_m *StudySessionService) UpdateStudySession(ctx context.Context, sessionID uuid.UUID, request studysession.UpdateStudySessionRequest) (*modelsstudysession.StudySession, error) {
+	ret := _m.Called(ctx, sessionID, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateStudySession")
+	}
+
+	var r0 *modelsstudysession.StudySession
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, studysession.UpdateStudySessionRequest) (*modelsstudysession.StudySession, error)); ok {
+		return rf(ctx, sessionID, request)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, studysession.UpdateStudySessionRequest) *modelsstudysession.StudySession); ok {
+		r0 = rf(ctx, sessionID, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*modelsstudysession.StudySession)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, studysession.UpdateStudySessionRequest) error); ok {
+		r1 = rf(ctx, sessionID, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
-// StudySessionService_Sample_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Sample'
-type StudySessionService_Sample_Call struct {
+// StudySessionService_UpdateStudySession_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateStudySession'
+type StudySessionService_UpdateStudySession_Call struct {
 	*mock.Call
 }
 
-// Sample is a helper method to define mock.On call
-func (_e *StudySessionService_Expecter) Sample() *StudySessionService_Sample_Call {
-	return &StudySessionService_Sample_Call{Call: _e.mock.On("Sample")}
+// UpdateStudySession is a helper method to define mock.On call
+//   - ctx context.Context
+//   - sessionID uuid.UUID
+//   - request studysession.UpdateStudySessionRequest
+func (_e *StudySessionService_Expecter) UpdateStudySession(ctx interface{}, sessionID interface{}, request interface{}) *StudySessionService_UpdateStudySession_Call {
+	return &StudySessionService_UpdateStudySession_Call{Call: _e.mock.On("UpdateStudySession", ctx, sessionID, request)}
 }
 
-func (_c *StudySessionService_Sample_Call) Run(run func()) *StudySessionService_Sample_Call {
+func (_c *StudySessionService_UpdateStudySession_Call) Run(run func(ctx context.Context, sessionID uuid.UUID, request studysession.UpdateStudySessionRequest)) *StudySessionService_UpdateStudySession_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run()
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(studysession.UpdateStudySessionRequest))
 	})
 	return _c
 }
 
-func (_c *StudySessionService_Sample_Call) Return() *StudySessionService_Sample_Call {
-	_c.Call.Return()
+func (_c *StudySessionService_UpdateStudySession_Call) Return(_a0 *modelsstudysession.StudySession, _a1 error) *StudySessionService_UpdateStudySession_Call {
+	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *StudySessionService_Sample_Call) RunAndReturn(run func()) *StudySessionService_Sample_Call {
-	_c.Run(run)
+func (_c *StudySessionService_UpdateStudySession_Call) RunAndReturn(run func(context.Context, uuid.UUID, studysession.UpdateStudySessionRequest) (*modelsstudysession.StudySession, error)) *StudySessionService_UpdateStudySession_Call {
+	_c.Call.Return(run)
 	return _c
 }
 
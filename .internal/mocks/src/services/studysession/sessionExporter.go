@@ -0,0 +1,127 @@
+// Code generated by mockery v2.53.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	modelsstudysession "go-api/src/models/studysession"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// sessionExporter is an autogenerated mock type for the sessionExporter type
+type sessionExporter struct {
+	mock.Mock
+}
+
+type sessionExporter_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *sessionExporter) EXPECT() *sessionExporter_Expecter {
+	return &sessionExporter_Expecter{mock: &_m.Mock}
+}
+
+// Close provides a mock function with no fields
+func (_m *sessionExporter) Close() error {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Close")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// sessionExporter_Close_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Close'
+type sessionExporter_Close_Call struct {
+	*mock.Call
+}
+
+// Close is a helper method to define mock.On call
+func (_e *sessionExporter_Expecter) Close() *sessionExporter_Close_Call {
+	return &sessionExporter_Close_Call{Call: _e.mock.On("Close")}
+}
+
+func (_c *sessionExporter_Close_Call) Run(run func()) *sessionExporter_Close_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *sessionExporter_Close_Call) Return(_a0 error) *sessionExporter_Close_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *sessionExporter_Close_Call) RunAndReturn(run func() error) *sessionExporter_Close_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Write provides a mock function with given fields: session
+func (_m *sessionExporter) Write(session modelsstudysession.StudySessionDetails) error {
+	ret := _m.Called(session)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Write")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(modelsstudysession.StudySessionDetails) error); ok {
+		r0 = rf(session)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// sessionExporter_Write_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Write'
+type sessionExporter_Write_Call struct {
+	*mock.Call
+}
+
+// Write is a helper method to define mock.On call
+//   - session modelsstudysession.StudySessionDetails
+func (_e *sessionExporter_Expecter) Write(session interface{}) *sessionExporter_Write_Call {
+	return &sessionExporter_Write_Call{Call: _e.mock.On("Write", session)}
+}
+
+func (_c *sessionExporter_Write_Call) Run(run func(session modelsstudysession.StudySessionDetails)) *sessionExporter_Write_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(modelsstudysession.StudySessionDetails))
+	})
+	return _c
+}
+
+func (_c *sessionExporter_Write_Call) Return(_a0 error) *sessionExporter_Write_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *sessionExporter_Write_Call) RunAndReturn(run func(modelsstudysession.StudySessionDetails) error) *sessionExporter_Write_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// newSessionExporter creates a new instance of sessionExporter. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func newSessionExporter(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *sessionExporter {
+	mock := &sessionExporter{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,676 @@
+// Code generated by mockery v2.53.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	servicessubjects "go-api/src/services/subjects"
+
+	mock "github.com/stretchr/testify/mock"
+
+	subjects "go-api/src/models/subjects"
+
+	uuid "github.com/google/uuid"
+)
+
+// SubjectService is an autogenerated mock type for the SubjectService type
+type SubjectService struct {
+	mock.Mock
+}
+
+type SubjectService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *SubjectService) EXPECT() *SubjectService_Expecter {
+	return &SubjectService_Expecter{mock: &_m.Mock}
+}
+
+// ArchiveSubject provides a mock function with given fields: ctx, subjectID
+func (_m *SubjectService) ArchiveSubject(ctx context.Context, subjectID uuid.UUID) (*subjects.Subject, error) {
+	ret := _m.Called(ctx, subjectID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ArchiveSubject")
+	}
+
+	var r0 *subjects.Subject
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*subjects.Subject, error)); ok {
+		return rf(ctx, subjectID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *subjects.Subject); ok {
+		r0 = rf(ctx, subjectID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*subjects.Subject)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, subjectID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SubjectService_ArchiveSubject_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ArchiveSubject'
+type SubjectService_ArchiveSubject_Call struct {
+	*mock.Call
+}
+
+// ArchiveSubject is a helper method to define mock.On call
+//   - ctx context.Context
+//   - subjectID uuid.UUID
+func (_e *SubjectService_Expecter) ArchiveSubject(ctx interface{}, subjectID interface{}) *SubjectService_ArchiveSubject_Call {
+	return &SubjectService_ArchiveSubject_Call{Call: _e.mock.On("ArchiveSubject", ctx, subjectID)}
+}
+
+func (_c *SubjectService_ArchiveSubject_Call) Run(run func(ctx context.Context, subjectID uuid.UUID)) *SubjectService_ArchiveSubject_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *SubjectService_ArchiveSubject_Call) Return(_a0 *subjects.Subject, _a1 error) *SubjectService_ArchiveSubject_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SubjectService_ArchiveSubject_Call) RunAndReturn(run func(context.Context, uuid.UUID) (*subjects.Subject, error)) *SubjectService_ArchiveSubject_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateRelation provides a mock function with given fields: ctx, request
+func (_m *SubjectService) CreateRelation(ctx context.Context, request servicessubjects.CreateRelationRequest) (*subjects.SubjectRelation, error) {
+	ret := _m.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateRelation")
+	}
+
+	var r0 *subjects.SubjectRelation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, servicessubjects.CreateRelationRequest) (*subjects.SubjectRelation, error)); ok {
+		return rf(ctx, request)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, servicessubjects.CreateRelationRequest) *subjects.SubjectRelation); ok {
+		r0 = rf(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*subjects.SubjectRelation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, servicessubjects.CreateRelationRequest) error); ok {
+		r1 = rf(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SubjectService_CreateRelation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateRelation'
+type SubjectService_CreateRelation_Call struct {
+	*mock.Call
+}
+
+// CreateRelation is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request servicessubjects.CreateRelationRequest
+func (_e *SubjectService_Expecter) CreateRelation(ctx interface{}, request interface{}) *SubjectService_CreateRelation_Call {
+	return &SubjectService_CreateRelation_Call{Call: _e.mock.On("CreateRelation", ctx, request)}
+}
+
+func (_c *SubjectService_CreateRelation_Call) Run(run func(ctx context.Context, request servicessubjects.CreateRelationRequest)) *SubjectService_CreateRelation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(servicessubjects.CreateRelationRequest))
+	})
+	return _c
+}
+
+func (_c *SubjectService_CreateRelation_Call) Return(_a0 *subjects.SubjectRelation, _a1 error) *SubjectService_CreateRelation_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SubjectService_CreateRelation_Call) RunAndReturn(run func(context.Context, servicessubjects.CreateRelationRequest) (*subjects.SubjectRelation, error)) *SubjectService_CreateRelation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateSubject provides a mock function with given fields: ctx, request
+func (_m *SubjectService) CreateSubject(ctx context.Context, request servicessubjects.CreateSubjectRequest) (*subjects.Subject, error) {
+	ret := _m.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateSubject")
+	}
+
+	var r0 *subjects.Subject
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, servicessubjects.CreateSubjectRequest) (*subjects.Subject, error)); ok {
+		return rf(ctx, request)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, servicessubjects.CreateSubjectRequest) *subjects.Subject); ok {
+		r0 = rf(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*subjects.Subject)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, servicessubjects.CreateSubjectRequest) error); ok {
+		r1 = rf(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SubjectService_CreateSubject_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateSubject'
+type SubjectService_CreateSubject_Call struct {
+	*mock.Call
+}
+
+// CreateSubject is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request servicessubjects.CreateSubjectRequest
+func (_e *SubjectService_Expecter) CreateSubject(ctx interface{}, request interface{}) *SubjectService_CreateSubject_Call {
+	return &SubjectService_CreateSubject_Call{Call: _e.mock.On("CreateSubject", ctx, request)}
+}
+
+func (_c *SubjectService_CreateSubject_Call) Run(run func(ctx context.Context, request servicessubjects.CreateSubjectRequest)) *SubjectService_CreateSubject_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(servicessubjects.CreateSubjectRequest))
+	})
+	return _c
+}
+
+func (_c *SubjectService_CreateSubject_Call) Return(_a0 *subjects.Subject, _a1 error) *SubjectService_CreateSubject_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SubjectService_CreateSubject_Call) RunAndReturn(run func(context.Context, servicessubjects.CreateSubjectRequest) (*subjects.Subject, error)) *SubjectService_CreateSubject_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteRelation provides a mock function with given fields: ctx, relationID
+func (_m *SubjectService) DeleteRelation(ctx context.Context, relationID uuid.UUID) error {
+	ret := _m.Called(ctx, relationID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteRelation")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = rf(ctx, relationID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SubjectService_DeleteRelation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteRelation'
+type SubjectService_DeleteRelation_Call struct {
+	*mock.Call
+}
+
+// DeleteRelation is a helper method to define mock.On call
+//   - ctx context.Context
+//   - relationID uuid.UUID
+func (_e *SubjectService_Expecter) DeleteRelation(ctx interface{}, relationID interface{}) *SubjectService_DeleteRelation_Call {
+	return &SubjectService_DeleteRelation_Call{Call: _e.mock.On("DeleteRelation", ctx, relationID)}
+}
+
+func (_c *SubjectService_DeleteRelation_Call) Run(run func(ctx context.Context, relationID uuid.UUID)) *SubjectService_DeleteRelation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *SubjectService_DeleteRelation_Call) Return(_a0 error) *SubjectService_DeleteRelation_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *SubjectService_DeleteRelation_Call) RunAndReturn(run func(context.Context, uuid.UUID) error) *SubjectService_DeleteRelation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetStudyOrder provides a mock function with given fields: ctx
+func (_m *SubjectService) GetStudyOrder(ctx context.Context) ([]subjects.Subject, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetStudyOrder")
+	}
+
+	var r0 []subjects.Subject
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]subjects.Subject, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []subjects.Subject); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]subjects.Subject)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SubjectService_GetStudyOrder_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetStudyOrder'
+type SubjectService_GetStudyOrder_Call struct {
+	*mock.Call
+}
+
+// GetStudyOrder is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *SubjectService_Expecter) GetStudyOrder(ctx interface{}) *SubjectService_GetStudyOrder_Call {
+	return &SubjectService_GetStudyOrder_Call{Call: _e.mock.On("GetStudyOrder", ctx)}
+}
+
+func (_c *SubjectService_GetStudyOrder_Call) Run(run func(ctx context.Context)) *SubjectService_GetStudyOrder_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *SubjectService_GetStudyOrder_Call) Return(_a0 []subjects.Subject, _a1 error) *SubjectService_GetStudyOrder_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SubjectService_GetStudyOrder_Call) RunAndReturn(run func(context.Context) ([]subjects.Subject, error)) *SubjectService_GetStudyOrder_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSubject provides a mock function with given fields: ctx, subjectID
+func (_m *SubjectService) GetSubject(ctx context.Context, subjectID uuid.UUID) (*subjects.Subject, error) {
+	ret := _m.Called(ctx, subjectID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSubject")
+	}
+
+	var r0 *subjects.Subject
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*subjects.Subject, error)); ok {
+		return rf(ctx, subjectID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *subjects.Subject); ok {
+		r0 = rf(ctx, subjectID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*subjects.Subject)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, subjectID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SubjectService_GetSubject_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSubject'
+type SubjectService_GetSubject_Call struct {
+	*mock.Call
+}
+
+// GetSubject is a helper method to define mock.On call
+//   - ctx context.Context
+//   - subjectID uuid.UUID
+func (_e *SubjectService_Expecter) GetSubject(ctx interface{}, subjectID interface{}) *SubjectService_GetSubject_Call {
+	return &SubjectService_GetSubject_Call{Call: _e.mock.On("GetSubject", ctx, subjectID)}
+}
+
+func (_c *SubjectService_GetSubject_Call) Run(run func(ctx context.Context, subjectID uuid.UUID)) *SubjectService_GetSubject_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *SubjectService_GetSubject_Call) Return(_a0 *subjects.Subject, _a1 error) *SubjectService_GetSubject_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SubjectService_GetSubject_Call) RunAndReturn(run func(context.Context, uuid.UUID) (*subjects.Subject, error)) *SubjectService_GetSubject_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSubjectTree provides a mock function with given fields: ctx
+func (_m *SubjectService) GetSubjectTree(ctx context.Context) ([]subjects.SubjectNode, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSubjectTree")
+	}
+
+	var r0 []subjects.SubjectNode
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]subjects.SubjectNode, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []subjects.SubjectNode); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]subjects.SubjectNode)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SubjectService_GetSubjectTree_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSubjectTree'
+type SubjectService_GetSubjectTree_Call struct {
+	*mock.Call
+}
+
+// GetSubjectTree is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *SubjectService_Expecter) GetSubjectTree(ctx interface{}) *SubjectService_GetSubjectTree_Call {
+	return &SubjectService_GetSubjectTree_Call{Call: _e.mock.On("GetSubjectTree", ctx)}
+}
+
+func (_c *SubjectService_GetSubjectTree_Call) Run(run func(ctx context.Context)) *SubjectService_GetSubjectTree_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *SubjectService_GetSubjectTree_Call) Return(_a0 []subjects.SubjectNode, _a1 error) *SubjectService_GetSubjectTree_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SubjectService_GetSubjectTree_Call) RunAndReturn(run func(context.Context) ([]subjects.SubjectNode, error)) *SubjectService_GetSubjectTree_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListRelations provides a mock function with given fields: ctx
+func (_m *SubjectService) ListRelations(ctx context.Context) ([]subjects.SubjectRelation, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListRelations")
+	}
+
+	var r0 []subjects.SubjectRelation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]subjects.SubjectRelation, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []subjects.SubjectRelation); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]subjects.SubjectRelation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SubjectService_ListRelations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListRelations'
+type SubjectService_ListRelations_Call struct {
+	*mock.Call
+}
+
+// ListRelations is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *SubjectService_Expecter) ListRelations(ctx interface{}) *SubjectService_ListRelations_Call {
+	return &SubjectService_ListRelations_Call{Call: _e.mock.On("ListRelations", ctx)}
+}
+
+func (_c *SubjectService_ListRelations_Call) Run(run func(ctx context.Context)) *SubjectService_ListRelations_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *SubjectService_ListRelations_Call) Return(_a0 []subjects.SubjectRelation, _a1 error) *SubjectService_ListRelations_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SubjectService_ListRelations_Call) RunAndReturn(run func(context.Context) ([]subjects.SubjectRelation, error)) *SubjectService_ListRelations_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListSubjects provides a mock function with given fields: ctx, request
+func (_m *SubjectService) ListSubjects(ctx context.Context, request servicessubjects.ListSubjectsRequest) ([]subjects.Subject, error) {
+	ret := _m.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListSubjects")
+	}
+
+	var r0 []subjects.Subject
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, servicessubjects.ListSubjectsRequest) ([]subjects.Subject, error)); ok {
+		return rf(ctx, request)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, servicessubjects.ListSubjectsRequest) []subjects.Subject); ok {
+		r0 = rf(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]subjects.Subject)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, servicessubjects.ListSubjectsRequest) error); ok {
+		r1 = rf(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SubjectService_ListSubjects_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListSubjects'
+type SubjectService_ListSubjects_Call struct {
+	*mock.Call
+}
+
+// ListSubjects is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request servicessubjects.ListSubjectsRequest
+func (_e *SubjectService_Expecter) ListSubjects(ctx interface{}, request interface{}) *SubjectService_ListSubjects_Call {
+	return &SubjectService_ListSubjects_Call{Call: _e.mock.On("ListSubjects", ctx, request)}
+}
+
+func (_c *SubjectService_ListSubjects_Call) Run(run func(ctx context.Context, request servicessubjects.ListSubjectsRequest)) *SubjectService_ListSubjects_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(servicessubjects.ListSubjectsRequest))
+	})
+	return _c
+}
+
+func (_c *SubjectService_ListSubjects_Call) Return(_a0 []subjects.Subject, _a1 error) *SubjectService_ListSubjects_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SubjectService_ListSubjects_Call) RunAndReturn(run func(context.Context, servicessubjects.ListSubjectsRequest) ([]subjects.Subject, error)) *SubjectService_ListSubjects_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UnarchiveSubject provides a mock function with given fields: ctx, subjectID
+func (_m *SubjectService) UnarchiveSubject(ctx context.Context, subjectID uuid.UUID) (*subjects.Subject, error) {
+	ret := _m.Called(ctx, subjectID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UnarchiveSubject")
+	}
+
+	var r0 *subjects.Subject
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*subjects.Subject, error)); ok {
+		return rf(ctx, subjectID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *subjects.Subject); ok {
+		r0 = rf(ctx, subjectID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*subjects.Subject)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, subjectID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SubjectService_UnarchiveSubject_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UnarchiveSubject'
+type SubjectService_UnarchiveSubject_Call struct {
+	*mock.Call
+}
+
+// UnarchiveSubject is a helper method to define mock.On call
+//   - ctx context.Context
+//   - subjectID uuid.UUID
+func (_e *SubjectService_Expecter) UnarchiveSubject(ctx interface{}, subjectID interface{}) *SubjectService_UnarchiveSubject_Call {
+	return &SubjectService_UnarchiveSubject_Call{Call: _e.mock.On("UnarchiveSubject", ctx, subjectID)}
+}
+
+func (_c *SubjectService_UnarchiveSubject_Call) Run(run func(ctx context.Context, subjectID uuid.UUID)) *SubjectService_UnarchiveSubject_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *SubjectService_UnarchiveSubject_Call) Return(_a0 *subjects.Subject, _a1 error) *SubjectService_UnarchiveSubject_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SubjectService_UnarchiveSubject_Call) RunAndReturn(run func(context.Context, uuid.UUID) (*subjects.Subject, error)) *SubjectService_UnarchiveSubject_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateSubject provides a mock function with given fields: ctx, subjectID, request
+func (_m *SubjectService) UpdateSubject(ctx context.Context, subjectID uuid.UUID, request servicessubjects.UpdateSubjectRequest) (*subjects.Subject, error) {
+	ret := _m.Called(ctx, subjectID, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateSubject")
+	}
+
+	var r0 *subjects.Subject
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, servicessubjects.UpdateSubjectRequest) (*subjects.Subject, error)); ok {
+		return rf(ctx, subjectID, request)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, servicessubjects.UpdateSubjectRequest) *subjects.Subject); ok {
+		r0 = rf(ctx, subjectID, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*subjects.Subject)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, servicessubjects.UpdateSubjectRequest) error); ok {
+		r1 = rf(ctx, subjectID, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SubjectService_UpdateSubject_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateSubject'
+type SubjectService_UpdateSubject_Call struct {
+	*mock.Call
+}
+
+// UpdateSubject is a helper method to define mock.On call
+//   - ctx context.Context
+//   - subjectID uuid.UUID
+//   - request servicessubjects.UpdateSubjectRequest
+func (_e *SubjectService_Expecter) UpdateSubject(ctx interface{}, subjectID interface{}, request interface{}) *SubjectService_UpdateSubject_Call {
+	return &SubjectService_UpdateSubject_Call{Call: _e.mock.On("UpdateSubject", ctx, subjectID, request)}
+}
+
+func (_c *SubjectService_UpdateSubject_Call) Run(run func(ctx context.Context, subjectID uuid.UUID, request servicessubjects.UpdateSubjectRequest)) *SubjectService_UpdateSubject_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(servicessubjects.UpdateSubjectRequest))
+	})
+	return _c
+}
+
+func (_c *SubjectService_UpdateSubject_Call) Return(_a0 *subjects.Subject, _a1 error) *SubjectService_UpdateSubject_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SubjectService_UpdateSubject_Call) RunAndReturn(run func(context.Context, uuid.UUID, servicessubjects.UpdateSubjectRequest) (*subjects.Subject, error)) *SubjectService_UpdateSubject_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewSubjectService creates a new instance of SubjectService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewSubjectService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *SubjectService {
+	mock := &SubjectService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,556 @@
+// Code generated by mockery v2.53.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	modelswebhooks "go-api/src/models/webhooks"
+
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+
+	webhooks "go-api/src/services/webhooks"
+)
+
+// WebhookService is an autogenerated mock type for the WebhookService type
+type WebhookService struct {
+	mock.Mock
+}
+
+type WebhookService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *WebhookService) EXPECT() *WebhookService_Expecter {
+	return &WebhookService_Expecter{mock: &_m.Mock}
+}
+
+// CreateEndpoint provides a mock function with given fields: ctx, request
+func (_m *WebhookService) CreateEndpoint(ctx context.Context, request webhooks.CreateEndpointRequest) (*modelswebhooks.CreatedEndpoint, error) {
+	ret := _m.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateEndpoint")
+	}
+
+	var r0 *modelswebhooks.CreatedEndpoint
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, webhooks.CreateEndpointRequest) (*modelswebhooks.CreatedEndpoint, error)); ok {
+		return rf(ctx, request)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, webhooks.CreateEndpointRequest) *modelswebhooks.CreatedEndpoint); ok {
+		r0 = rf(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*modelswebhooks.CreatedEndpoint)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, webhooks.CreateEndpointRequest) error); ok {
+		r1 = rf(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// WebhookService_CreateEndpoint_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateEndpoint'
+type WebhookService_CreateEndpoint_Call struct {
+	*mock.Call
+}
+
+// CreateEndpoint is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request webhooks.CreateEndpointRequest
+func (_e *WebhookService_Expecter) CreateEndpoint(ctx interface{}, request interface{}) *WebhookService_CreateEndpoint_Call {
+	return &WebhookService_CreateEndpoint_Call{Call: _e.mock.On("CreateEndpoint", ctx, request)}
+}
+
+func (_c *WebhookService_CreateEndpoint_Call) Run(run func(ctx context.Context, request webhooks.CreateEndpointRequest)) *WebhookService_CreateEndpoint_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(webhooks.CreateEndpointRequest))
+	})
+	return _c
+}
+
+func (_c *WebhookService_CreateEndpoint_Call) Return(_a0 *modelswebhooks.CreatedEndpoint, _a1 error) *WebhookService_CreateEndpoint_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *WebhookService_CreateEndpoint_Call) RunAndReturn(run func(context.Context, webhooks.CreateEndpointRequest) (*modelswebhooks.CreatedEndpoint, error)) *WebhookService_CreateEndpoint_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteEndpoint provides a mock function with given fields: ctx, endpointID
+func (_m *WebhookService) DeleteEndpoint(ctx context.Context, endpointID uuid.UUID) error {
+	ret := _m.Called(ctx, endpointID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteEndpoint")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = rf(ctx, endpointID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// WebhookService_DeleteEndpoint_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteEndpoint'
+type WebhookService_DeleteEndpoint_Call struct {
+	*mock.Call
+}
+
+// DeleteEndpoint is a helper method to define mock.On call
+//   - ctx context.Context
+//   - endpointID uuid.UUID
+func (_e *WebhookService_Expecter) DeleteEndpoint(ctx interface{}, endpointID interface{}) *WebhookService_DeleteEndpoint_Call {
+	return &WebhookService_DeleteEndpoint_Call{Call: _e.mock.On("DeleteEndpoint", ctx, endpointID)}
+}
+
+func (_c *WebhookService_DeleteEndpoint_Call) Run(run func(ctx context.Context, endpointID uuid.UUID)) *WebhookService_DeleteEndpoint_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *WebhookService_DeleteEndpoint_Call) Return(_a0 error) *WebhookService_DeleteEndpoint_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *WebhookService_DeleteEndpoint_Call) RunAndReturn(run func(context.Context, uuid.UUID) error) *WebhookService_DeleteEndpoint_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeliverDueWebhooks provides a mock function with given fields: ctx
+func (_m *WebhookService) DeliverDueWebhooks(ctx context.Context) (int, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeliverDueWebhooks")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (int, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) int); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// WebhookService_DeliverDueWebhooks_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeliverDueWebhooks'
+type WebhookService_DeliverDueWebhooks_Call struct {
+	*mock.Call
+}
+
+// DeliverDueWebhooks is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *WebhookService_Expecter) DeliverDueWebhooks(ctx interface{}) *WebhookService_DeliverDueWebhooks_Call {
+	return &WebhookService_DeliverDueWebhooks_Call{Call: _e.mock.On("DeliverDueWebhooks", ctx)}
+}
+
+func (_c *WebhookService_DeliverDueWebhooks_Call) Run(run func(ctx context.Context)) *WebhookService_DeliverDueWebhooks_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *WebhookService_DeliverDueWebhooks_Call) Return(_a0 int, _a1 error) *WebhookService_DeliverDueWebhooks_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *WebhookService_DeliverDueWebhooks_Call) RunAndReturn(run func(context.Context) (int, error)) *WebhookService_DeliverDueWebhooks_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetEndpoint provides a mock function with given fields: ctx, endpointID
+func (_m *WebhookService) GetEndpoint(ctx context.Context, endpointID uuid.UUID) (*modelswebhooks.Endpoint, error) {
+	ret := _m.Called(ctx, endpointID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetEndpoint")
+	}
+
+	var r0 *modelswebhooks.Endpoint
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*modelswebhooks.Endpoint, error)); ok {
+		return rf(ctx, endpointID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *modelswebhooks.Endpoint); ok {
+		r0 = rf(ctx, endpointID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*modelswebhooks.Endpoint)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, endpointID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// WebhookService_GetEndpoint_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetEndpoint'
+type WebhookService_GetEndpoint_Call struct {
+	*mock.Call
+}
+
+// GetEndpoint is a helper method to define mock.On call
+//   - ctx context.Context
+//   - endpointID uuid.UUID
+func (_e *WebhookService_Expecter) GetEndpoint(ctx interface{}, endpointID interface{}) *WebhookService_GetEndpoint_Call {
+	return &WebhookService_GetEndpoint_Call{Call: _e.mock.On("GetEndpoint", ctx, endpointID)}
+}
+
+func (_c *WebhookService_GetEndpoint_Call) Run(run func(ctx context.Context, endpointID uuid.UUID)) *WebhookService_GetEndpoint_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *WebhookService_GetEndpoint_Call) Return(_a0 *modelswebhooks.Endpoint, _a1 error) *WebhookService_GetEndpoint_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *WebhookService_GetEndpoint_Call) RunAndReturn(run func(context.Context, uuid.UUID) (*modelswebhooks.Endpoint, error)) *WebhookService_GetEndpoint_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListDeliveries provides a mock function with given fields: ctx, endpointID, request
+func (_m *WebhookService) ListDeliveries(ctx context.Context, endpointID uuid.UUID, request webhooks.ListDeliveriesRequest) ([]modelswebhooks.Delivery, error) {
+	ret := _m.Called(ctx, endpointID, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListDeliveries")
+	}
+
+	var r0 []modelswebhooks.Delivery
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, webhooks.ListDeliveriesRequest) ([]modelswebhooks.Delivery, error)); ok {
+		return rf(ctx, endpointID, request)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, webhooks.ListDeliveriesRequest) []modelswebhooks.Delivery); ok {
+		r0 = rf(ctx, endpointID, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]modelswebhooks.Delivery)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, webhooks.ListDeliveriesRequest) error); ok {
+		r1 = rf(ctx, endpointID, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// WebhookService_ListDeliveries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListDeliveries'
+type WebhookService_ListDeliveries_Call struct {
+	*mock.Call
+}
+
+// ListDeliveries is a helper method to define mock.On call
+//   - ctx context.Context
+//   - endpointID uuid.UUID
+//   - request webhooks.ListDeliveriesRequest
+func (_e *WebhookService_Expecter) ListDeliveries(ctx interface{}, endpointID interface{}, request interface{}) *WebhookService_ListDeliveries_Call {
+	return &WebhookService_ListDeliveries_Call{Call: _e.mock.On("ListDeliveries", ctx, endpointID, request)}
+}
+
+func (_c *WebhookService_ListDeliveries_Call) Run(run func(ctx context.Context, endpointID uuid.UUID, request webhooks.ListDeliveriesRequest)) *WebhookService_ListDeliveries_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(webhooks.ListDeliveriesRequest))
+	})
+	return _c
+}
+
+func (_c *WebhookService_ListDeliveries_Call) Return(_a0 []modelswebhooks.Delivery, _a1 error) *WebhookService_ListDeliveries_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *WebhookService_ListDeliveries_Call) RunAndReturn(run func(context.Context, uuid.UUID, webhooks.ListDeliveriesRequest) ([]modelswebhooks.Delivery, error)) *WebhookService_ListDeliveries_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListEndpoints provides a mock function with given fields: ctx
+func (_m *WebhookService) ListEndpoints(ctx context.Context) ([]modelswebhooks.Endpoint, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListEndpoints")
+	}
+
+	var r0 []modelswebhooks.Endpoint
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]modelswebhooks.Endpoint, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []modelswebhooks.Endpoint); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]modelswebhooks.Endpoint)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// WebhookService_ListEndpoints_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListEndpoints'
+type WebhookService_ListEndpoints_Call struct {
+	*mock.Call
+}
+
+// ListEndpoints is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *WebhookService_Expecter) ListEndpoints(ctx interface{}) *WebhookService_ListEndpoints_Call {
+	return &WebhookService_ListEndpoints_Call{Call: _e.mock.On("ListEndpoints", ctx)}
+}
+
+func (_c *WebhookService_ListEndpoints_Call) Run(run func(ctx context.Context)) *WebhookService_ListEndpoints_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *WebhookService_ListEndpoints_Call) Return(_a0 []modelswebhooks.Endpoint, _a1 error) *WebhookService_ListEndpoints_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *WebhookService_ListEndpoints_Call) RunAndReturn(run func(context.Context) ([]modelswebhooks.Endpoint, error)) *WebhookService_ListEndpoints_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PurgeEvents provides a mock function with given fields: ctx
+func (_m *WebhookService) PurgeEvents(ctx context.Context) (int64, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PurgeEvents")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (int64, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) int64); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// WebhookService_PurgeEvents_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PurgeEvents'
+type WebhookService_PurgeEvents_Call struct {
+	*mock.Call
+}
+
+// PurgeEvents is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *WebhookService_Expecter) PurgeEvents(ctx interface{}) *WebhookService_PurgeEvents_Call {
+	return &WebhookService_PurgeEvents_Call{Call: _e.mock.On("PurgeEvents", ctx)}
+}
+
+func (_c *WebhookService_PurgeEvents_Call) Run(run func(ctx context.Context)) *WebhookService_PurgeEvents_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *WebhookService_PurgeEvents_Call) Return(_a0 int64, _a1 error) *WebhookService_PurgeEvents_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *WebhookService_PurgeEvents_Call) RunAndReturn(run func(context.Context) (int64, error)) *WebhookService_PurgeEvents_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Redeliver provides a mock function with given fields: ctx, endpointID, deliveryID
+func (_m *WebhookService) Redeliver(ctx context.Context, endpointID uuid.UUID, deliveryID uuid.UUID) (*modelswebhooks.Delivery, error) {
+	ret := _m.Called(ctx, endpointID, deliveryID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Redeliver")
+	}
+
+	var r0 *modelswebhooks.Delivery
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) (*modelswebhooks.Delivery, error)); ok {
+		return rf(ctx, endpointID, deliveryID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) *modelswebhooks.Delivery); ok {
+		r0 = rf(ctx, endpointID, deliveryID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*modelswebhooks.Delivery)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r1 = rf(ctx, endpointID, deliveryID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// WebhookService_Redeliver_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Redeliver'
+type WebhookService_Redeliver_Call struct {
+	*mock.Call
+}
+
+// Redeliver is a helper method to define mock.On call
+//   - ctx context.Context
+//   - endpointID uuid.UUID
+//   - deliveryID uuid.UUID
+func (_e *WebhookService_Expecter) Redeliver(ctx interface{}, endpointID interface{}, deliveryID interface{}) *WebhookService_Redeliver_Call {
+	return &WebhookService_Redeliver_Call{Call: _e.mock.On("Redeliver", ctx, endpointID, deliveryID)}
+}
+
+func (_c *WebhookService_Redeliver_Call) Run(run func(ctx context.Context, endpointID uuid.UUID, deliveryID uuid.UUID)) *WebhookService_Redeliver_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *WebhookService_Redeliver_Call) Return(_a0 *modelswebhooks.Delivery, _a1 error) *WebhookService_Redeliver_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *WebhookService_Redeliver_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) (*modelswebhooks.Delivery, error)) *WebhookService_Redeliver_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateEndpoint provides a mock function with given fields: ctx, endpointID, request
+func (_m *WebhookService) UpdateEndpoint(ctx context.Context, endpointID uuid.UUID, request webhooks.UpdateEndpointRequest) (*modelswebhooks.Endpoint, error) {
+	ret := _m.Called(ctx, endpointID, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateEndpoint")
+	}
+
+	var r0 *modelswebhooks.Endpoint
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, webhooks.UpdateEndpointRequest) (*modelswebhooks.Endpoint, error)); ok {
+		return rf(ctx, endpointID, request)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, webhooks.UpdateEndpointRequest) *modelswebhooks.Endpoint); ok {
+		r0 = rf(ctx, endpointID, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*modelswebhooks.Endpoint)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, webhooks.UpdateEndpointRequest) error); ok {
+		r1 = rf(ctx, endpointID, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// WebhookService_UpdateEndpoint_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateEndpoint'
+type WebhookService_UpdateEndpoint_Call struct {
+	*mock.Call
+}
+
+// UpdateEndpoint is a helper method to define mock.On call
+//   - ctx context.Context
+//   - endpointID uuid.UUID
+//   - request webhooks.UpdateEndpointRequest
+func (_e *WebhookService_Expecter) UpdateEndpoint(ctx interface{}, endpointID interface{}, request interface{}) *WebhookService_UpdateEndpoint_Call {
+	return &WebhookService_UpdateEndpoint_Call{Call: _e.mock.On("UpdateEndpoint", ctx, endpointID, request)}
+}
+
+func (_c *WebhookService_UpdateEndpoint_Call) Run(run func(ctx context.Context, endpointID uuid.UUID, request webhooks.UpdateEndpointRequest)) *WebhookService_UpdateEndpoint_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(webhooks.UpdateEndpointRequest))
+	})
+	return _c
+}
+
+func (_c *WebhookService_UpdateEndpoint_Call) Return(_a0 *modelswebhooks.Endpoint, _a1 error) *WebhookService_UpdateEndpoint_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *WebhookService_UpdateEndpoint_Call) RunAndReturn(run func(context.Context, uuid.UUID, webhooks.UpdateEndpointRequest) (*modelswebhooks.Endpoint, error)) *WebhookService_UpdateEndpoint_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewWebhookService creates a new instance of WebhookService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewWebhookService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *WebhookService {
+	mock := &WebhookService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
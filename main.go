@@ -8,8 +8,10 @@ import (
 	"go-api/src/repositories"
 	"go-api/src/server"
 	"go-api/src/services"
+	"go-api/src/workers"
 	"log"
 	"time"
+	_ "time/tzdata" // Embed the timezone database, the runtime image doesn't ship it
 
 	"go.uber.org/fx"
 	"go.uber.org/zap"
@@ -38,6 +40,7 @@ func main() {
 		handlers.Module,
 		clients.Module,
 		repositories.Module,
+		workers.Module,
 
 		// Logger
 		fx.Provide(zap.NewExample),
@@ -0,0 +1,2 @@
+CREATE INDEX IF NOT EXISTS idx_study_sessions_user_history
+    ON study_sessions (user_id, session_state, date DESC, id DESC);
@@ -0,0 +1,3 @@
+DROP TRIGGER IF EXISTS study_sessions_set_updated_at ON study_sessions;
+
+DROP FUNCTION IF EXISTS set_updated_at();
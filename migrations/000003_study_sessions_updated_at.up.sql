@@ -0,0 +1,10 @@
+CREATE OR REPLACE FUNCTION set_updated_at() RETURNS TRIGGER AS $$
+BEGIN
+    NEW.updated_at = clock_timestamp();
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER study_sessions_set_updated_at
+    BEFORE UPDATE ON study_sessions
+    FOR EACH ROW EXECUTE FUNCTION set_updated_at();
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_study_sessions_deleted_at;
+
+ALTER TABLE study_sessions DROP COLUMN IF EXISTS deleted_at;
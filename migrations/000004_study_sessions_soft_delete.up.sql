@@ -0,0 +1,5 @@
+ALTER TABLE study_sessions ADD COLUMN deleted_at TIMESTAMP;
+
+CREATE INDEX IF NOT EXISTS idx_study_sessions_deleted_at
+    ON study_sessions (deleted_at)
+    WHERE deleted_at IS NOT NULL;
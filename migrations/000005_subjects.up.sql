@@ -0,0 +1,18 @@
+CREATE TABLE subjects (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    user_id UUID NOT NULL,
+    name VARCHAR(100) NOT NULL,
+    color VARCHAR(7) NOT NULL DEFAULT '',
+    icon VARCHAR(50) NOT NULL DEFAULT '',
+    archived_at TIMESTAMP,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE UNIQUE INDEX idx_subjects_user_name
+    ON subjects (user_id, lower(name))
+    WHERE archived_at IS NULL;
+
+CREATE TRIGGER subjects_set_updated_at
+    BEFORE UPDATE ON subjects
+    FOR EACH ROW EXECUTE FUNCTION set_updated_at();
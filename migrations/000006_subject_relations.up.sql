@@ -0,0 +1,17 @@
+CREATE TABLE subject_relations (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    user_id UUID NOT NULL,
+    from_subject_id UUID NOT NULL REFERENCES subjects (id) ON DELETE CASCADE,
+    to_subject_id UUID NOT NULL REFERENCES subjects (id) ON DELETE CASCADE,
+    relation_type VARCHAR(20) NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT subject_relations_distinct_subjects CHECK (from_subject_id <> to_subject_id),
+    CONSTRAINT subject_relations_unique_edge UNIQUE (from_subject_id, to_subject_id, relation_type)
+);
+
+-- A subject has at most one parent, so parent relations form a forest
+CREATE UNIQUE INDEX idx_subject_relations_single_parent
+    ON subject_relations (to_subject_id)
+    WHERE relation_type = 'parent';
+
+CREATE INDEX idx_subject_relations_user ON subject_relations (user_id);
@@ -0,0 +1,10 @@
+CREATE TABLE session_subjects (
+    session_id UUID NOT NULL REFERENCES study_sessions (id) ON DELETE CASCADE,
+    subject_id UUID NOT NULL REFERENCES subjects (id) ON DELETE CASCADE,
+    -- Share of the session time spent on the subject, NULL splits it evenly
+    percentage SMALLINT,
+    PRIMARY KEY (session_id, subject_id),
+    CONSTRAINT session_subjects_percentage_range CHECK (percentage IS NULL OR (percentage > 0 AND percentage <= 100))
+);
+
+CREATE INDEX idx_session_subjects_subject ON session_subjects (subject_id);
@@ -0,0 +1,12 @@
+CREATE TABLE streak_settings (
+    user_id UUID PRIMARY KEY,
+    daily_goal_minutes INTEGER NOT NULL DEFAULT 30
+        CHECK (daily_goal_minutes BETWEEN 1 AND 1440),
+    timezone VARCHAR(64) NOT NULL DEFAULT 'UTC',
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TRIGGER streak_settings_set_updated_at
+    BEFORE UPDATE ON streak_settings
+    FOR EACH ROW EXECUTE FUNCTION set_updated_at();
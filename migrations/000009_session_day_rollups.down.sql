@@ -0,0 +1,5 @@
+DROP TRIGGER IF EXISTS session_events_invalidate_rollups ON session_events;
+
+DROP FUNCTION IF EXISTS invalidate_session_day_rollups();
+
+DROP TABLE IF EXISTS session_day_rollups;
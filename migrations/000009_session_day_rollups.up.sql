@@ -0,0 +1,32 @@
+-- Focused seconds of each completed session per local day of a timezone.
+-- Rows are built lazily when the heatmap or the streak of a timezone is
+-- requested, a session without focused time gets a single zero row so it's
+-- not rebuilt.
+CREATE TABLE session_day_rollups (
+    session_id UUID NOT NULL REFERENCES study_sessions (id) ON DELETE CASCADE,
+    user_id UUID NOT NULL,
+    timezone VARCHAR(64) NOT NULL,
+    day DATE NOT NULL,
+    focused_seconds INTEGER NOT NULL,
+    PRIMARY KEY (session_id, timezone, day)
+);
+
+CREATE INDEX idx_session_day_rollups_user_day
+    ON session_day_rollups (user_id, timezone, day);
+
+-- Changing the events of a session invalidates its rollups, they are
+-- rebuilt by the next heatmap or streak request
+CREATE OR REPLACE FUNCTION invalidate_session_day_rollups() RETURNS TRIGGER AS $$
+BEGIN
+    IF TG_OP = 'DELETE' THEN
+        DELETE FROM session_day_rollups WHERE session_id = OLD.session_id;
+        RETURN OLD;
+    END IF;
+    DELETE FROM session_day_rollups WHERE session_id = NEW.session_id;
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER session_events_invalidate_rollups
+    AFTER INSERT OR UPDATE OR DELETE ON session_events
+    FOR EACH ROW EXECUTE FUNCTION invalidate_session_day_rollups();
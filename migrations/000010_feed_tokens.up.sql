@@ -0,0 +1,14 @@
+-- Secret tokens that give read-only access to a user's calendar feed. Only
+-- the SHA-256 of the token is stored, the token itself is shown once.
+CREATE TABLE feed_tokens (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    user_id UUID NOT NULL,
+    name VARCHAR(100) NOT NULL DEFAULT '',
+    token_hash CHAR(64) NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    last_used_at TIMESTAMP,
+    revoked_at TIMESTAMP,
+    CONSTRAINT feed_tokens_unique_hash UNIQUE (token_hash)
+);
+
+CREATE INDEX idx_feed_tokens_user ON feed_tokens (user_id);
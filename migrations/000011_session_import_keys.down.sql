@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_study_sessions_import_key;
+
+ALTER TABLE study_sessions DROP COLUMN IF EXISTS import_key;
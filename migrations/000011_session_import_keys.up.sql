@@ -0,0 +1,6 @@
+-- Hash of an imported CSV row, re-importing the same row is a no-op
+ALTER TABLE study_sessions ADD COLUMN import_key CHAR(64);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_study_sessions_import_key
+    ON study_sessions (user_id, import_key)
+    WHERE import_key IS NOT NULL;
@@ -0,0 +1,8 @@
+DELETE FROM session_events WHERE event_type IN ('work_phase', 'short_break', 'long_break');
+
+ALTER TABLE study_sessions
+    DROP CONSTRAINT IF EXISTS study_sessions_pomodoro_settings,
+    DROP COLUMN IF EXISTS pomodoro_work_minutes,
+    DROP COLUMN IF EXISTS pomodoro_short_break_minutes,
+    DROP COLUMN IF EXISTS pomodoro_long_break_minutes,
+    DROP COLUMN IF EXISTS pomodoro_cycles;
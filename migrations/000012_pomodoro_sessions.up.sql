@@ -0,0 +1,12 @@
+-- Pomodoro settings chosen when the session starts, all NULL for a plain timer
+ALTER TABLE study_sessions
+    ADD COLUMN pomodoro_work_minutes SMALLINT,
+    ADD COLUMN pomodoro_short_break_minutes SMALLINT,
+    ADD COLUMN pomodoro_long_break_minutes SMALLINT,
+    ADD COLUMN pomodoro_cycles SMALLINT,
+    ADD CONSTRAINT study_sessions_pomodoro_settings CHECK (
+        (pomodoro_work_minutes IS NULL AND pomodoro_short_break_minutes IS NULL
+            AND pomodoro_long_break_minutes IS NULL AND pomodoro_cycles IS NULL)
+        OR (pomodoro_work_minutes > 0 AND pomodoro_short_break_minutes > 0
+            AND pomodoro_long_break_minutes > 0 AND pomodoro_cycles > 0)
+    );
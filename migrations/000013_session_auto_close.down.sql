@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_study_sessions_active;
+
+ALTER TABLE study_sessions DROP COLUMN IF EXISTS auto_closed_at;
@@ -0,0 +1,6 @@
+-- Set when the stale session sweeper closed or paused a forgotten timer
+ALTER TABLE study_sessions ADD COLUMN auto_closed_at TIMESTAMP;
+
+CREATE INDEX IF NOT EXISTS idx_study_sessions_active
+    ON study_sessions (id)
+    WHERE session_state = 'active';
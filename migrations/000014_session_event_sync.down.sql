@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_session_events_session_seq;
+
+ALTER TABLE session_events DROP COLUMN IF EXISTS device_id;
+ALTER TABLE session_events DROP COLUMN IF EXISTS seq;
@@ -0,0 +1,7 @@
+-- Order in which the server recorded the events, sync cursors point into it
+ALTER TABLE session_events ADD COLUMN seq BIGSERIAL;
+-- Client device that recorded the event, NULL for server recorded events
+ALTER TABLE session_events ADD COLUMN device_id VARCHAR(100);
+
+CREATE INDEX IF NOT EXISTS idx_session_events_session_seq
+    ON session_events (session_id, seq);
@@ -0,0 +1,12 @@
+-- Changes to the sessions of a user, pushed to the clients over the session
+-- stream. The id is the SSE event id clients resume from.
+CREATE TABLE session_notifications (
+    id BIGSERIAL PRIMARY KEY,
+    user_id UUID NOT NULL,
+    session_id UUID NOT NULL,
+    notification_type VARCHAR(50) NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_session_notifications_user_id ON session_notifications (user_id, id);
+CREATE INDEX idx_session_notifications_created_at ON session_notifications (created_at);
@@ -3,6 +3,7 @@ package clients
 import (
 	"go-api/src/clients/keycloak"
 	"go-api/src/clients/postgres"
+	"go-api/src/clients/webhook"
 
 	"go.uber.org/fx"
 )
@@ -11,5 +12,7 @@ var Module = fx.Options(
 	fx.Provide(
 		keycloak.NewKeycloakClient,
 		postgres.NewPostgresClient,
+		postgres.NewPostgresListener,
+		webhook.NewWebhookClient,
 	),
 )
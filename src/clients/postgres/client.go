@@ -15,6 +15,7 @@ import (
 
 type PostgresClient interface {
 	QuerySelect(ctx context.Context, result any, sqlQuery string, args ...any) error
+	QueryRows(ctx context.Context, sqlQuery string, args ...any) (*sqlx.Rows, error)
 	BeginTransaction(ctx context.Context, opts *sql.TxOptions) (*sqlx.Tx, error)
 }
 
@@ -66,6 +67,12 @@ func (c *postgresClient) QuerySelect(ctx context.Context, result any, sqlQuery s
 	return c.db.SelectContext(ctx, result, sqlQuery, args...)
 }
 
+// QueryRows returns a cursor over the result set, the caller must close it.
+// It's meant for large results that shouldn't be loaded into memory at once.
+func (c *postgresClient) QueryRows(ctx context.Context, sqlQuery string, args ...any) (*sqlx.Rows, error) {
+	return c.db.QueryxContext(ctx, sqlQuery, args...)
+}
+
 func (c *postgresClient) BeginTransaction(ctx context.Context, opts *sql.TxOptions) (*sqlx.Tx, error) {
 	return c.db.BeginTxx(ctx, opts)
 }
@@ -0,0 +1,88 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"go-api/src/config"
+	"time"
+
+	"github.com/lib/pq"
+	"go.uber.org/fx"
+	"go.uber.org/zap"
+)
+
+const (
+	listenerMinReconnectInterval = time.Second
+	listenerMaxReconnectInterval = time.Minute
+	// listenerPingInterval detects a dead connection when no notification
+	// arrives for a while
+	listenerPingInterval = 90 * time.Second
+)
+
+// PostgresListener receives the notifications sent with NOTIFY. It uses a
+// dedicated connection, outside of the client pool, that is reopened when it
+// breaks.
+type PostgresListener interface {
+	// Listen calls handle with the payload of every notification on the
+	// channel until the context is done. Notifications sent while the
+	// connection was lost are not received, handle is called with an empty
+	// payload once the connection is back.
+	Listen(ctx context.Context, channel string, handle func(payload string)) error
+}
+
+type postgresListener struct {
+	config *config.Config
+	logger *zap.Logger
+}
+
+type PostgresListenerParams struct {
+	fx.In
+
+	Config *config.Config
+	Logger *zap.Logger
+}
+
+func NewPostgresListener(params PostgresListenerParams) PostgresListener {
+	return &postgresListener{
+		config: params.Config,
+		logger: params.Logger,
+	}
+}
+
+func (l *postgresListener) Listen(ctx context.Context, channel string, handle func(payload string)) error {
+	listener := pq.NewListener(
+		l.config.PostgresConnectionString,
+		listenerMinReconnectInterval,
+		listenerMaxReconnectInterval,
+		func(event pq.ListenerEventType, err error) {
+			if err != nil {
+				l.logger.Warn("Postgres listener connection event", zap.String("channel", channel), zap.Error(err))
+			}
+		},
+	)
+	defer listener.Close()
+
+	if err := listener.Listen(channel); err != nil {
+		return fmt.Errorf("failed to listen on %s: %w", channel, err)
+	}
+
+	ping := time.NewTicker(listenerPingInterval)
+	defer ping.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case notification := <-listener.Notify:
+			// A nil notification means the connection was reestablished
+			if notification == nil {
+				handle("")
+				continue
+			}
+			handle(notification.Extra)
+		case <-ping.C:
+			if err := listener.Ping(); err != nil {
+				l.logger.Warn("Postgres listener ping failed", zap.String("channel", channel), zap.Error(err))
+			}
+		}
+	}
+}
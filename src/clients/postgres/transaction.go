@@ -0,0 +1,44 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+)
+
+// uniqueViolation is the postgres error code raised by unique indexes
+const uniqueViolation = "23505"
+
+// Transaction is the transaction used by the repositories, those with their
+// own queries embed it in a type holding them
+type Transaction struct {
+	sqlx.Tx
+}
+
+// NewTransaction begins a transaction on the client
+func NewTransaction(ctx context.Context, client PostgresClient, opts *sql.TxOptions) (*Transaction, error) {
+	tx, err := client.BeginTransaction(ctx, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	return &Transaction{
+		Tx: *tx,
+	}, nil
+}
+
+// SafeRollback must be deferred right after the transaction begins. Rolling
+// back an already committed transaction returns sql.ErrTxDone and is harmless,
+// so read-only and failed transactions are always released to the pool.
+func (tx Transaction) SafeRollback() {
+	_ = tx.Rollback()
+}
+
+// IsUniqueViolation reports whether the error was raised by a unique index
+func IsUniqueViolation(err error) bool {
+	var pqErr *pq.Error
+	return errors.As(err, &pqErr) && pqErr.Code == uniqueViolation
+}
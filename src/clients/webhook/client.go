@@ -0,0 +1,172 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"go-api/src/config"
+	"io"
+	"net"
+	"net/http"
+	"net/netip"
+	"syscall"
+	"time"
+
+	"go.uber.org/fx"
+	"go.uber.org/zap"
+)
+
+const (
+	_USER_AGENT   = "go-api-webhooks/1.0"
+	_CONTENT_TYPE = "application/json"
+
+	// maxDrainBytes is how much of a response body is read so that the
+	// connection can be reused
+	maxDrainBytes = 64 << 10
+)
+
+// ErrForbiddenAddress is returned when an endpoint resolves to an address of
+// the internal network, which users must not reach through the webhooks
+var ErrForbiddenAddress = errors.New("webhook endpoint address is not allowed")
+
+// deniedPrefixes are the special purpose ranges webhooks must not reach, they
+// are internal, reserved, or embed an IPv4 address that could be internal
+var deniedPrefixes = []netip.Prefix{
+	// IPv4
+	netip.MustParsePrefix("0.0.0.0/8"),       // this network
+	netip.MustParsePrefix("10.0.0.0/8"),      // private
+	netip.MustParsePrefix("100.64.0.0/10"),   // carrier-grade NAT
+	netip.MustParsePrefix("127.0.0.0/8"),     // loopback
+	netip.MustParsePrefix("169.254.0.0/16"),  // link-local
+	netip.MustParsePrefix("172.16.0.0/12"),   // private
+	netip.MustParsePrefix("192.0.0.0/24"),    // IETF protocol assignments
+	netip.MustParsePrefix("192.0.2.0/24"),    // documentation
+	netip.MustParsePrefix("192.168.0.0/16"),  // private
+	netip.MustParsePrefix("198.18.0.0/15"),   // benchmarking
+	netip.MustParsePrefix("198.51.100.0/24"), // documentation
+	netip.MustParsePrefix("203.0.113.0/24"),  // documentation
+	netip.MustParsePrefix("224.0.0.0/4"),     // multicast
+	netip.MustParsePrefix("240.0.0.0/4"),     // reserved and broadcast
+	// IPv6
+	netip.MustParsePrefix("::/128"),         // unspecified
+	netip.MustParsePrefix("::1/128"),        // loopback
+	netip.MustParsePrefix("::ffff:0:0/96"),  // IPv4-mapped
+	netip.MustParsePrefix("64:ff9b::/96"),   // NAT64
+	netip.MustParsePrefix("64:ff9b:1::/48"), // local NAT64
+	netip.MustParsePrefix("100::/64"),       // discard
+	netip.MustParsePrefix("2001::/32"),      // Teredo
+	netip.MustParsePrefix("2001:db8::/32"),  // documentation
+	netip.MustParsePrefix("2002::/16"),      // 6to4
+	netip.MustParsePrefix("fc00::/7"),       // unique local
+	netip.MustParsePrefix("fe80::/10"),      // link-local
+	netip.MustParsePrefix("fec0::/10"),      // site-local
+	netip.MustParsePrefix("ff00::/8"),       // multicast
+}
+
+// WebhookClient posts webhook payloads to the endpoints registered by users
+type WebhookClient interface {
+	Send(ctx context.Context, request SendWebhookRequest) (*SendWebhookResponse, error)
+}
+
+type webhookClient struct {
+	httpClient *http.Client
+	logger     *zap.Logger
+}
+
+type WebhookClientParams struct {
+	fx.In
+
+	Config *config.Config
+	Logger *zap.Logger
+}
+
+// NewWebhookClient creates a client that doesn't follow redirects, a
+// redirect response counts as a failed delivery. Only public addresses are
+// dialed, the check runs on the resolved address so it also holds when a
+// hostname is rebound to an internal one.
+func NewWebhookClient(params WebhookClientParams) WebhookClient {
+	return newWebhookClient(params, checkPublicAddress)
+}
+
+func newWebhookClient(params WebhookClientParams, control func(network, address string, conn syscall.RawConn) error) WebhookClient {
+	dialer := &net.Dialer{
+		Timeout:   30 * time.Second,
+		KeepAlive: 30 * time.Second,
+		Control:   control,
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	// A proxy would be the only address checked
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+	httpClient := &http.Client{
+		Transport: transport,
+		Timeout:   time.Duration(params.Config.WebhookTimeoutSeconds) * time.Second,
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+
+	return &webhookClient{
+		httpClient: httpClient,
+		logger:     params.Logger,
+	}
+}
+
+// checkPublicAddress rejects the addresses of deniedPrefixes
+func checkPublicAddress(_, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return ErrForbiddenAddress
+	}
+	ip, err := netip.ParseAddr(host)
+	if err != nil {
+		return ErrForbiddenAddress
+	}
+	if !IsPublicAddress(ip) {
+		return ErrForbiddenAddress
+	}
+	return nil
+}
+
+// IsPublicAddress reports whether the address can be reached by webhooks.
+// IPv4-mapped addresses are checked as IPv4 ones.
+func IsPublicAddress(ip netip.Addr) bool {
+	if !ip.IsValid() {
+		return false
+	}
+	ip = ip.Unmap()
+	for _, prefix := range deniedPrefixes {
+		if prefix.Contains(ip) {
+			return false
+		}
+	}
+	return true
+}
+
+// Send posts the body to the URL. Any response is returned, the caller
+// decides which status codes are a success. An error means no response was
+// received.
+func (c *webhookClient) Send(ctx context.Context, request SendWebhookRequest) (*SendWebhookResponse, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, request.URL, bytes.NewReader(request.Body))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	for key, values := range request.Header {
+		for _, value := range values {
+			req.Header.Add(key, value)
+		}
+	}
+	req.Header.Set("Content-Type", _CONTENT_TYPE)
+	req.Header.Set("User-Agent", _USER_AGENT)
+
+	res, err := c.httpClient.Do(req)
+	if err != nil {
+		c.logger.Debug("failed to send webhook", zap.Error(err))
+		return nil, err
+	}
+	defer res.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(res.Body, maxDrainBytes))
+
+	return &SendWebhookResponse{StatusCode: res.StatusCode}, nil
+}
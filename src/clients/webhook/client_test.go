@@ -0,0 +1,139 @@
+package webhook
+
+import (
+	"context"
+	"go-api/src/config"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap/zaptest"
+)
+
+func TestSend(t *testing.T) {
+	tests := map[string]struct {
+		ResponseStatus int
+		Location       string
+	}{
+		"success": {
+			ResponseStatus: http.StatusNoContent,
+		},
+		"error response": {
+			ResponseStatus: http.StatusInternalServerError,
+		},
+		"redirect is not followed": {
+			ResponseStatus: http.StatusFound,
+			Location:       "/elsewhere",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "/hooks", r.URL.Path)
+				assert.Equal(t, http.MethodPost, r.Method)
+				assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+				assert.Equal(t, "signature", r.Header.Get("X-Webhook-Signature"))
+				body, _ := io.ReadAll(r.Body)
+				assert.Equal(t, `{"id":"1"}`, string(body))
+
+				if tc.Location != "" {
+					w.Header().Set("Location", tc.Location)
+				}
+				w.WriteHeader(tc.ResponseStatus)
+			}))
+			defer ts.Close()
+
+			// The test server listens on loopback
+			client := newWebhookClient(WebhookClientParams{
+				Config: &config.Config{WebhookTimeoutSeconds: 5},
+				Logger: zaptest.NewLogger(t),
+			}, nil)
+			response, err := client.Send(context.Background(), SendWebhookRequest{
+				URL:    ts.URL + "/hooks",
+				Header: http.Header{"X-Webhook-Signature": {"signature"}},
+				Body:   []byte(`{"id":"1"}`),
+			})
+			assert.NoError(t, err)
+			assert.Equal(t, tc.ResponseStatus, response.StatusCode)
+		})
+	}
+}
+
+func TestSendUnreachable(t *testing.T) {
+	ts := httptest.NewServer(http.NotFoundHandler())
+	ts.Close()
+
+	client := newWebhookClient(WebhookClientParams{
+		Config: &config.Config{WebhookTimeoutSeconds: 5},
+		Logger: zaptest.NewLogger(t),
+	}, nil)
+	response, err := client.Send(context.Background(), SendWebhookRequest{URL: ts.URL})
+	assert.Error(t, err)
+	assert.Nil(t, response)
+}
+
+func TestSendInternalAddress(t *testing.T) {
+	called := false
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		called = true
+	}))
+	defer ts.Close()
+
+	client := NewWebhookClient(WebhookClientParams{
+		Config: &config.Config{WebhookTimeoutSeconds: 5},
+		Logger: zaptest.NewLogger(t),
+	})
+	response, err := client.Send(context.Background(), SendWebhookRequest{URL: ts.URL})
+	assert.ErrorIs(t, err, ErrForbiddenAddress)
+	assert.Nil(t, response)
+	assert.False(t, called)
+}
+
+func TestIsPublicAddress(t *testing.T) {
+	tests := map[string]bool{
+		"93.184.216.34":      true,
+		"2606:2800:220:1::1": true,
+		"::ffff:8.8.8.8":     true,
+		"0.1.2.3":            false,
+		"10.0.0.1":           false,
+		"100.64.0.1":         false,
+		"100.127.255.254":    false,
+		"127.0.0.1":          false,
+		"169.254.169.254":    false,
+		"172.16.0.1":         false,
+		"192.0.0.170":        false,
+		"192.0.2.1":          false,
+		"192.168.1.1":        false,
+		"198.18.0.1":         false,
+		"198.19.255.254":     false,
+		"198.51.100.1":       false,
+		"203.0.113.1":        false,
+		"224.0.0.1":          false,
+		"240.0.0.1":          false,
+		"255.255.255.255":    false,
+		"::":                 false,
+		"::1":                false,
+		"::ffff:127.0.0.1":   false,
+		"::ffff:10.0.0.1":    false,
+		"64:ff9b::a00:1":     false,
+		"64:ff9b:1::1":       false,
+		"100::1":             false,
+		"2001::1":            false,
+		"2001:db8::1":        false,
+		"2002:a00:1::1":      false,
+		"fd00::1":            false,
+		"fe80::1":            false,
+		"fec0::1":            false,
+		"ff02::1":            false,
+	}
+
+	for address, expected := range tests {
+		t.Run(address, func(t *testing.T) {
+			assert.Equal(t, expected, IsPublicAddress(netip.MustParseAddr(address)))
+		})
+	}
+}
@@ -0,0 +1,14 @@
+package webhook
+
+import "net/http"
+
+type SendWebhookRequest struct {
+	URL    string
+	Header http.Header
+	Body   []byte
+}
+
+// SendWebhookResponse only keeps the status, the response body is ignored
+type SendWebhookResponse struct {
+	StatusCode int
+}
@@ -24,6 +24,44 @@ type Config struct {
 	PostgresConnMaxLifetime  int    `env:"POSTGRES_CONN_MAX_LIFETIME" envDefault:"300"` // in seconds
 	PostgresMaxIdleTime      int    `env:"POSTGRES_MAX_IDLE_TIME" envDefault:"300"`     // in seconds
 	PostgresMaxLifetime      int    `env:"POSTGRES_MAX_LIFETIME" envDefault:"300"`      // in seconds
+
+	// Study sessions
+	DeletedSessionRetentionHours int `env:"DELETED_SESSION_RETENTION_HOURS" envDefault:"720"`
+	SessionPurgeIntervalMinutes  int `env:"SESSION_PURGE_INTERVAL_MINUTES" envDefault:"60"`
+	// Active sessions without events for longer than the idle threshold are
+	// closed with the action, either "finish" or "pause"
+	StaleSessionIdleMinutes          int    `env:"STALE_SESSION_IDLE_MINUTES" envDefault:"360"`
+	StaleSessionAction               string `env:"STALE_SESSION_ACTION" envDefault:"finish"`
+	StaleSessionSweepIntervalMinutes int    `env:"STALE_SESSION_SWEEP_INTERVAL_MINUTES" envDefault:"15"`
+
+	// Idempotency keys
+	IdempotencyKeyTTLHours          int `env:"IDEMPOTENCY_KEY_TTL_HOURS" envDefault:"24"`
+	IdempotencyPurgeIntervalMinutes int `env:"IDEMPOTENCY_PURGE_INTERVAL_MINUTES" envDefault:"60"`
+
+	// Session notifications are kept for the stream clients to resume from
+	SessionNotificationRetentionHours       int `env:"SESSION_NOTIFICATION_RETENTION_HOURS" envDefault:"24"`
+	SessionNotificationPurgeIntervalMinutes int `env:"SESSION_NOTIFICATION_PURGE_INTERVAL_MINUTES" envDefault:"60"`
+	SessionStreamHeartbeatSeconds           int `env:"SESSION_STREAM_HEARTBEAT_SECONDS" envDefault:"15"`
+
+	// WebSocket clients that don't send a Bearer token on connect must
+	// authenticate within the timeout
+	WebSocketAuthTimeoutSeconds int `env:"WEBSOCKET_AUTH_TIMEOUT_SECONDS" envDefault:"10"`
+
+	// Webhooks are retried with an exponential backoff starting at the base
+	// delay until the max attempts. Endpoints must use https unless http is
+	// allowed, and never resolve to an address of the internal network.
+	WebhookAllowHTTP               bool `env:"WEBHOOK_ALLOW_HTTP" envDefault:"false"`
+	WebhookTimeoutSeconds          int  `env:"WEBHOOK_TIMEOUT_SECONDS" envDefault:"10"`
+	WebhookMaxAttempts             int  `env:"WEBHOOK_MAX_ATTEMPTS" envDefault:"8"`
+	WebhookRetryBaseSeconds        int  `env:"WEBHOOK_RETRY_BASE_SECONDS" envDefault:"30"`
+	WebhookDeliveryIntervalSeconds int  `env:"WEBHOOK_DELIVERY_INTERVAL_SECONDS" envDefault:"5"`
+	WebhookDeliveryBatchSize       int  `env:"WEBHOOK_DELIVERY_BATCH_SIZE" envDefault:"20"`
+	WebhookEventRetentionHours     int  `env:"WEBHOOK_EVENT_RETENTION_HOURS" envDefault:"720"`
+	WebhookPurgeIntervalMinutes    int  `env:"WEBHOOK_PURGE_INTERVAL_MINUTES" envDefault:"60"`
+
+	// Streaks
+	StreakFreezeEarnDays int `env:"STREAK_FREEZE_EARN_DAYS" envDefault:"7"`
+	StreakMaxFreezes     int `env:"STREAK_MAX_FREEZES" envDefault:"2"`
 }
 
 // NewConfig will parse the necessary env vars to
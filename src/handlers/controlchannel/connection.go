@@ -0,0 +1,310 @@
+package controlchannel
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	authmodel "go-api/src/models/auth"
+	"go-api/src/models/constants"
+	notificationmodels "go-api/src/models/notifications"
+	models "go-api/src/models/studysession"
+	notificationservice "go-api/src/services/notifications"
+	service "go-api/src/services/studysession"
+	"time"
+
+	"go.uber.org/zap"
+	"golang.org/x/net/websocket"
+)
+
+// incomingMessage is a message read from the socket, err is set when it
+// isn't a valid client message
+type incomingMessage struct {
+	message clientMessage
+	err     error
+}
+
+// connection is the state of an open control channel. Only the goroutine
+// running run writes to the socket, so messages are never interleaved.
+type connection struct {
+	ws      *websocket.Conn
+	handler *controlChannelHandler
+	user    *authmodel.UserInfo
+	expiry  *time.Timer
+}
+
+// run serves the connection until the client goes away or the socket is
+// closed by the server. Returning closes the underlying connection.
+func (c *connection) run(parent context.Context, token string) {
+	ctx, cancel := context.WithCancel(parent)
+	defer cancel()
+
+	incoming := make(chan incomingMessage)
+	go c.read(ctx, incoming)
+
+	var authDeadline <-chan time.Time
+	if token != "" {
+		if err := c.authenticate(ctx, token); err != nil {
+			c.close(closeUnauthorized, "invalid token")
+			return
+		}
+	} else {
+		timer := time.NewTimer(c.handler.authTimeout)
+		defer timer.Stop()
+		authDeadline = timer.C
+	}
+
+	var notifications <-chan notificationmodels.Notification
+	if c.user != nil {
+		if notifications = c.subscribe(ctx); notifications == nil {
+			return
+		}
+		c.sendState(ctx, "")
+	}
+	defer func() {
+		if c.expiry != nil {
+			c.expiry.Stop()
+		}
+	}()
+
+	for {
+		var expired <-chan time.Time
+		if c.expiry != nil {
+			expired = c.expiry.C
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case in, ok := <-incoming:
+			if !ok {
+				return
+			}
+			if in.err != nil {
+				c.sendError("", errorCodeInvalidMessage, "Invalid message")
+				continue
+			}
+			if c.user == nil {
+				if in.message.Type != messageTypeAuth {
+					c.close(closeUnauthorized, "authentication required")
+					return
+				}
+				if err := c.authenticateMessage(ctx, in.message); err != nil {
+					c.close(closeUnauthorized, "invalid token")
+					return
+				}
+				authDeadline = nil
+				if notifications = c.subscribe(ctx); notifications == nil {
+					return
+				}
+				c.sendState(ctx, in.message.ID)
+				continue
+			}
+			c.handle(ctx, in.message)
+		case <-authDeadline:
+			c.close(closeUnauthorized, "authentication timeout")
+			return
+		case <-expired:
+			c.close(closeTokenExpired, "token expired")
+			return
+		case _, ok := <-notifications:
+			if !ok {
+				c.close(closeInternalError, "session updates interrupted")
+				return
+			}
+			c.sendState(ctx, "")
+		}
+	}
+}
+
+// read forwards the client messages until the socket fails, e.g. when the
+// client closes it
+func (c *connection) read(ctx context.Context, incoming chan<- incomingMessage) {
+	defer close(incoming)
+	for {
+		var data []byte
+		if err := websocket.Message.Receive(c.ws, &data); err != nil {
+			return
+		}
+		var in incomingMessage
+		in.err = json.Unmarshal(data, &in.message)
+		if in.err == nil && in.message.Type == "" {
+			in.err = errors.New("message type is missing")
+		}
+		select {
+		case incoming <- in:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// authenticate verifies the token and arms the expiry timer. A token of
+// another user than the one already authenticated is rejected.
+func (c *connection) authenticate(ctx context.Context, token string) error {
+	user, err := c.handler.authService.GetUserInfo(ctx, authmodel.VerifySessionRequest{AccessToken: token})
+	if err != nil {
+		return err
+	}
+	if user == nil {
+		return errors.New("no user for the token")
+	}
+	if c.user != nil && c.user.ID != user.ID {
+		return errors.New("token belongs to another user")
+	}
+	c.user = user
+
+	if c.expiry != nil {
+		c.expiry.Stop()
+		c.expiry = nil
+	}
+	if !user.ExpiresAt.IsZero() {
+		c.expiry = time.NewTimer(time.Until(user.ExpiresAt))
+	}
+	return nil
+}
+
+func (c *connection) authenticateMessage(ctx context.Context, message clientMessage) error {
+	var payload authPayload
+	if err := decodePayload(message, &payload); err != nil {
+		return err
+	}
+	if payload.Token == "" {
+		return errors.New("token is missing")
+	}
+	return c.authenticate(ctx, payload.Token)
+}
+
+// subscribe follows the changes to the user's sessions, it closes the socket
+// and returns nil when it can't
+func (c *connection) subscribe(ctx context.Context) <-chan notificationmodels.Notification {
+	notifications, err := c.handler.notificationService.Subscribe(c.userContext(ctx), notificationservice.SubscribeRequest{})
+	if err != nil {
+		c.handler.logger.Error("Failed to subscribe the control channel to session notifications", zap.Error(err))
+		c.close(closeInternalError, "session updates unavailable")
+		return nil
+	}
+	return notifications
+}
+
+// userContext carries the authenticated user like the auth middleware does
+// for REST requests
+func (c *connection) userContext(ctx context.Context) context.Context {
+	return context.WithValue(ctx, constants.ContextKeyUserInfoKey, c.user)
+}
+
+// handle runs a command of an authenticated client and replies with the new
+// state or an error
+func (c *connection) handle(ctx context.Context, message clientMessage) {
+	ctx = c.userContext(ctx)
+	switch message.Type {
+	case messageTypeAuth:
+		// A token that fails to refresh the authentication leaves the
+		// current one in place until it expires
+		if err := c.authenticateMessage(ctx, message); err != nil {
+			c.sendError(message.ID, errorCodeUnauthorized, "Invalid token")
+			return
+		}
+		c.sendState(ctx, message.ID)
+	case messageTypeState:
+		c.sendState(ctx, message.ID)
+	case messageTypeStart:
+		var request service.UpsertActiveStudySessionRequest
+		if err := decodePayload(message, &request); err != nil {
+			c.sendError(message.ID, errorCodeInvalidMessage, "Invalid payload")
+			return
+		}
+		session, err := c.handler.service.CreateStudySession(ctx, request)
+		if err != nil {
+			c.sendCommandError(message, err)
+			return
+		}
+		c.send(stateMessage{Type: messageTypeState, ReplyTo: message.ID, ServerTime: time.Now().UTC(), Session: session})
+	case messageTypePause, messageTypeResume:
+		var payload eventPayload
+		if err := decodePayload(message, &payload); err != nil {
+			c.sendError(message.ID, errorCodeInvalidMessage, "Invalid payload")
+			return
+		}
+		eventType := models.EventTypePause
+		if message.Type == messageTypeResume {
+			eventType = models.EventTypeResume
+		}
+		if payload.EventTime.IsZero() {
+			payload.EventTime = time.Now().UTC()
+		}
+		_, err := c.handler.service.AddStudySessionEvents(ctx, service.AddStudySessionEventsRequest{
+			Events: []models.SessionEvent{{
+				ID:        payload.EventID,
+				EventType: eventType,
+				EventTime: payload.EventTime,
+				DeviceID:  payload.DeviceID,
+			}},
+		})
+		if err != nil {
+			c.sendCommandError(message, err)
+			return
+		}
+		c.sendState(ctx, message.ID)
+	case messageTypeStop:
+		var request service.FinishStudySessionRequest
+		if err := decodePayload(message, &request); err != nil {
+			c.sendError(message.ID, errorCodeInvalidMessage, "Invalid payload")
+			return
+		}
+		finished, err := c.handler.service.FinishStudySession(ctx, request)
+		if err != nil {
+			c.sendCommandError(message, err)
+			return
+		}
+		c.send(stateMessage{Type: messageTypeState, ReplyTo: message.ID, ServerTime: time.Now().UTC(), Finished: finished})
+	default:
+		c.sendError(message.ID, errorCodeInvalidMessage, "Unknown message type")
+	}
+}
+
+// sendState sends the active session of the user as it is now
+func (c *connection) sendState(ctx context.Context, replyTo string) {
+	session, err := c.handler.service.GetActiveStudySession(c.userContext(ctx))
+	if err != nil && err != models.ErrActiveSessionNotFound {
+		c.handler.logger.Error("Failed to get active study session", zap.Error(err), zap.String("endpoint", "/ws"))
+		c.sendError(replyTo, errorCodeInternal, "Failed to get active study session")
+		return
+	}
+	c.send(stateMessage{Type: messageTypeState, ReplyTo: replyTo, ServerTime: time.Now().UTC(), Session: session})
+}
+
+func (c *connection) sendCommandError(message clientMessage, err error) {
+	code, text := commandError(err)
+	if code == errorCodeInternal {
+		c.handler.logger.Error("Failed to handle control channel command",
+			zap.Error(err),
+			zap.String("type", message.Type),
+			zap.String("endpoint", "/ws"),
+		)
+	}
+	c.sendError(message.ID, code, text)
+}
+
+func (c *connection) sendError(replyTo string, code string, text string) {
+	c.send(errorMessage{Type: messageTypeError, ReplyTo: replyTo, ServerTime: time.Now().UTC(), Code: code, Error: text})
+}
+
+// send writes the message. When the write fails the connection is closed,
+// which stops the reader and then run.
+func (c *connection) send(message any) {
+	c.ws.SetWriteDeadline(time.Now().Add(writeTimeout))
+	if err := websocket.JSON.Send(c.ws, message); err != nil {
+		c.handler.logger.Debug("Failed to write control channel message", zap.Error(err))
+		c.ws.Close()
+	}
+}
+
+// close sends a close frame with the code, the connection itself is closed
+// once run returns. The frame is written by hand since the websocket package
+// only closes with a normal status.
+func (c *connection) close(code int, reason string) {
+	c.ws.SetWriteDeadline(time.Now().Add(writeTimeout))
+	c.ws.PayloadType = websocket.CloseFrame
+	if _, err := c.ws.Write(closePayload(code, reason)); err != nil {
+		c.handler.logger.Debug("Failed to close control channel", zap.Error(err))
+	}
+}
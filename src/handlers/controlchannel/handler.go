@@ -0,0 +1,108 @@
+package controlchannel
+
+import (
+	"go-api/src/config"
+	authservice "go-api/src/services/auth"
+	notificationservice "go-api/src/services/notifications"
+	service "go-api/src/services/studysession"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"go.uber.org/fx"
+	"go.uber.org/zap"
+	"golang.org/x/net/websocket"
+)
+
+const (
+	// maxMessageBytes bounds the size of a client message
+	maxMessageBytes  = 64 << 10
+	writeTimeout     = 10 * time.Second
+	defaultAuthLimit = 10 * time.Second
+)
+
+// ControlChannelHandler defines the interface for the WebSocket control channel
+type ControlChannelHandler interface {
+	Connect(e echo.Context) error
+}
+
+// ControlChannelHandlerParams defines the dependencies for the control channel handler
+type ControlChannelHandlerParams struct {
+	fx.In
+
+	Config              *config.Config
+	AuthService         authservice.AuthService
+	Service             service.StudySessionService
+	NotificationService notificationservice.NotificationService
+	Logger              *zap.Logger
+}
+
+type controlChannelHandler struct {
+	authService         authservice.AuthService
+	service             service.StudySessionService
+	notificationService notificationservice.NotificationService
+	logger              *zap.Logger
+	authTimeout         time.Duration
+}
+
+// NewControlChannelHandler creates a new control channel handler with injected dependencies
+func NewControlChannelHandler(p ControlChannelHandlerParams) ControlChannelHandler {
+	authTimeout := defaultAuthLimit
+	if p.Config != nil && p.Config.WebSocketAuthTimeoutSeconds > 0 {
+		authTimeout = time.Duration(p.Config.WebSocketAuthTimeoutSeconds) * time.Second
+	}
+	return &controlChannelHandler{
+		authService:         p.AuthService,
+		service:             p.Service,
+		notificationService: p.NotificationService,
+		logger:              p.Logger,
+		authTimeout:         authTimeout,
+	}
+}
+
+// Connect handles the WebSocket control channel of the live timer
+//
+//	@Summary		Live timer control channel
+//	@Description	WebSocket carrying JSON messages {"id", "type", "payload"}. The connection is authenticated with the
+//	@Description	Bearer token of the upgrade request or, within a few seconds, with an "auth" message whose payload is
+//	@Description	{"token"}; sending "auth" again with a fresh token keeps the connection open. Commands "start", "pause",
+//	@Description	"resume" and "stop" take the payloads of the matching REST endpoints and "state" requests the current
+//	@Description	state. The server replies with a "state" message holding the active session and the server time, or an
+//	@Description	"error" message with a code, and pushes the state whenever the session changes on any device. The socket
+//	@Description	is closed with code 4001 when the token expires and 4002 when no valid token is sent.
+//	@Tags			study-session
+//	@Param			Authorization	header	string	false	"Bearer token, can be sent in an auth message instead"
+//	@Success		101
+//	@Failure		400	{string}	string	"Not a WebSocket handshake"
+//	@Router			/ws [get]
+func (h *controlChannelHandler) Connect(e echo.Context) error {
+	server := websocket.Server{
+		// Clients authenticate with a token, not with cookies, so any origin
+		// is accepted, including native clients that send none
+		Handshake: func(*websocket.Config, *http.Request) error {
+			return nil
+		},
+		Handler: h.serve,
+	}
+	server.ServeHTTP(e.Response(), e.Request())
+	return nil
+}
+
+func (h *controlChannelHandler) serve(ws *websocket.Conn) {
+	ws.MaxPayloadBytes = maxMessageBytes
+	c := &connection{
+		ws:      ws,
+		handler: h,
+	}
+	c.run(ws.Request().Context(), bearerToken(ws.Request()))
+}
+
+// bearerToken returns the token of the Authorization header, if any
+func bearerToken(request *http.Request) string {
+	scheme, token, found := strings.Cut(request.Header.Get(echo.HeaderAuthorization), " ")
+	if !found || scheme != "Bearer" {
+		return ""
+	}
+	return token
+}
@@ -0,0 +1,279 @@
+package controlchannel
+
+import (
+	"encoding/json"
+	"errors"
+	mockauth "go-api/.internal/mocks/src/services/auth"
+	mocknotifications "go-api/.internal/mocks/src/services/notifications"
+	mockstudysession "go-api/.internal/mocks/src/services/studysession"
+	"go-api/src/config"
+	authmodel "go-api/src/models/auth"
+	notificationmodels "go-api/src/models/notifications"
+	models "go-api/src/models/studysession"
+	service "go-api/src/services/studysession"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/zap/zaptest"
+	"golang.org/x/net/websocket"
+)
+
+// HandlerTestSuite ...
+type HandlerTestSuite struct {
+	suite.Suite
+
+	MockAuthService         *mockauth.AuthService
+	MockService             *mockstudysession.StudySessionService
+	MockNotificationService *mocknotifications.NotificationService
+
+	User          *authmodel.UserInfo
+	Notifications chan notificationmodels.Notification
+	Server        *httptest.Server
+}
+
+// SetupTest ...
+func (s *HandlerTestSuite) SetupTest() {
+	t := s.T()
+	s.MockAuthService = mockauth.NewAuthService(t)
+	s.MockService = mockstudysession.NewStudySessionService(t)
+	s.MockNotificationService = mocknotifications.NewNotificationService(t)
+	s.User = &authmodel.UserInfo{ID: uuid.New(), ExpiresAt: time.Now().Add(time.Hour)}
+	s.Notifications = make(chan notificationmodels.Notification)
+
+	handler := NewControlChannelHandler(ControlChannelHandlerParams{
+		Config:              &config.Config{WebSocketAuthTimeoutSeconds: 1},
+		AuthService:         s.MockAuthService,
+		Service:             s.MockService,
+		NotificationService: s.MockNotificationService,
+		Logger:              zaptest.NewLogger(t),
+	})
+	e := echo.New()
+	e.GET("/ws", handler.Connect)
+	s.Server = httptest.NewServer(e)
+}
+
+// TearDownTest ...
+func (s *HandlerTestSuite) TearDownTest() {
+	s.Server.Close()
+}
+
+// TestHandlerTestSuite ...
+func TestHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(HandlerTestSuite))
+}
+
+// dial opens the control channel, with the token in the upgrade request
+// when one is given
+func (s *HandlerTestSuite) dial(token string) *websocket.Conn {
+	wsConfig, err := websocket.NewConfig("ws"+strings.TrimPrefix(s.Server.URL, "http")+"/ws", s.Server.URL)
+	s.Require().NoError(err)
+	if token != "" {
+		wsConfig.Header.Set(echo.HeaderAuthorization, "Bearer "+token)
+	}
+	ws, err := websocket.DialConfig(wsConfig)
+	s.Require().NoError(err)
+	s.T().Cleanup(func() { ws.Close() })
+	return ws
+}
+
+func (s *HandlerTestSuite) expectAuthentication(token string) {
+	s.MockAuthService.EXPECT().GetUserInfo(mock.Anything, authmodel.VerifySessionRequest{AccessToken: token}).Return(s.User, nil)
+	s.MockNotificationService.EXPECT().Subscribe(mock.Anything, mock.Anything).Return((<-chan notificationmodels.Notification)(s.Notifications), nil)
+}
+
+func (s *HandlerTestSuite) send(ws *websocket.Conn, message string) {
+	s.Require().NoError(websocket.Message.Send(ws, message))
+}
+
+// receive reads the next message as a generic JSON object
+func (s *HandlerTestSuite) receive(ws *websocket.Conn) map[string]any {
+	s.Require().NoError(ws.SetReadDeadline(time.Now().Add(2 * time.Second)))
+	var data []byte
+	s.Require().NoError(websocket.Message.Receive(ws, &data))
+	var message map[string]any
+	s.Require().NoError(json.Unmarshal(data, &message))
+	return message
+}
+
+// expectClosed waits for the server to close the connection
+func (s *HandlerTestSuite) expectClosed(ws *websocket.Conn) {
+	s.Require().NoError(ws.SetReadDeadline(time.Now().Add(2 * time.Second)))
+	var data []byte
+	err := websocket.Message.Receive(ws, &data)
+	s.Error(err)
+	s.NotContains(err.Error(), "timeout")
+}
+
+// TestConnectWithBearerToken ...
+func (s *HandlerTestSuite) TestConnectWithBearerToken() {
+	session := &models.StudySession{ID: uuid.New(), SessionState: models.SessionStateActive}
+	s.expectAuthentication("token")
+	s.MockService.EXPECT().GetActiveStudySession(mock.Anything).Return(nil, models.ErrActiveSessionNotFound).Once()
+	s.MockService.EXPECT().CreateStudySession(mock.Anything, service.UpsertActiveStudySessionRequest{Title: "Calculus"}).Return(session, nil)
+
+	ws := s.dial("token")
+
+	state := s.receive(ws)
+	s.Equal(messageTypeState, state["type"])
+	s.Nil(state["session"])
+	s.NotEmpty(state["server_time"])
+
+	s.send(ws, `{"id":"1","type":"start","payload":{"title":"Calculus"}}`)
+	reply := s.receive(ws)
+	s.Equal(messageTypeState, reply["type"])
+	s.Equal("1", reply["reply_to"])
+	s.Equal(session.ID.String(), reply["session"].(map[string]any)["id"])
+}
+
+// TestConnectWithAuthMessage ...
+func (s *HandlerTestSuite) TestConnectWithAuthMessage() {
+	s.expectAuthentication("token")
+	s.MockService.EXPECT().GetActiveStudySession(mock.Anything).Return(nil, models.ErrActiveSessionNotFound)
+
+	ws := s.dial("")
+	s.send(ws, `{"id":"auth","type":"auth","payload":{"token":"token"}}`)
+
+	reply := s.receive(ws)
+	s.Equal(messageTypeState, reply["type"])
+	s.Equal("auth", reply["reply_to"])
+}
+
+// TestCommandErrors ...
+func (s *HandlerTestSuite) TestCommandErrors() {
+	tests := map[string]struct {
+		Message      string
+		MockSetup    func()
+		ExpectedCode string
+	}{
+		"pause without active session": {
+			Message: `{"id":"2","type":"pause"}`,
+			MockSetup: func() {
+				s.MockService.EXPECT().AddStudySessionEvents(mock.Anything, mock.MatchedBy(func(request service.AddStudySessionEventsRequest) bool {
+					return len(request.Events) == 1 && request.Events[0].EventType == models.EventTypePause && !request.Events[0].EventTime.IsZero()
+				})).Return(nil, models.ErrActiveSessionNotFound)
+			},
+			ExpectedCode: errorCodeNotFound,
+		},
+		"start with an active session": {
+			Message: `{"id":"2","type":"start"}`,
+			MockSetup: func() {
+				s.MockService.EXPECT().CreateStudySession(mock.Anything, service.UpsertActiveStudySessionRequest{}).Return(nil, models.ErrActiveSessionExists)
+			},
+			ExpectedCode: errorCodeConflict,
+		},
+		"invalid finish request": {
+			Message: `{"id":"2","type":"stop","payload":{"final_state":"running"}}`,
+			MockSetup: func() {
+				s.MockService.EXPECT().FinishStudySession(mock.Anything, service.FinishStudySessionRequest{FinalState: models.TimerStatusRunning}).Return(nil, models.ErrInvalidFinishRequest)
+			},
+			ExpectedCode: errorCodeInvalidRequest,
+		},
+		"unknown type": {
+			Message:      `{"id":"2","type":"rewind"}`,
+			MockSetup:    func() {},
+			ExpectedCode: errorCodeInvalidMessage,
+		},
+		"invalid payload": {
+			Message:      `{"id":"2","type":"start","payload":"title"}`,
+			MockSetup:    func() {},
+			ExpectedCode: errorCodeInvalidMessage,
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			defer s.TearDownTest()
+			s.expectAuthentication("token")
+			s.MockService.EXPECT().GetActiveStudySession(mock.Anything).Return(nil, models.ErrActiveSessionNotFound)
+			tc.MockSetup()
+
+			ws := s.dial("token")
+			s.receive(ws) // Initial state
+			s.send(ws, tc.Message)
+
+			reply := s.receive(ws)
+			s.Equal(messageTypeError, reply["type"])
+			s.Equal("2", reply["reply_to"])
+			s.Equal(tc.ExpectedCode, reply["code"])
+		})
+	}
+}
+
+// TestPushesStateOnNotification ...
+func (s *HandlerTestSuite) TestPushesStateOnNotification() {
+	session := &models.StudySession{ID: uuid.New(), SessionState: models.SessionStateActive}
+	s.expectAuthentication("token")
+	s.MockService.EXPECT().GetActiveStudySession(mock.Anything).Return(nil, models.ErrActiveSessionNotFound).Once()
+	s.MockService.EXPECT().GetActiveStudySession(mock.Anything).Return(session, nil).Once()
+
+	ws := s.dial("token")
+	s.receive(ws) // Initial state
+	s.Notifications <- notificationmodels.Notification{ID: 1, SessionID: session.ID, Type: notificationmodels.NotificationTypeStarted}
+
+	state := s.receive(ws)
+	s.Equal(messageTypeState, state["type"])
+	s.Equal(session.ID.String(), state["session"].(map[string]any)["id"])
+}
+
+// TestClosesConnection ...
+func (s *HandlerTestSuite) TestClosesConnection() {
+	tests := map[string]struct {
+		Token     string
+		Message   string
+		MockSetup func()
+	}{
+		"token expires": {
+			Token: "token",
+			MockSetup: func() {
+				s.User.ExpiresAt = time.Now().Add(200 * time.Millisecond)
+				s.expectAuthentication("token")
+				s.MockService.EXPECT().GetActiveStudySession(mock.Anything).Return(nil, models.ErrActiveSessionNotFound)
+			},
+		},
+		"invalid token": {
+			Token: "expired",
+			MockSetup: func() {
+				s.MockAuthService.EXPECT().GetUserInfo(mock.Anything, authmodel.VerifySessionRequest{AccessToken: "expired"}).Return(nil, errExpiredToken)
+			},
+		},
+		"command before authentication": {
+			Message:   `{"id":"1","type":"start"}`,
+			MockSetup: func() {},
+		},
+		"no authentication in time": {
+			MockSetup: func() {},
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			defer s.TearDownTest()
+			tc.MockSetup()
+
+			ws := s.dial(tc.Token)
+			if tc.Token != "" && tc.Token != "expired" {
+				s.receive(ws) // Initial state
+			}
+			if tc.Message != "" {
+				s.send(ws, tc.Message)
+			}
+
+			s.expectClosed(ws)
+		})
+	}
+}
+
+// TestClosePayload ...
+func (s *HandlerTestSuite) TestClosePayload() {
+	s.Equal([]byte{0x0f, 0xa1, 't', 'o', 'k', 'e', 'n'}, closePayload(closeTokenExpired, "token"))
+}
+
+var errExpiredToken = errors.New("expired token")
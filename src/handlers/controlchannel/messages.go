@@ -0,0 +1,132 @@
+package controlchannel
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	models "go-api/src/models/studysession"
+	service "go-api/src/services/studysession"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Close codes sent when the server closes the socket, in the range reserved
+// for applications by RFC 6455
+const (
+	// closeTokenExpired asks the client to reconnect with a fresh token
+	closeTokenExpired = 4001
+	// closeUnauthorized means no valid token was sent in time
+	closeUnauthorized = 4002
+	// closeInternalError follows RFC 6455, the client may reconnect
+	closeInternalError = 1011
+)
+
+// Client message types
+const (
+	messageTypeAuth   = "auth"
+	messageTypeState  = "state"
+	messageTypeStart  = "start"
+	messageTypePause  = "pause"
+	messageTypeResume = "resume"
+	messageTypeStop   = "stop"
+)
+
+// Server message types
+const (
+	messageTypeError = "error"
+)
+
+// Error codes of the error messages
+const (
+	errorCodeInvalidMessage = "invalid_message"
+	errorCodeUnauthorized   = "unauthorized"
+	errorCodeInvalidRequest = "invalid_request"
+	errorCodeConflict       = "conflict"
+	errorCodeNotFound       = "not_found"
+	errorCodeInvalidEvent   = "invalid_event"
+	errorCodeInternal       = "internal_error"
+)
+
+// clientMessage is a message sent by the client, the payload depends on the
+// type. The id is echoed in the reply_to field of the reply.
+type clientMessage struct {
+	ID      string          `json:"id"`
+	Type    string          `json:"type"`
+	Payload json.RawMessage `json:"payload"`
+}
+
+// authPayload authenticates the connection, sending it again with a fresh
+// token keeps the connection open past the expiry of the previous token
+type authPayload struct {
+	Token string `json:"token"`
+}
+
+// eventPayload pauses or resumes the active session, the event time
+// defaults to the server time
+type eventPayload struct {
+	EventID   uuid.UUID `json:"event_id"`
+	EventTime time.Time `json:"event_time"`
+	DeviceID  string    `json:"device_id"`
+}
+
+// stateMessage is the authoritative state of the user's timer, sent in reply
+// to commands and whenever the active session changes. Session is null when
+// there is no active session.
+type stateMessage struct {
+	Type       string                              `json:"type"`
+	ReplyTo    string                              `json:"reply_to,omitempty"`
+	ServerTime time.Time                           `json:"server_time"`
+	Session    *models.StudySession                `json:"session"`
+	Finished   *service.FinishStudySessionResponse `json:"finished,omitempty"`
+}
+
+type errorMessage struct {
+	Type       string    `json:"type"`
+	ReplyTo    string    `json:"reply_to,omitempty"`
+	ServerTime time.Time `json:"server_time"`
+	Code       string    `json:"code"`
+	Error      string    `json:"error"`
+}
+
+// decodePayload decodes the payload of the message, a missing payload
+// leaves the zero value
+func decodePayload(message clientMessage, payload any) error {
+	if len(message.Payload) == 0 || string(message.Payload) == "null" {
+		return nil
+	}
+	return json.Unmarshal(message.Payload, payload)
+}
+
+// commandError maps the errors of the study session service to the error
+// code and message sent to the client, following the REST endpoints
+func commandError(err error) (string, string) {
+	var invalidEventErr *models.InvalidEventError
+	if errors.As(err, &invalidEventErr) {
+		return errorCodeInvalidEvent, invalidEventErr.Error()
+	}
+	switch err {
+	case models.ErrActiveSessionExists:
+		return errorCodeConflict, "Active session already exists"
+	case models.ErrActiveSessionNotFound:
+		return errorCodeNotFound, "No active session found"
+	case models.ErrInvalidPomodoro:
+		return errorCodeInvalidRequest, "Invalid pomodoro settings"
+	case models.ErrInvalidSessionSubjects:
+		return errorCodeInvalidRequest, "Invalid session subjects"
+	case models.ErrSubjectNotFound:
+		return errorCodeNotFound, "Subject not found"
+	case models.ErrInvalidFinishRequest:
+		return errorCodeInvalidRequest, "Invalid finish request"
+	default:
+		return errorCodeInternal, "Failed to handle the command"
+	}
+}
+
+// closePayload is the body of a close frame, the status code followed by
+// the reason
+func closePayload(code int, reason string) []byte {
+	payload := make([]byte, 2, 2+len(reason))
+	binary.BigEndian.PutUint16(payload, uint16(code))
+	return append(payload, reason...)
+}
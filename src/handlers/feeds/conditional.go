@@ -0,0 +1,41 @@
+package feeds
+
+import (
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/labstack/echo/v4"
+)
+
+const (
+	headerETag        = "ETag"
+	headerIfNoneMatch = "If-None-Match"
+)
+
+// isNotModified evaluates the conditional headers of a GET request as in
+// RFC 9110 section 13.2.2: If-None-Match takes precedence and
+// If-Modified-Since is only used when it's missing
+func isNotModified(header http.Header, etag string, lastModified time.Time) bool {
+	if ifNoneMatch := strings.TrimSpace(header.Get(headerIfNoneMatch)); ifNoneMatch != "" {
+		if ifNoneMatch == "*" {
+			return true
+		}
+		for _, tag := range strings.Split(ifNoneMatch, ",") {
+			// The weak comparison ignores the W/ prefix
+			if strings.TrimPrefix(strings.TrimSpace(tag), "W/") == strings.TrimPrefix(etag, "W/") {
+				return true
+			}
+		}
+		return false
+	}
+
+	if lastModified.IsZero() {
+		return false
+	}
+	ifModifiedSince, err := http.ParseTime(header.Get(echo.HeaderIfModifiedSince))
+	if err != nil {
+		return false
+	}
+	return !lastModified.After(ifModifiedSince)
+}
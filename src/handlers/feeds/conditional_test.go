@@ -0,0 +1,72 @@
+package feeds
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsNotModified(t *testing.T) {
+	etag := `"5f1c0a9e2b7d4c36"`
+	lastModified := time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)
+
+	tests := map[string]struct {
+		Headers             map[string]string
+		LastModified        time.Time
+		ExpectedNotModified bool
+	}{
+		"no conditional headers": {
+			LastModified: lastModified,
+		},
+		"matching etag": {
+			Headers:             map[string]string{headerIfNoneMatch: etag},
+			LastModified:        lastModified,
+			ExpectedNotModified: true,
+		},
+		"matching weak etag in a list": {
+			Headers:             map[string]string{headerIfNoneMatch: `"other", W/` + etag},
+			LastModified:        lastModified,
+			ExpectedNotModified: true,
+		},
+		"any etag": {
+			Headers:             map[string]string{headerIfNoneMatch: "*"},
+			LastModified:        lastModified,
+			ExpectedNotModified: true,
+		},
+		"etag mismatch wins over modified since": {
+			Headers: map[string]string{
+				headerIfNoneMatch:   `"other"`,
+				"If-Modified-Since": lastModified.Format(http.TimeFormat),
+			},
+			LastModified: lastModified,
+		},
+		"not modified since": {
+			Headers:             map[string]string{"If-Modified-Since": lastModified.Format(http.TimeFormat)},
+			LastModified:        lastModified,
+			ExpectedNotModified: true,
+		},
+		"modified since": {
+			Headers:      map[string]string{"If-Modified-Since": lastModified.Add(-time.Second).Format(http.TimeFormat)},
+			LastModified: lastModified,
+		},
+		"invalid date": {
+			Headers:      map[string]string{"If-Modified-Since": "yesterday"},
+			LastModified: lastModified,
+		},
+		"empty feed has no modification time": {
+			Headers: map[string]string{"If-Modified-Since": lastModified.Format(http.TimeFormat)},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			header := http.Header{}
+			for key, value := range tc.Headers {
+				header.Set(key, value)
+			}
+			assert.Equal(t, tc.ExpectedNotModified, isNotModified(header, etag, tc.LastModified))
+		})
+	}
+}
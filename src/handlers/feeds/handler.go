@@ -0,0 +1,180 @@
+package feeds
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	models "go-api/src/models/feeds"
+	service "go-api/src/services/feeds"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"go.uber.org/fx"
+	"go.uber.org/zap"
+)
+
+// feedExtension is the suffix of the feed URLs, calendar apps rely on it
+const feedExtension = ".ics"
+
+// FeedHandler defines the interface for calendar feed API handlers
+type FeedHandler interface {
+	CreateFeedToken(e echo.Context) error
+	ListFeedTokens(e echo.Context) error
+	RevokeFeedToken(e echo.Context) error
+	GetCalendarFeed(e echo.Context) error
+}
+
+// FeedHandlerParams defines the dependencies for the feed handler
+type FeedHandlerParams struct {
+	fx.In
+
+	Service service.FeedService
+	Logger  *zap.Logger
+}
+
+type feedHandler struct {
+	service service.FeedService
+	logger  *zap.Logger
+}
+
+// NewFeedHandler creates a new feed handler with injected dependencies
+func NewFeedHandler(p FeedHandlerParams) FeedHandler {
+	return &feedHandler{
+		service: p.Service,
+		logger:  p.Logger,
+	}
+}
+
+// CreateFeedToken handles the creation of a calendar feed token
+//
+//	@Summary		Create a feed token
+//	@Description	Create a secret token for the user's calendar feed. The token and the feed URL are only returned once.
+//	@Tags			feeds
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			request	body		service.CreateFeedTokenRequest	true	"Feed token data"
+//	@Success		201		{object}	models.CreatedFeedToken
+//	@Failure		400		{object}	map[string]string
+//	@Failure		409		{object}	map[string]string	"Too many feed tokens"
+//	@Failure		500		{object}	map[string]string
+//	@Router			/feed-tokens [post]
+func (h *feedHandler) CreateFeedToken(e echo.Context) error {
+	var req service.CreateFeedTokenRequest
+	if err := e.Bind(&req); err != nil {
+		return e.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid request format"})
+	}
+
+	ctx := e.Request().Context()
+	feedToken, err := h.service.CreateFeedToken(ctx, req)
+	if err != nil {
+		return h.handleError(e, err, "Failed to create feed token")
+	}
+	feedToken.URL = fmt.Sprintf("%s://%s/feeds/%s%s", e.Scheme(), e.Request().Host, feedToken.Token, feedExtension)
+	return e.JSON(http.StatusCreated, feedToken)
+}
+
+// ListFeedTokens handles listing the user's active feed tokens
+//
+//	@Summary		List feed tokens
+//	@Description	List the user's calendar feed tokens that weren't revoked
+//	@Tags			feeds
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Success		200	{object}	[]models.FeedToken
+//	@Failure		500	{object}	map[string]string
+//	@Router			/feed-tokens [get]
+func (h *feedHandler) ListFeedTokens(e echo.Context) error {
+	ctx := e.Request().Context()
+	feedTokens, err := h.service.ListFeedTokens(ctx)
+	if err != nil {
+		return h.handleError(e, err, "Failed to list feed tokens")
+	}
+	return e.JSON(http.StatusOK, feedTokens)
+}
+
+// RevokeFeedToken handles revoking a feed token
+//
+//	@Summary		Revoke a feed token
+//	@Description	Revoke a calendar feed token, its feed URL stops working immediately
+//	@Tags			feeds
+//	@Security		BearerAuth
+//	@Param			id	path	string	true	"Feed token ID"
+//	@Success		204
+//	@Failure		400	{object}	map[string]string
+//	@Failure		404	{object}	map[string]string	"Feed token not found"
+//	@Failure		500	{object}	map[string]string
+//	@Router			/feed-tokens/{id} [delete]
+func (h *feedHandler) RevokeFeedToken(e echo.Context) error {
+	tokenID, err := uuid.Parse(e.Param("id"))
+	if err != nil {
+		return e.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid feed token id"})
+	}
+
+	ctx := e.Request().Context()
+	if err := h.service.RevokeFeedToken(ctx, tokenID); err != nil {
+		return h.handleError(e, err, "Failed to revoke feed token")
+	}
+	return e.NoContent(http.StatusNoContent)
+}
+
+// GetCalendarFeed handles serving a calendar feed to calendar apps
+//
+//	@Summary		Get calendar feed
+//	@Description	Serve the completed sessions of the token owner as an iCalendar feed. The token in the URL replaces the Bearer token. Supports If-None-Match and If-Modified-Since.
+//	@Tags			feeds
+//	@Produce		text/calendar
+//	@Param			token				path		string	true	"Feed token followed by .ics"
+//	@Param			If-None-Match		header		string	false	"ETag of the cached feed"
+//	@Param			If-Modified-Since	header		string	false	"Last-Modified of the cached feed"
+//	@Success		200					{file}		file
+//	@Success		304
+//	@Failure		404	{object}	map[string]string	"Feed not found"
+//	@Failure		500	{object}	map[string]string
+//	@Router			/feeds/{token} [get]
+func (h *feedHandler) GetCalendarFeed(e echo.Context) error {
+	token, ok := strings.CutSuffix(e.Param("token"), feedExtension)
+	if !ok {
+		return e.JSON(http.StatusNotFound, map[string]string{"error": "Feed not found"})
+	}
+
+	ctx := e.Request().Context()
+	version, err := h.service.ResolveCalendarFeed(ctx, token)
+	if err != nil {
+		return h.handleError(e, err, "Failed to get calendar feed")
+	}
+
+	header := e.Response().Header()
+	header.Set(headerETag, version.ETag)
+	if !version.LastModified.IsZero() {
+		header.Set(echo.HeaderLastModified, version.LastModified.Format(http.TimeFormat))
+	}
+	header.Set(echo.HeaderCacheControl, "private, no-cache")
+	if isNotModified(e.Request().Header, version.ETag, version.LastModified) {
+		return e.NoContent(http.StatusNotModified)
+	}
+
+	calendar, err := h.service.RenderCalendarFeed(ctx, *version)
+	if err != nil {
+		return h.handleError(e, err, "Failed to get calendar feed")
+	}
+	return e.Blob(http.StatusOK, "text/calendar; charset=utf-8", calendar)
+}
+
+// handleError maps the feed errors to their HTTP responses
+func (h *feedHandler) handleError(e echo.Context, err error, message string) error {
+	switch err {
+	case models.ErrInvalidFeedToken:
+		return e.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid feed token"})
+	case models.ErrFeedTokenNotFound:
+		return e.JSON(http.StatusNotFound, map[string]string{"error": "Feed token not found"})
+	case models.ErrFeedTokenLimit:
+		return e.JSON(http.StatusConflict, map[string]string{"error": "Too many feed tokens"})
+	case models.ErrFeedNotFound:
+		return e.JSON(http.StatusNotFound, map[string]string{"error": "Feed not found"})
+	default:
+		h.logger.Error(message, zap.Error(err))
+		return e.JSON(http.StatusInternalServerError, map[string]string{"error": message})
+	}
+}
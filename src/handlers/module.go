@@ -2,8 +2,13 @@ package handlers
 
 import (
 	"go-api/src/handlers/auth"
+	"go-api/src/handlers/controlchannel"
+	"go-api/src/handlers/feeds"
 	"go-api/src/handlers/healthcheck"
+	"go-api/src/handlers/stats"
 	"go-api/src/handlers/studysession"
+	"go-api/src/handlers/subjects"
+	"go-api/src/handlers/webhooks"
 
 	"go.uber.org/fx"
 )
@@ -13,5 +18,10 @@ var Module = fx.Options(
 		healthcheck.New,
 		auth.NewAuthHandler,
 		studysession.NewStudySessionHandler,
+		subjects.NewSubjectHandler,
+		stats.NewStatsHandler,
+		feeds.NewFeedHandler,
+		controlchannel.NewControlChannelHandler,
+		webhooks.NewWebhookHandler,
 	),
 )
@@ -0,0 +1,158 @@
+package stats
+
+import (
+	"net/http"
+
+	models "go-api/src/models/stats"
+	service "go-api/src/services/stats"
+
+	"github.com/labstack/echo/v4"
+	"go.uber.org/fx"
+	"go.uber.org/zap"
+)
+
+// StatsHandler defines the interface for study statistics API handlers
+type StatsHandler interface {
+	GetStudyStats(e echo.Context) error
+	GetStreak(e echo.Context) error
+	UpdateStreakSettings(e echo.Context) error
+	GetHeatmap(e echo.Context) error
+}
+
+// StatsHandlerParams defines the dependencies for the stats handler
+type StatsHandlerParams struct {
+	fx.In
+
+	Service service.StatsService
+	Logger  *zap.Logger
+}
+
+type statsHandler struct {
+	service service.StatsService
+	logger  *zap.Logger
+}
+
+// NewStatsHandler creates a new stats handler with injected dependencies
+func NewStatsHandler(p StatsHandlerParams) StatsHandler {
+	return &statsHandler{
+		service: p.Service,
+		logger:  p.Logger,
+	}
+}
+
+// GetStudyStats handles aggregating the user's study time per period
+//
+//	@Summary		Get study statistics
+//	@Description	Aggregate the focused time of the user's completed sessions per day, week or month in the user's timezone
+//	@Tags			stats
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			granularity	query		string	false	"Bucket size: day, week or month (default day)"
+//	@Param			from		query		string	false	"First date (YYYY-MM-DD), defaults to 30 days, 12 weeks or 12 months before to"
+//	@Param			to			query		string	false	"Last date (YYYY-MM-DD), defaults to today"
+//	@Param			tz			query		string	false	"IANA timezone of the buckets (default UTC)"
+//	@Success		200			{object}	models.StudyStats
+//	@Failure		400			{object}	map[string]string
+//	@Failure		500			{object}	map[string]string
+//	@Router			/stats [get]
+func (h *statsHandler) GetStudyStats(e echo.Context) error {
+	var req service.GetStudyStatsRequest
+	if err := e.Bind(&req); err != nil {
+		return e.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid request format"})
+	}
+
+	ctx := e.Request().Context()
+	stats, err := h.service.GetStudyStats(ctx, req)
+	if err != nil {
+		return h.handleError(e, err, "Failed to get study stats")
+	}
+	return e.JSON(http.StatusOK, stats)
+}
+
+// GetHeatmap handles retrieving the daily focused time of a year
+//
+//	@Summary		Get study heatmap
+//	@Description	Get the focused minutes of every day of a year with an intensity level based on the quantiles of the user's days
+//	@Tags			stats
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			year	query		int		false	"Year (default current year)"
+//	@Param			tz		query		string	false	"IANA timezone of the days (default the streak settings timezone)"
+//	@Success		200		{object}	models.Heatmap
+//	@Failure		400		{object}	map[string]string
+//	@Failure		500		{object}	map[string]string
+//	@Router			/stats/heatmap [get]
+func (h *statsHandler) GetHeatmap(e echo.Context) error {
+	var req service.GetHeatmapRequest
+	if err := e.Bind(&req); err != nil {
+		return e.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid request format"})
+	}
+
+	ctx := e.Request().Context()
+	heatmap, err := h.service.GetHeatmap(ctx, req)
+	if err != nil {
+		return h.handleError(e, err, "Failed to get heatmap")
+	}
+	return e.JSON(http.StatusOK, heatmap)
+}
+
+// GetStreak handles retrieving the user's study streak
+//
+//	@Summary		Get study streak
+//	@Description	Get the user's current and longest streak of days reaching the daily goal, along with the banked freezes
+//	@Tags			stats
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Success		200	{object}	models.Streak
+//	@Failure		500	{object}	map[string]string
+//	@Router			/streaks [get]
+func (h *statsHandler) GetStreak(e echo.Context) error {
+	ctx := e.Request().Context()
+	streak, err := h.service.GetStreak(ctx)
+	if err != nil {
+		return h.handleError(e, err, "Failed to get streak")
+	}
+	return e.JSON(http.StatusOK, streak)
+}
+
+// UpdateStreakSettings handles changing the user's daily goal and timezone
+//
+//	@Summary		Update streak settings
+//	@Description	Set the daily focused time goal and the timezone the streak days are counted in
+//	@Tags			stats
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			request	body		service.UpdateStreakSettingsRequest	true	"Streak settings"
+//	@Success		200		{object}	models.StreakSettings
+//	@Failure		400		{object}	map[string]string
+//	@Failure		500		{object}	map[string]string
+//	@Router			/streaks/settings [put]
+func (h *statsHandler) UpdateStreakSettings(e echo.Context) error {
+	var req service.UpdateStreakSettingsRequest
+	if err := e.Bind(&req); err != nil {
+		return e.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid request format"})
+	}
+
+	ctx := e.Request().Context()
+	settings, err := h.service.UpdateStreakSettings(ctx, req)
+	if err != nil {
+		return h.handleError(e, err, "Failed to update streak settings")
+	}
+	return e.JSON(http.StatusOK, settings)
+}
+
+// handleError maps the stats errors to their HTTP responses
+func (h *statsHandler) handleError(e echo.Context, err error, message string) error {
+	switch err {
+	case models.ErrInvalidStatsFilter:
+		return e.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid stats filter"})
+	case models.ErrInvalidTimezone:
+		return e.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid timezone"})
+	case models.ErrInvalidStreakSettings:
+		return e.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid streak settings"})
+	default:
+		h.logger.Error(message, zap.Error(err))
+		return e.JSON(http.StatusInternalServerError, map[string]string{"error": message})
+	}
+}
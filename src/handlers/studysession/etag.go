@@ -0,0 +1,39 @@
+package studysession
+
+import (
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	headerETag    = "ETag"
+	headerIfMatch = "If-Match"
+	// headerSyncCursor carries the cursor of the active session events, the
+	// body stays a plain list of events
+	headerSyncCursor = "X-Sync-Cursor"
+)
+
+// sessionETag derives the entity tag of a session from its last update time
+func sessionETag(updatedAt time.Time) string {
+	return `"` + strconv.FormatInt(updatedAt.UnixMicro(), 36) + `"`
+}
+
+// parseIfMatch returns the update time referenced by an If-Match header. A
+// missing header or "*" matches any version and returns nil.
+func parseIfMatch(header string) (*time.Time, bool) {
+	header = strings.TrimSpace(header)
+	if header == "" || header == "*" {
+		return nil, true
+	}
+	tag := strings.TrimPrefix(header, "W/")
+	if len(tag) < 2 || !strings.HasPrefix(tag, `"`) || !strings.HasSuffix(tag, `"`) {
+		return nil, false
+	}
+	micros, err := strconv.ParseInt(tag[1:len(tag)-1], 36, 64)
+	if err != nil {
+		return nil, false
+	}
+	updatedAt := time.UnixMicro(micros).UTC()
+	return &updatedAt, true
+}
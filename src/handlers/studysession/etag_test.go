@@ -0,0 +1,52 @@
+package studysession
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseIfMatch(t *testing.T) {
+	updatedAt := time.Date(2025, 1, 1, 10, 30, 0, 123456000, time.UTC)
+
+	tests := map[string]struct {
+		Header        string
+		ExpectedTime  *time.Time
+		ExpectedMatch bool
+	}{
+		"missing header": {
+			Header:        "",
+			ExpectedMatch: true,
+		},
+		"any version": {
+			Header:        "*",
+			ExpectedMatch: true,
+		},
+		"session etag": {
+			Header:        sessionETag(updatedAt),
+			ExpectedTime:  &updatedAt,
+			ExpectedMatch: true,
+		},
+		"weak session etag": {
+			Header:        "W/" + sessionETag(updatedAt),
+			ExpectedTime:  &updatedAt,
+			ExpectedMatch: true,
+		},
+		"unquoted etag": {
+			Header: "abc",
+		},
+		"unknown etag": {
+			Header: `"not-base-36!"`,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ifUpdatedAt, ok := parseIfMatch(tc.Header)
+
+			assert.Equal(t, tc.ExpectedMatch, ok)
+			assert.Equal(t, tc.ExpectedTime, ifUpdatedAt)
+		})
+	}
+}
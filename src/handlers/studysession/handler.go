@@ -1,11 +1,18 @@
 package studysession
 
 import (
+	"errors"
+	"fmt"
 	"net/http"
+	"time"
 
+	"go-api/src/config"
+	notificationmodels "go-api/src/models/notifications"
 	models "go-api/src/models/studysession"
+	notificationservice "go-api/src/services/notifications"
 	service "go-api/src/services/studysession"
 
+	"github.com/google/uuid"
 	"github.com/labstack/echo/v4"
 	"go.uber.org/fx"
 	"go.uber.org/zap"
@@ -14,45 +21,71 @@ import (
 // StudySessionHandler defines the interface for study session API handlers
 type StudySessionHandler interface {
 	StartStudySession(e echo.Context) error
+	CreateManualStudySession(e echo.Context) error
 	GetActiveStudySession(e echo.Context) error
 	AddStudySessionEvents(e echo.Context) error
 	FinishStudySession(e echo.Context) error
 	GetActiveStudySessionEvents(e echo.Context) error
+	GetStudySessionHistory(e echo.Context) error
+	GetStudySession(e echo.Context) error
+	GetStudySessionEvents(e echo.Context) error
+	UpdateActiveStudySession(e echo.Context) error
+	UpdateStudySession(e echo.Context) error
+	CancelActiveStudySession(e echo.Context) error
+	DeleteStudySession(e echo.Context) error
+	RestoreStudySession(e echo.Context) error
+	GetSubjectTimeTotals(e echo.Context) error
+	ExportStudySessionsICal(e echo.Context) error
+	ExportStudySessionData(e echo.Context) error
+	ImportStudySessions(e echo.Context) error
+	StreamStudySessionNotifications(e echo.Context) error
 }
 
 // StudySessionHandlerParams defines the dependencies for the study session handler
 type StudySessionHandlerParams struct {
 	fx.In
 
-	Service service.StudySessionService
-	Logger  *zap.Logger
+	Config              *config.Config
+	Service             service.StudySessionService
+	NotificationService notificationservice.NotificationService
+	Logger              *zap.Logger
 }
 
 type studySessionHandler struct {
-	service service.StudySessionService
-	logger  *zap.Logger
+	service             service.StudySessionService
+	notificationService notificationservice.NotificationService
+	logger              *zap.Logger
+	heartbeat           time.Duration
 }
 
 // NewStudySessionHandler creates a new study session handler with injected dependencies
 func NewStudySessionHandler(p StudySessionHandlerParams) StudySessionHandler {
+	heartbeat := defaultHeartbeat
+	if p.Config != nil && p.Config.SessionStreamHeartbeatSeconds > 0 {
+		heartbeat = time.Duration(p.Config.SessionStreamHeartbeatSeconds) * time.Second
+	}
 	return &studySessionHandler{
-		service: p.Service,
-		logger:  p.Logger,
+		service:             p.Service,
+		notificationService: p.NotificationService,
+		logger:              p.Logger,
+		heartbeat:           heartbeat,
 	}
 }
 
 // StartStudySession handles the creation of a new study session
 //
 //	@Summary		Create a study session
-//	@Description	Create a new study session for the authenticated user
+//	@Description	Create a new study session for the authenticated user, optionally in pomodoro mode
 //	@Tags			study-session
 //	@Accept			json
 //	@Produce		json
 //	@Security		BearerAuth
 //	@Param			request	body		service.UpsertActiveStudySessionRequest	true	"Study session data"
+//	@Param			Idempotency-Key	header	string	false	"Key making the request safe to retry, repeats replay the first response"
 //	@Success		201		{object}	models.StudySession
 //	@Failure		400		{object}	map[string]string
 //	@Failure		409		{object}	map[string]string	"Active session already exists"
+//	@Failure		422		{object}	map[string]string	"Subject not found"
 //	@Failure		500		{object}	map[string]string
 //	@Router			/study-session/start [post]
 func (h *studySessionHandler) StartStudySession(e echo.Context) error {
@@ -67,6 +100,12 @@ func (h *studySessionHandler) StartStudySession(e echo.Context) error {
 		switch err {
 		case models.ErrActiveSessionExists:
 			return e.JSON(http.StatusConflict, map[string]string{"error": "Active session already exists"})
+		case models.ErrInvalidPomodoro:
+			return e.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid pomodoro settings"})
+		case models.ErrInvalidSessionSubjects:
+			return e.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid session subjects"})
+		case models.ErrSubjectNotFound:
+			return e.JSON(http.StatusUnprocessableEntity, map[string]string{"error": "Subject not found"})
 		default:
 			h.logger.Error("Failed to create study session", zap.Error(err))
 			return e.JSON(http.StatusInternalServerError, map[string]string{"error": "Failed to create study session"})
@@ -76,10 +115,52 @@ func (h *studySessionHandler) StartStudySession(e echo.Context) error {
 	return e.JSON(http.StatusCreated, studySession)
 }
 
+// CreateManualStudySession handles logging a past session without a timer
+//
+//	@Summary		Log a past study session
+//	@Description	Record a completed session with explicit start, end and pause times, the active session is left untouched
+//	@Tags			study-session
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			request	body		service.CreateManualStudySessionRequest	true	"Past session data"
+//	@Success		201		{object}	models.StudySession
+//	@Failure		400		{object}	map[string]string
+//	@Failure		409		{object}	map[string]string	"Session overlaps another session"
+//	@Failure		422		{object}	map[string]string	"Subject not found"
+//	@Failure		500		{object}	map[string]string
+//	@Router			/study-session/manual [post]
+func (h *studySessionHandler) CreateManualStudySession(e echo.Context) error {
+	var req service.CreateManualStudySessionRequest
+	if err := e.Bind(&req); err != nil {
+		return e.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid request format"})
+	}
+
+	ctx := e.Request().Context()
+	studySession, err := h.service.CreateManualStudySession(ctx, req)
+	if err != nil {
+		switch err {
+		case models.ErrInvalidManualSession:
+			return e.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid session or pause times"})
+		case models.ErrSessionOverlap:
+			return e.JSON(http.StatusConflict, map[string]string{"error": "Session overlaps another session"})
+		case models.ErrInvalidSessionSubjects:
+			return e.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid session subjects"})
+		case models.ErrSubjectNotFound:
+			return e.JSON(http.StatusUnprocessableEntity, map[string]string{"error": "Subject not found"})
+		default:
+			h.logger.Error("Failed to create manual study session", zap.Error(err))
+			return e.JSON(http.StatusInternalServerError, map[string]string{"error": "Failed to create study session"})
+		}
+	}
+
+	return e.JSON(http.StatusCreated, studySession)
+}
+
 // GetActiveStudySession handles retrieving the active study session
 //
 //	@Summary		Get active study session
-//	@Description	Get the user's active study session
+//	@Description	Get the user's active study session, with the current phase and the time remaining for pomodoro sessions
 //	@Tags			study-session
 //	@Produce		json
 //	@Security		BearerAuth
@@ -99,22 +180,26 @@ func (h *studySessionHandler) GetActiveStudySession(e echo.Context) error {
 			return e.JSON(http.StatusInternalServerError, map[string]string{"error": "Failed to get active study session"})
 		}
 	}
+	e.Response().Header().Set(headerETag, sessionETag(studySession.UpdatedAt))
 	return e.JSON(http.StatusOK, studySession)
 }
 
 // AddStudySessionEvents handles adding events to the active study session
 //
 //	@Summary		Add events to active study session
-//	@Description	Add events to the user's active study session
+//	@Description	Add events to the user's active study session. Events whose id is already recorded in the session are skipped so
+//	@Description	offline clients can replay them, events older than the last session event are merged by event time.
 //	@Tags			study-session
 //	@Accept			json
 //	@Produce		json
 //	@Security		BearerAuth
 //	@Param			request	body		service.AddStudySessionEventsRequest	true	"Session events data"
-//	@Success		200		{object}	models.StudySession
+//	@Param			Idempotency-Key	header	string	false	"Key making the request safe to retry, repeats replay the first response"
+//	@Success		200		{object}	[]models.SessionEvent
+//	@Header			200		{string}	X-Sync-Cursor	"Cursor pointing after the last recorded event"
 //	@Failure		400		{object}	map[string]string
 //	@Failure		404		{object}	map[string]string	"No active session found"
-//	@Failure		422		{object}	map[string]string	"Session not active"
+//	@Failure		422		{object}	map[string]string	"Events break the session state machine or reuse the id of another session event"
 //	@Failure		500		{object}	map[string]string
 //	@Router			/study-session/events [post]
 func (h *studySessionHandler) AddStudySessionEvents(e echo.Context) error {
@@ -128,7 +213,11 @@ func (h *studySessionHandler) AddStudySessionEvents(e echo.Context) error {
 	}
 
 	ctx := e.Request().Context()
-	studySession, err := h.service.AddStudySessionEvents(ctx, req)
+	response, err := h.service.AddStudySessionEvents(ctx, req)
+	var invalidEventErr *models.InvalidEventError
+	if errors.As(err, &invalidEventErr) {
+		return e.JSON(http.StatusUnprocessableEntity, map[string]string{"error": invalidEventErr.Error()})
+	}
 	if err != nil {
 		switch err {
 		case models.ErrActiveSessionNotFound:
@@ -139,22 +228,24 @@ func (h *studySessionHandler) AddStudySessionEvents(e echo.Context) error {
 		}
 	}
 
-	return e.JSON(http.StatusOK, studySession)
+	e.Response().Header().Set(headerSyncCursor, response.Cursor)
+	return e.JSON(http.StatusOK, response.Events)
 }
 
 // FinishStudySession handles finishing the active study session
 //
 //	@Summary		Finish active study session
-//	@Description	Finish the user's active study session
+//	@Description	Finish the user's active study session, the response includes the change to the user's streak
 //	@Tags			study-session
 //	@Accept			json
 //	@Produce		json
 //	@Security		BearerAuth
 //	@Param			request	body		service.FinishStudySessionRequest	true	"Finish session data"
-//	@Success		200		{object}	models.StudySession
+//	@Param			Idempotency-Key	header	string	false	"Key making the request safe to retry, repeats replay the first response"
+//	@Success		200		{object}	service.FinishStudySessionResponse
 //	@Failure		400		{object}	map[string]string
 //	@Failure		404		{object}	map[string]string	"No active session found"
-//	@Failure		422		{object}	map[string]string	"Session not active"
+//	@Failure		422		{object}	map[string]string	"Finish time is not valid for the session"
 //	@Failure		500		{object}	map[string]string
 //	@Router			/study-session/finish [post]
 func (h *studySessionHandler) FinishStudySession(e echo.Context) error {
@@ -165,8 +256,14 @@ func (h *studySessionHandler) FinishStudySession(e echo.Context) error {
 
 	ctx := e.Request().Context()
 	studySession, err := h.service.FinishStudySession(ctx, req)
+	var invalidEventErr *models.InvalidEventError
+	if errors.As(err, &invalidEventErr) {
+		return e.JSON(http.StatusUnprocessableEntity, map[string]string{"error": invalidEventErr.Error()})
+	}
 	if err != nil {
 		switch err {
+		case models.ErrInvalidFinishRequest:
+			return e.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid finish request"})
 		case models.ErrActiveSessionNotFound:
 			return e.JSON(http.StatusNotFound, map[string]string{"error": "No active session found"})
 		default:
@@ -181,20 +278,30 @@ func (h *studySessionHandler) FinishStudySession(e echo.Context) error {
 // GetActiveStudySessionEvents handles retrieving events for the active study session
 //
 //	@Summary		Get active study session events
-//	@Description	Get events for the user's active study session
+//	@Description	Get events for the user's active study session. With a cursor only the events recorded after it are
+//	@Description	returned, including late events from other devices; a cursor from a previous session returns all events.
 //	@Tags			study-session
 //	@Produce		json
 //	@Security		BearerAuth
-//	@Success		200	{object}	[]models.SessionEvent
-//	@Failure		404	{object}	map[string]string	"No active session found"
-//	@Failure		500	{object}	map[string]string
+//	@Param			cursor	query		string	false	"X-Sync-Cursor returned by the previous sync"
+//	@Success		200		{object}	[]models.SessionEvent
+//	@Header			200		{string}	X-Sync-Cursor	"Cursor pointing after the last recorded event"
+//	@Failure		400		{object}	map[string]string	"Invalid cursor"
+//	@Failure		404		{object}	map[string]string	"No active session found"
+//	@Failure		500		{object}	map[string]string
 //	@Router			/study-session/events [get]
 func (h *studySessionHandler) GetActiveStudySessionEvents(e echo.Context) error {
-	ctx := e.Request().Context()
+	var req service.GetActiveStudySessionEventsRequest
+	if err := e.Bind(&req); err != nil {
+		return e.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid request format"})
+	}
 
-	events, err := h.service.GetActiveStudySessionEvents(ctx)
+	ctx := e.Request().Context()
+	response, err := h.service.GetActiveStudySessionEvents(ctx, req)
 	if err != nil {
 		switch err {
+		case models.ErrInvalidSyncCursor:
+			return e.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid cursor"})
 		case models.ErrActiveSessionNotFound:
 			return e.JSON(http.StatusNotFound, map[string]string{"error": "No active session found"})
 		default:
@@ -207,5 +314,577 @@ func (h *studySessionHandler) GetActiveStudySessionEvents(e echo.Context) error
 		}
 	}
 
+	e.Response().Header().Set(headerSyncCursor, response.Cursor)
+	return e.JSON(http.StatusOK, response.Events)
+}
+
+// GetStudySessionHistory handles listing the user's finished study sessions
+//
+//	@Summary		List study session history
+//	@Description	List the user's finished study sessions, most recent first
+//	@Tags			study-session
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			from	query		string		false	"First session date (YYYY-MM-DD)"
+//	@Param			to		query		string		false	"Last session date (YYYY-MM-DD)"
+//	@Param			state	query		[]string	false	"Session states to include"	collectionFormat(multi)
+//	@Param			title	query		string		false	"Case-insensitive title search"
+//	@Param			auto_closed	query	bool	false	"Only sessions closed by the stale session sweeper"
+//	@Param			cursor	query		string		false	"Cursor returned by the previous page"
+//	@Param			limit	query		int			false	"Page size (default 20, max 100)"
+//	@Success		200		{object}	models.StudySessionPage
+//	@Failure		400		{object}	map[string]string
+//	@Failure		500		{object}	map[string]string
+//	@Router			/study-session/history [get]
+func (h *studySessionHandler) GetStudySessionHistory(e echo.Context) error {
+	var req service.GetStudySessionHistoryRequest
+	if err := e.Bind(&req); err != nil {
+		return e.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid request format"})
+	}
+
+	ctx := e.Request().Context()
+	page, err := h.service.GetStudySessionHistory(ctx, req)
+	if err != nil {
+		switch err {
+		case models.ErrInvalidHistoryFilter:
+			return e.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid history filter"})
+		case models.ErrInvalidHistoryCursor:
+			return e.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid cursor"})
+		default:
+			h.logger.Error("Failed to get study session history", zap.Error(err))
+			return e.JSON(http.StatusInternalServerError, map[string]string{"error": "Failed to get study session history"})
+		}
+	}
+
+	return e.JSON(http.StatusOK, page)
+}
+
+// GetStudySession handles retrieving any study session owned by the user
+//
+//	@Summary		Get study session
+//	@Description	Get one of the user's study sessions, active or finished, with its events
+//	@Tags			study-session
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id	path		string	true	"Study session ID"
+//	@Success		200	{object}	models.StudySessionDetails
+//	@Failure		400	{object}	map[string]string
+//	@Failure		404	{object}	map[string]string	"Session not found"
+//	@Failure		500	{object}	map[string]string
+//	@Router			/study-session/{id} [get]
+func (h *studySessionHandler) GetStudySession(e echo.Context) error {
+	sessionID, err := uuid.Parse(e.Param("id"))
+	if err != nil {
+		return e.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid session id"})
+	}
+
+	ctx := e.Request().Context()
+	studySession, err := h.service.GetStudySession(ctx, sessionID)
+	if err != nil {
+		switch err {
+		case models.ErrSessionNotFound:
+			return e.JSON(http.StatusNotFound, map[string]string{"error": "Session not found"})
+		default:
+			h.logger.Error("Failed to get study session", zap.Error(err))
+			return e.JSON(http.StatusInternalServerError, map[string]string{"error": "Failed to get study session"})
+		}
+	}
+	e.Response().Header().Set(headerETag, sessionETag(studySession.UpdatedAt))
+	return e.JSON(http.StatusOK, studySession)
+}
+
+// GetStudySessionEvents handles retrieving the event timeline of a study session
+//
+//	@Summary		Get study session events
+//	@Description	Get the events of one of the user's study sessions, ordered by time
+//	@Tags			study-session
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id	path		string	true	"Study session ID"
+//	@Success		200	{object}	[]models.SessionEvent
+//	@Failure		400	{object}	map[string]string
+//	@Failure		404	{object}	map[string]string	"Session not found"
+//	@Failure		500	{object}	map[string]string
+//	@Router			/study-session/{id}/events [get]
+func (h *studySessionHandler) GetStudySessionEvents(e echo.Context) error {
+	sessionID, err := uuid.Parse(e.Param("id"))
+	if err != nil {
+		return e.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid session id"})
+	}
+
+	ctx := e.Request().Context()
+	events, err := h.service.GetStudySessionEvents(ctx, sessionID)
+	if err != nil {
+		switch err {
+		case models.ErrSessionNotFound:
+			return e.JSON(http.StatusNotFound, map[string]string{"error": "Session not found"})
+		default:
+			h.logger.Error("Failed to get study session events",
+				zap.Error(err),
+				zap.String("endpoint", "/study-session/:id/events"),
+			)
+			return e.JSON(http.StatusInternalServerError,
+				map[string]string{"error": "Failed to get study session events"})
+		}
+	}
 	return e.JSON(http.StatusOK, events)
 }
+
+// UpdateActiveStudySession handles editing the active study session
+//
+//	@Summary		Update active study session
+//	@Description	Update the title, notes and subjects of the user's active study session
+//	@Tags			study-session
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			If-Match	header		string								false	"ETag of the session version being edited"
+//	@Param			request		body		service.UpdateStudySessionRequest	true	"Fields to update"
+//	@Success		200			{object}	models.StudySession
+//	@Failure		400			{object}	map[string]string
+//	@Failure		404			{object}	map[string]string	"No active session found"
+//	@Failure		412			{object}	map[string]string	"Session was modified"
+//	@Failure		422			{object}	map[string]string	"Subject not found"
+//	@Failure		500			{object}	map[string]string
+//	@Router			/study-session [patch]
+func (h *studySessionHandler) UpdateActiveStudySession(e echo.Context) error {
+	var req service.UpdateStudySessionRequest
+	if err := e.Bind(&req); err != nil {
+		return e.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid request format"})
+	}
+	ifUpdatedAt, ok := parseIfMatch(e.Request().Header.Get(headerIfMatch))
+	if !ok {
+		return e.JSON(http.StatusPreconditionFailed, map[string]string{"error": "Session was modified"})
+	}
+	req.IfUpdatedAt = ifUpdatedAt
+
+	ctx := e.Request().Context()
+	studySession, err := h.service.UpdateActiveStudySession(ctx, req)
+	if err != nil {
+		switch err {
+		case models.ErrInvalidSessionUpdate:
+			return e.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid session update"})
+		case models.ErrInvalidSessionSubjects:
+			return e.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid session subjects"})
+		case models.ErrSubjectNotFound:
+			return e.JSON(http.StatusUnprocessableEntity, map[string]string{"error": "Subject not found"})
+		case models.ErrActiveSessionNotFound:
+			return e.JSON(http.StatusNotFound, map[string]string{"error": "No active session found"})
+		case models.ErrSessionModified:
+			return e.JSON(http.StatusPreconditionFailed, map[string]string{"error": "Session was modified"})
+		default:
+			h.logger.Error("Failed to update active study session", zap.Error(err))
+			return e.JSON(http.StatusInternalServerError, map[string]string{"error": "Failed to update active study session"})
+		}
+	}
+
+	e.Response().Header().Set(headerETag, sessionETag(studySession.UpdatedAt))
+	return e.JSON(http.StatusOK, studySession)
+}
+
+// UpdateStudySession handles editing any study session owned by the user
+//
+//	@Summary		Update study session
+//	@Description	Update the title, notes and subjects of one of the user's study sessions
+//	@Tags			study-session
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id			path		string								true	"Study session ID"
+//	@Param			If-Match	header		string								false	"ETag of the session version being edited"
+//	@Param			request		body		service.UpdateStudySessionRequest	true	"Fields to update"
+//	@Success		200			{object}	models.StudySession
+//	@Failure		400			{object}	map[string]string
+//	@Failure		404			{object}	map[string]string	"Session not found"
+//	@Failure		412			{object}	map[string]string	"Session was modified"
+//	@Failure		422			{object}	map[string]string	"Subject not found"
+//	@Failure		500			{object}	map[string]string
+//	@Router			/study-session/{id} [patch]
+func (h *studySessionHandler) UpdateStudySession(e echo.Context) error {
+	sessionID, err := uuid.Parse(e.Param("id"))
+	if err != nil {
+		return e.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid session id"})
+	}
+	var req service.UpdateStudySessionRequest
+	if err := e.Bind(&req); err != nil {
+		return e.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid request format"})
+	}
+	ifUpdatedAt, ok := parseIfMatch(e.Request().Header.Get(headerIfMatch))
+	if !ok {
+		return e.JSON(http.StatusPreconditionFailed, map[string]string{"error": "Session was modified"})
+	}
+	req.IfUpdatedAt = ifUpdatedAt
+
+	ctx := e.Request().Context()
+	studySession, err := h.service.UpdateStudySession(ctx, sessionID, req)
+	if err != nil {
+		switch err {
+		case models.ErrInvalidSessionUpdate:
+			return e.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid session update"})
+		case models.ErrInvalidSessionSubjects:
+			return e.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid session subjects"})
+		case models.ErrSubjectNotFound:
+			return e.JSON(http.StatusUnprocessableEntity, map[string]string{"error": "Subject not found"})
+		case models.ErrSessionNotFound:
+			return e.JSON(http.StatusNotFound, map[string]string{"error": "Session not found"})
+		case models.ErrSessionModified:
+			return e.JSON(http.StatusPreconditionFailed, map[string]string{"error": "Session was modified"})
+		default:
+			h.logger.Error("Failed to update study session", zap.Error(err))
+			return e.JSON(http.StatusInternalServerError, map[string]string{"error": "Failed to update study session"})
+		}
+	}
+
+	e.Response().Header().Set(headerETag, sessionETag(studySession.UpdatedAt))
+	return e.JSON(http.StatusOK, studySession)
+}
+
+// CancelActiveStudySession handles discarding the active study session
+//
+//	@Summary		Cancel active study session
+//	@Description	Discard the user's active study session, it's kept as abandoned
+//	@Tags			study-session
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Success		200	{object}	models.StudySession
+//	@Failure		404	{object}	map[string]string	"No active session found"
+//	@Failure		500	{object}	map[string]string
+//	@Router			/study-session/cancel [post]
+func (h *studySessionHandler) CancelActiveStudySession(e echo.Context) error {
+	ctx := e.Request().Context()
+	studySession, err := h.service.CancelActiveStudySession(ctx)
+	if err != nil {
+		switch err {
+		case models.ErrActiveSessionNotFound:
+			return e.JSON(http.StatusNotFound, map[string]string{"error": "No active session found"})
+		default:
+			h.logger.Error("Failed to cancel study session", zap.Error(err))
+			return e.JSON(http.StatusInternalServerError, map[string]string{"error": "Failed to cancel study session"})
+		}
+	}
+	return e.JSON(http.StatusOK, studySession)
+}
+
+// DeleteStudySession handles deleting a finished study session
+//
+//	@Summary		Delete study session
+//	@Description	Delete one of the user's finished study sessions, it can be restored during the retention window
+//	@Tags			study-session
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id	path	string	true	"Study session ID"
+//	@Success		204
+//	@Failure		400	{object}	map[string]string
+//	@Failure		404	{object}	map[string]string	"Session not found"
+//	@Failure		409	{object}	map[string]string	"Session is active"
+//	@Failure		500	{object}	map[string]string
+//	@Router			/study-session/{id} [delete]
+func (h *studySessionHandler) DeleteStudySession(e echo.Context) error {
+	sessionID, err := uuid.Parse(e.Param("id"))
+	if err != nil {
+		return e.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid session id"})
+	}
+
+	ctx := e.Request().Context()
+	err = h.service.DeleteStudySession(ctx, sessionID)
+	if err != nil {
+		switch err {
+		case models.ErrSessionNotFound:
+			return e.JSON(http.StatusNotFound, map[string]string{"error": "Session not found"})
+		case models.ErrSessionIsActive:
+			return e.JSON(http.StatusConflict, map[string]string{"error": "Active sessions must be finished or cancelled first"})
+		default:
+			h.logger.Error("Failed to delete study session", zap.Error(err))
+			return e.JSON(http.StatusInternalServerError, map[string]string{"error": "Failed to delete study session"})
+		}
+	}
+	return e.NoContent(http.StatusNoContent)
+}
+
+// RestoreStudySession handles restoring a deleted study session
+//
+//	@Summary		Restore study session
+//	@Description	Restore one of the user's deleted study sessions while it's within the retention window
+//	@Tags			study-session
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id	path		string	true	"Study session ID"
+//	@Success		200	{object}	models.StudySession
+//	@Failure		400	{object}	map[string]string
+//	@Failure		404	{object}	map[string]string	"No restorable session found"
+//	@Failure		500	{object}	map[string]string
+//	@Router			/study-session/{id}/restore [post]
+func (h *studySessionHandler) RestoreStudySession(e echo.Context) error {
+	sessionID, err := uuid.Parse(e.Param("id"))
+	if err != nil {
+		return e.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid session id"})
+	}
+
+	ctx := e.Request().Context()
+	studySession, err := h.service.RestoreStudySession(ctx, sessionID)
+	if err != nil {
+		switch err {
+		case models.ErrSessionNotFound:
+			return e.JSON(http.StatusNotFound, map[string]string{"error": "No restorable session found"})
+		default:
+			h.logger.Error("Failed to restore study session", zap.Error(err))
+			return e.JSON(http.StatusInternalServerError, map[string]string{"error": "Failed to restore study session"})
+		}
+	}
+	e.Response().Header().Set(headerETag, sessionETag(studySession.UpdatedAt))
+	return e.JSON(http.StatusOK, studySession)
+}
+
+// GetSubjectTimeTotals handles reporting the time spent on each subject
+//
+//	@Summary		Get time per subject
+//	@Description	Split the focused time of the user's completed sessions between their subjects
+//	@Tags			study-session
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			from	query		string	false	"First session date (YYYY-MM-DD)"
+//	@Param			to		query		string	false	"Last session date (YYYY-MM-DD)"
+//	@Success		200		{object}	models.SubjectTimeReport
+//	@Failure		400		{object}	map[string]string
+//	@Failure		500		{object}	map[string]string
+//	@Router			/study-session/subject-totals [get]
+func (h *studySessionHandler) GetSubjectTimeTotals(e echo.Context) error {
+	var req service.GetSubjectTimeTotalsRequest
+	if err := e.Bind(&req); err != nil {
+		return e.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid request format"})
+	}
+
+	ctx := e.Request().Context()
+	report, err := h.service.GetSubjectTimeTotals(ctx, req)
+	if err != nil {
+		switch err {
+		case models.ErrInvalidHistoryFilter:
+			return e.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid date range"})
+		default:
+			h.logger.Error("Failed to get subject time totals", zap.Error(err))
+			return e.JSON(http.StatusInternalServerError, map[string]string{"error": "Failed to get subject time totals"})
+		}
+	}
+
+	return e.JSON(http.StatusOK, report)
+}
+
+// ExportStudySessionsICal handles exporting the completed sessions to a calendar
+//
+//	@Summary		Export study sessions as iCalendar
+//	@Description	Export the user's completed sessions as an RFC 5545 calendar, one event per session
+//	@Tags			study-session
+//	@Produce		text/calendar
+//	@Security		BearerAuth
+//	@Param			from	query		string	false	"First session date (YYYY-MM-DD)"
+//	@Param			to		query		string	false	"Last session date (YYYY-MM-DD)"
+//	@Success		200		{file}		file
+//	@Failure		400		{object}	map[string]string
+//	@Failure		500		{object}	map[string]string
+//	@Router			/study-session/export.ics [get]
+func (h *studySessionHandler) ExportStudySessionsICal(e echo.Context) error {
+	var req service.ExportStudySessionsRequest
+	if err := e.Bind(&req); err != nil {
+		return e.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid request format"})
+	}
+
+	ctx := e.Request().Context()
+	calendar, err := h.service.ExportStudySessionsICal(ctx, req)
+	if err != nil {
+		switch err {
+		case models.ErrInvalidHistoryFilter:
+			return e.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid date range"})
+		default:
+			h.logger.Error("Failed to export study sessions", zap.Error(err))
+			return e.JSON(http.StatusInternalServerError, map[string]string{"error": "Failed to export study sessions"})
+		}
+	}
+
+	e.Response().Header().Set(echo.HeaderContentDisposition, `attachment; filename="study-sessions.ics"`)
+	return e.Blob(http.StatusOK, "text/calendar; charset=utf-8", calendar)
+}
+
+// ExportStudySessionData handles exporting all the sessions with their events
+//
+//	@Summary		Export study sessions
+//	@Description	Stream all the user's sessions with their events and durations as CSV (one row per event) or as a JSON array
+//	@Tags			study-session
+//	@Produce		text/csv
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			format	query		string	false	"Export format (csv, json), defaults to csv"
+//	@Success		200		{file}		file
+//	@Failure		400		{object}	map[string]string
+//	@Failure		500		{object}	map[string]string
+//	@Router			/study-session/export [get]
+func (h *studySessionHandler) ExportStudySessionData(e echo.Context) error {
+	var req service.ExportStudySessionDataRequest
+	if err := e.Bind(&req); err != nil {
+		return e.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid request format"})
+	}
+	format, err := models.ParseExportFormat(req.Format)
+	if err != nil {
+		return e.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid export format"})
+	}
+
+	response := e.Response()
+	response.Header().Set(echo.HeaderContentType, format.ContentType())
+	response.Header().Set(echo.HeaderContentDisposition, fmt.Sprintf(`attachment; filename="study-sessions.%s"`, format))
+
+	ctx := e.Request().Context()
+	if err := h.service.ExportStudySessionData(ctx, req, response); err != nil {
+		// Once the body has started the status can't be changed anymore, the
+		// client sees a truncated file
+		if response.Committed {
+			h.logger.Error("Study session export interrupted", zap.Error(err))
+			return nil
+		}
+		response.Header().Del(echo.HeaderContentDisposition)
+		switch err {
+		case models.ErrInvalidExportFormat:
+			return e.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid export format"})
+		default:
+			h.logger.Error("Failed to export study sessions", zap.Error(err))
+			return e.JSON(http.StatusInternalServerError, map[string]string{"error": "Failed to export study sessions"})
+		}
+	}
+	if !response.Committed {
+		response.WriteHeader(http.StatusOK)
+	}
+	return nil
+}
+
+// ImportStudySessions handles importing completed sessions from a CSV file
+//
+//	@Summary		Import study sessions
+//	@Description	Create completed sessions from a CSV file with start, end, title, notes and subject columns, or from a Toggl or Clockify export. Rows already imported are skipped and nothing is imported when a row is invalid.
+//	@Tags			study-session
+//	@Accept			multipart/form-data
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			file	formData	file	true	"CSV file"
+//	@Param			mapping	query		string	false	"Column mapping (generic, toggl, clockify), defaults to generic"
+//	@Param			tz		query		string	false	"IANA timezone of the times without offset, defaults to UTC"
+//	@Param			dry_run	query		bool	false	"Validate the file without importing it"
+//	@Success		200		{object}	models.ImportReport
+//	@Success		201		{object}	models.ImportReport
+//	@Failure		400		{object}	map[string]string
+//	@Failure		409		{object}	map[string]string	"A session overlapping the file was created meanwhile"
+//	@Failure		413		{object}	map[string]string
+//	@Failure		422		{object}	models.ImportReport
+//	@Failure		500		{object}	map[string]string
+//	@Router			/study-session/import [post]
+func (h *studySessionHandler) ImportStudySessions(e echo.Context) error {
+	var req service.ImportStudySessionsRequest
+	if err := (&echo.DefaultBinder{}).BindQueryParams(e, &req); err != nil {
+		return e.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid request format"})
+	}
+	fileHeader, err := e.FormFile("file")
+	if err != nil {
+		return e.JSON(http.StatusBadRequest, map[string]string{"error": "Missing import file"})
+	}
+	file, err := fileHeader.Open()
+	if err != nil {
+		return e.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid import file"})
+	}
+	defer file.Close()
+
+	ctx := e.Request().Context()
+	report, err := h.service.ImportStudySessions(ctx, req, file)
+	if err != nil {
+		switch err {
+		case models.ErrInvalidImportOptions:
+			return e.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid import mapping or timezone"})
+		case models.ErrInvalidImportFile:
+			return e.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid CSV file or missing columns"})
+		case models.ErrImportTooLarge:
+			return e.JSON(http.StatusRequestEntityTooLarge, map[string]string{"error": "Import file too large"})
+		case models.ErrSessionOverlap:
+			return e.JSON(http.StatusConflict, map[string]string{"error": "Session overlaps another session"})
+		default:
+			h.logger.Error("Failed to import study sessions", zap.Error(err))
+			return e.JSON(http.StatusInternalServerError, map[string]string{"error": "Failed to import study sessions"})
+		}
+	}
+
+	switch {
+	case report.Invalid > 0 && !report.DryRun:
+		return e.JSON(http.StatusUnprocessableEntity, report)
+	case report.Imported > 0:
+		return e.JSON(http.StatusCreated, report)
+	default:
+		return e.JSON(http.StatusOK, report)
+	}
+}
+
+// StreamStudySessionNotifications handles streaming the changes to the user's sessions
+//
+//	@Summary		Stream study session notifications
+//	@Description	Server-sent events stream of the changes to the user's sessions, made from any device. Each event is named
+//	@Description	after the notification type (e.g. session.started, session.paused) and its id can be sent back as
+//	@Description	Last-Event-ID to resume after a disconnect. A comment is sent as heartbeat while there is no change.
+//	@Tags			study-session
+//	@Produce		text/event-stream
+//	@Security		BearerAuth
+//	@Param			Last-Event-ID	header		string	false	"Id of the last event received, the stream resumes after it"
+//	@Param			last_event_id	query		string	false	"Same as the Last-Event-ID header, for clients that can't set headers"
+//	@Success		200				{object}	notificationmodels.Notification
+//	@Failure		400				{object}	map[string]string	"Invalid last event id"
+//	@Failure		500				{object}	map[string]string
+//	@Router			/study-session/stream [get]
+func (h *studySessionHandler) StreamStudySessionNotifications(e echo.Context) error {
+	lastEventID := e.Request().Header.Get(headerLastEventID)
+	if lastEventID == "" {
+		lastEventID = e.QueryParam("last_event_id")
+	}
+
+	ctx := e.Request().Context()
+	notifications, err := h.notificationService.Subscribe(ctx, notificationservice.SubscribeRequest{LastEventID: lastEventID})
+	if err != nil {
+		switch err {
+		case notificationmodels.ErrInvalidLastEventID:
+			return e.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid last event id"})
+		default:
+			h.logger.Error("Failed to subscribe to study session notifications",
+				zap.Error(err),
+				zap.String("endpoint", "/study-session/stream"),
+			)
+			return e.JSON(http.StatusInternalServerError,
+				map[string]string{"error": "Failed to stream study session notifications"})
+		}
+	}
+
+	response := e.Response()
+	response.Header().Set(echo.HeaderContentType, mimeEventStream)
+	response.Header().Set(echo.HeaderCacheControl, "no-cache")
+	response.Header().Set(echo.HeaderConnection, "keep-alive")
+	// Keep reverse proxies from buffering the stream
+	response.Header().Set("X-Accel-Buffering", "no")
+	response.WriteHeader(http.StatusOK)
+	if _, err := response.Write([]byte(streamRetry)); err != nil {
+		return nil
+	}
+	response.Flush()
+
+	heartbeat := time.NewTicker(h.heartbeat)
+	defer heartbeat.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case notification, ok := <-notifications:
+			// The stream ended on the server side, the client reconnects
+			if !ok {
+				return nil
+			}
+			if err := writeStreamEvent(response, notification); err != nil {
+				return nil
+			}
+		case <-heartbeat.C:
+			if _, err := response.Write([]byte(streamHeartbeat)); err != nil {
+				return nil
+			}
+		}
+		response.Flush()
+	}
+}
@@ -0,0 +1,306 @@
+package studysession
+
+import (
+	mocknotifications "go-api/.internal/mocks/src/services/notifications"
+	mockstudysession "go-api/.internal/mocks/src/services/studysession"
+	notificationmodels "go-api/src/models/notifications"
+	models "go-api/src/models/studysession"
+	notificationservice "go-api/src/services/notifications"
+	service "go-api/src/services/studysession"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/zap/zaptest"
+)
+
+// HandlerTestSuite ...
+type HandlerTestSuite struct {
+	suite.Suite
+
+	MockService             *mockstudysession.StudySessionService
+	MockNotificationService *mocknotifications.NotificationService
+
+	Handler StudySessionHandler
+}
+
+// SetupTest ...
+func (s *HandlerTestSuite) SetupTest() {
+	t := s.T()
+	s.MockService = mockstudysession.NewStudySessionService(t)
+	s.MockNotificationService = mocknotifications.NewNotificationService(t)
+	s.Handler = NewStudySessionHandler(StudySessionHandlerParams{
+		Service:             s.MockService,
+		NotificationService: s.MockNotificationService,
+		Logger:              zaptest.NewLogger(t),
+	})
+}
+
+// SetupSubTest ...
+func (s *HandlerTestSuite) SetupSubTest() {
+	s.SetupTest() // Clean up the mocks
+}
+
+// TestHandlerTestSuite ...
+func TestHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(HandlerTestSuite))
+}
+
+// TestGetStudySession ...
+func (s *HandlerTestSuite) TestGetStudySession() {
+	sessionID := uuid.New()
+
+	tests := map[string]struct {
+		SessionID      string
+		MockSetup      func()
+		ExpectedStatus int
+	}{
+		"success": {
+			SessionID: sessionID.String(),
+			MockSetup: func() {
+				s.MockService.EXPECT().GetStudySession(mock.Anything, sessionID).Return(&models.StudySessionDetails{
+					StudySession: models.StudySession{ID: sessionID},
+					Events:       []models.SessionEvent{},
+				}, nil)
+			},
+			ExpectedStatus: http.StatusOK,
+		},
+		"invalid session id": {
+			SessionID:      "not-a-uuid",
+			ExpectedStatus: http.StatusBadRequest,
+		},
+		"session from another user": {
+			SessionID: sessionID.String(),
+			MockSetup: func() {
+				s.MockService.EXPECT().GetStudySession(mock.Anything, sessionID).Return(nil, models.ErrSessionNotFound)
+			},
+			ExpectedStatus: http.StatusNotFound,
+		},
+		"fail to get session": {
+			SessionID: sessionID.String(),
+			MockSetup: func() {
+				s.MockService.EXPECT().GetStudySession(mock.Anything, sessionID).Return(nil, assert.AnError)
+			},
+			ExpectedStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			if tc.MockSetup != nil {
+				tc.MockSetup()
+			}
+
+			resp, err := runHandler(s.Handler.GetStudySession, http.MethodGet, nil, map[string]string{"id": tc.SessionID})
+
+			s.NoError(err)
+			s.Equal(tc.ExpectedStatus, resp.Code)
+		})
+	}
+}
+
+// TestAddStudySessionEvents ...
+func (s *HandlerTestSuite) TestAddStudySessionEvents() {
+	body := `{"events":[{"event_type":"pause","event_time":"2025-01-01T10:00:00Z"}]}`
+
+	tests := map[string]struct {
+		Body           string
+		MockSetup      func()
+		ExpectedStatus int
+		ExpectedCursor string
+	}{
+		"success": {
+			Body: body,
+			MockSetup: func() {
+				s.MockService.EXPECT().AddStudySessionEvents(mock.Anything, mock.Anything).Return(&service.SessionEventsResponse{
+					Events: []models.SessionEvent{},
+					Cursor: "cursor",
+				}, nil)
+			},
+			ExpectedStatus: http.StatusOK,
+			ExpectedCursor: "cursor",
+		},
+		"no events": {
+			Body:           `{"events":[]}`,
+			ExpectedStatus: http.StatusBadRequest,
+		},
+		"no active session": {
+			Body: body,
+			MockSetup: func() {
+				s.MockService.EXPECT().AddStudySessionEvents(mock.Anything, mock.Anything).Return(nil, models.ErrActiveSessionNotFound)
+			},
+			ExpectedStatus: http.StatusNotFound,
+		},
+		"invalid transition": {
+			Body: body,
+			MockSetup: func() {
+				s.MockService.EXPECT().AddStudySessionEvents(mock.Anything, mock.Anything).Return(nil, &models.InvalidEventError{
+					Event:  models.SessionEvent{EventType: models.EventTypePause},
+					Reason: "not allowed while session is paused",
+				})
+			},
+			ExpectedStatus: http.StatusUnprocessableEntity,
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			if tc.MockSetup != nil {
+				tc.MockSetup()
+			}
+
+			resp, err := runHandler(s.Handler.AddStudySessionEvents, http.MethodPost, &tc.Body, nil)
+
+			s.NoError(err)
+			s.Equal(tc.ExpectedStatus, resp.Code)
+			s.Equal(tc.ExpectedCursor, resp.Header().Get(headerSyncCursor))
+		})
+	}
+}
+
+// TestGetActiveStudySessionEvents ...
+func (s *HandlerTestSuite) TestGetActiveStudySessionEvents() {
+	tests := map[string]struct {
+		MockSetup      func()
+		ExpectedStatus int
+		ExpectedCursor string
+	}{
+		"success": {
+			MockSetup: func() {
+				s.MockService.EXPECT().GetActiveStudySessionEvents(mock.Anything, mock.Anything).Return(&service.SessionEventsResponse{
+					Events: []models.SessionEvent{{ID: uuid.New(), EventType: models.EventTypePause}},
+					Cursor: "cursor",
+				}, nil)
+			},
+			ExpectedStatus: http.StatusOK,
+			ExpectedCursor: "cursor",
+		},
+		"invalid cursor": {
+			MockSetup: func() {
+				s.MockService.EXPECT().GetActiveStudySessionEvents(mock.Anything, mock.Anything).Return(nil, models.ErrInvalidSyncCursor)
+			},
+			ExpectedStatus: http.StatusBadRequest,
+		},
+		"no active session": {
+			MockSetup: func() {
+				s.MockService.EXPECT().GetActiveStudySessionEvents(mock.Anything, mock.Anything).Return(nil, models.ErrActiveSessionNotFound)
+			},
+			ExpectedStatus: http.StatusNotFound,
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			tc.MockSetup()
+
+			resp, err := runHandler(s.Handler.GetActiveStudySessionEvents, http.MethodGet, nil, nil)
+
+			s.NoError(err)
+			s.Equal(tc.ExpectedStatus, resp.Code)
+			s.Equal(tc.ExpectedCursor, resp.Header().Get(headerSyncCursor))
+		})
+	}
+}
+
+// TestStreamStudySessionNotifications ...
+func (s *HandlerTestSuite) TestStreamStudySessionNotifications() {
+	notification := notificationmodels.Notification{
+		ID:        6,
+		SessionID: uuid.MustParse("6b0f4c1e-2f57-4c3a-9d51-3f1e0c2b7a10"),
+		Type:      notificationmodels.NotificationTypePaused,
+		CreatedAt: time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC),
+	}
+	stream := func() <-chan notificationmodels.Notification {
+		notifications := make(chan notificationmodels.Notification, 1)
+		notifications <- notification
+		close(notifications)
+		return notifications
+	}
+
+	tests := map[string]struct {
+		Header         string
+		Query          string
+		MockSetup      func()
+		ExpectedStatus int
+		ExpectedBody   string
+	}{
+		"resumes after the header id": {
+			Header: "5",
+			MockSetup: func() {
+				s.MockNotificationService.EXPECT().Subscribe(mock.Anything, notificationservice.SubscribeRequest{LastEventID: "5"}).Return(stream(), nil)
+			},
+			ExpectedStatus: http.StatusOK,
+			ExpectedBody: "retry: 3000\n\n" +
+				"id: 6\nevent: session.paused\n" +
+				`data: {"id":6,"session_id":"6b0f4c1e-2f57-4c3a-9d51-3f1e0c2b7a10","type":"session.paused","created_at":"2025-01-01T12:00:00Z"}` + "\n\n",
+		},
+		"resumes after the query id": {
+			Query: "5",
+			MockSetup: func() {
+				s.MockNotificationService.EXPECT().Subscribe(mock.Anything, notificationservice.SubscribeRequest{LastEventID: "5"}).Return(stream(), nil)
+			},
+			ExpectedStatus: http.StatusOK,
+		},
+		"invalid last event id": {
+			Header: "abc",
+			MockSetup: func() {
+				s.MockNotificationService.EXPECT().Subscribe(mock.Anything, notificationservice.SubscribeRequest{LastEventID: "abc"}).Return(nil, notificationmodels.ErrInvalidLastEventID)
+			},
+			ExpectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			tc.MockSetup()
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodGet, "/study-session/stream", nil)
+			if tc.Header != "" {
+				req.Header.Set(headerLastEventID, tc.Header)
+			}
+			if tc.Query != "" {
+				req = httptest.NewRequest(http.MethodGet, "/study-session/stream?last_event_id="+tc.Query, nil)
+			}
+			rec := httptest.NewRecorder()
+
+			err := s.Handler.StreamStudySessionNotifications(e.NewContext(req, rec))
+
+			s.NoError(err)
+			s.Equal(tc.ExpectedStatus, rec.Code)
+			if tc.ExpectedStatus == http.StatusOK {
+				s.Equal(mimeEventStream, rec.Header().Get(echo.HeaderContentType))
+			}
+			if tc.ExpectedBody != "" {
+				s.Equal(tc.ExpectedBody, rec.Body.String())
+			}
+		})
+	}
+}
+
+func runHandler(f func(e echo.Context) error, method string, body *string, params map[string]string) (*httptest.ResponseRecorder, error) {
+	e := echo.New()
+	req := httptest.NewRequest(method, "/", nil)
+	if body != nil {
+		req = httptest.NewRequest(method, "/", strings.NewReader(*body))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	}
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	names, values := []string{}, []string{}
+	for name, value := range params {
+		names = append(names, name)
+		values = append(values, value)
+	}
+	c.SetParamNames(names...)
+	c.SetParamValues(values...)
+
+	err := f(c)
+	return rec, err
+}
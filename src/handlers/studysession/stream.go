@@ -0,0 +1,31 @@
+package studysession
+
+import (
+	"encoding/json"
+	"fmt"
+	models "go-api/src/models/notifications"
+	"io"
+	"time"
+)
+
+const (
+	headerLastEventID = "Last-Event-ID"
+	mimeEventStream   = "text/event-stream"
+	defaultHeartbeat  = 15 * time.Second
+	// streamRetry tells clients to reconnect 3 seconds after the stream drops
+	streamRetry = "retry: 3000\n\n"
+	// streamHeartbeat is a comment, ignored by clients, that keeps proxies
+	// from closing an idle stream and detects clients that went away
+	streamHeartbeat = ": heartbeat\n\n"
+)
+
+// writeStreamEvent writes the notification as a server-sent event, its id is
+// the Last-Event-ID the client resumes from
+func writeStreamEvent(w io.Writer, notification models.Notification) error {
+	data, err := json.Marshal(notification)
+	if err != nil {
+		return fmt.Errorf("failed to encode notification: %w", err)
+	}
+	_, err = fmt.Fprintf(w, "id: %d\nevent: %s\ndata: %s\n\n", notification.ID, notification.Type, data)
+	return err
+}
@@ -0,0 +1,357 @@
+package subjects
+
+import (
+	"net/http"
+
+	models "go-api/src/models/subjects"
+	service "go-api/src/services/subjects"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"go.uber.org/fx"
+	"go.uber.org/zap"
+)
+
+// SubjectHandler defines the interface for study subject API handlers
+type SubjectHandler interface {
+	CreateSubject(e echo.Context) error
+	ListSubjects(e echo.Context) error
+	GetSubject(e echo.Context) error
+	UpdateSubject(e echo.Context) error
+	ArchiveSubject(e echo.Context) error
+	UnarchiveSubject(e echo.Context) error
+	CreateRelation(e echo.Context) error
+	ListRelations(e echo.Context) error
+	DeleteRelation(e echo.Context) error
+	GetSubjectTree(e echo.Context) error
+	GetStudyOrder(e echo.Context) error
+}
+
+// SubjectHandlerParams defines the dependencies for the subject handler
+type SubjectHandlerParams struct {
+	fx.In
+
+	Service service.SubjectService
+	Logger  *zap.Logger
+}
+
+type subjectHandler struct {
+	service service.SubjectService
+	logger  *zap.Logger
+}
+
+// NewSubjectHandler creates a new subject handler with injected dependencies
+func NewSubjectHandler(p SubjectHandlerParams) SubjectHandler {
+	return &subjectHandler{
+		service: p.Service,
+		logger:  p.Logger,
+	}
+}
+
+// CreateSubject handles the creation of a new study subject
+//
+//	@Summary		Create a subject
+//	@Description	Create a new study subject for the authenticated user
+//	@Tags			subjects
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			request	body		service.CreateSubjectRequest	true	"Subject data"
+//	@Success		201		{object}	models.Subject
+//	@Failure		400		{object}	map[string]string
+//	@Failure		409		{object}	map[string]string	"Subject name already in use"
+//	@Failure		500		{object}	map[string]string
+//	@Router			/subjects [post]
+func (h *subjectHandler) CreateSubject(e echo.Context) error {
+	var req service.CreateSubjectRequest
+	if err := e.Bind(&req); err != nil {
+		return e.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid request format"})
+	}
+
+	ctx := e.Request().Context()
+	subject, err := h.service.CreateSubject(ctx, req)
+	if err != nil {
+		return h.handleError(e, err, "Failed to create subject")
+	}
+	return e.JSON(http.StatusCreated, subject)
+}
+
+// ListSubjects handles listing the user's study subjects
+//
+//	@Summary		List subjects
+//	@Description	List the study subjects of the authenticated user ordered by name
+//	@Tags			subjects
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			include_archived	query		bool	false	"Include archived subjects"
+//	@Success		200					{object}	[]models.Subject
+//	@Failure		400					{object}	map[string]string
+//	@Failure		500					{object}	map[string]string
+//	@Router			/subjects [get]
+func (h *subjectHandler) ListSubjects(e echo.Context) error {
+	var req service.ListSubjectsRequest
+	if err := e.Bind(&req); err != nil {
+		return e.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid request format"})
+	}
+
+	ctx := e.Request().Context()
+	subjects, err := h.service.ListSubjects(ctx, req)
+	if err != nil {
+		return h.handleError(e, err, "Failed to list subjects")
+	}
+	return e.JSON(http.StatusOK, subjects)
+}
+
+// GetSubject handles retrieving a study subject
+//
+//	@Summary		Get subject
+//	@Description	Get one of the user's study subjects
+//	@Tags			subjects
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id	path		string	true	"Subject ID"
+//	@Success		200	{object}	models.Subject
+//	@Failure		400	{object}	map[string]string
+//	@Failure		404	{object}	map[string]string	"Subject not found"
+//	@Failure		500	{object}	map[string]string
+//	@Router			/subjects/{id} [get]
+func (h *subjectHandler) GetSubject(e echo.Context) error {
+	subjectID, err := uuid.Parse(e.Param("id"))
+	if err != nil {
+		return e.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid subject id"})
+	}
+
+	ctx := e.Request().Context()
+	subject, err := h.service.GetSubject(ctx, subjectID)
+	if err != nil {
+		return h.handleError(e, err, "Failed to get subject")
+	}
+	return e.JSON(http.StatusOK, subject)
+}
+
+// UpdateSubject handles editing a study subject
+//
+//	@Summary		Update subject
+//	@Description	Update the name, color and icon of one of the user's study subjects
+//	@Tags			subjects
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id		path		string							true	"Subject ID"
+//	@Param			request	body		service.UpdateSubjectRequest	true	"Fields to update"
+//	@Success		200		{object}	models.Subject
+//	@Failure		400		{object}	map[string]string
+//	@Failure		404		{object}	map[string]string	"Subject not found"
+//	@Failure		409		{object}	map[string]string	"Subject name already in use"
+//	@Failure		500		{object}	map[string]string
+//	@Router			/subjects/{id} [patch]
+func (h *subjectHandler) UpdateSubject(e echo.Context) error {
+	subjectID, err := uuid.Parse(e.Param("id"))
+	if err != nil {
+		return e.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid subject id"})
+	}
+	var req service.UpdateSubjectRequest
+	if err := e.Bind(&req); err != nil {
+		return e.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid request format"})
+	}
+
+	ctx := e.Request().Context()
+	subject, err := h.service.UpdateSubject(ctx, subjectID, req)
+	if err != nil {
+		return h.handleError(e, err, "Failed to update subject")
+	}
+	return e.JSON(http.StatusOK, subject)
+}
+
+// ArchiveSubject handles archiving a study subject
+//
+//	@Summary		Archive subject
+//	@Description	Archive one of the user's study subjects, hiding it from the default listing
+//	@Tags			subjects
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id	path		string	true	"Subject ID"
+//	@Success		200	{object}	models.Subject
+//	@Failure		400	{object}	map[string]string
+//	@Failure		404	{object}	map[string]string	"Subject not found"
+//	@Failure		500	{object}	map[string]string
+//	@Router			/subjects/{id}/archive [post]
+func (h *subjectHandler) ArchiveSubject(e echo.Context) error {
+	subjectID, err := uuid.Parse(e.Param("id"))
+	if err != nil {
+		return e.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid subject id"})
+	}
+
+	ctx := e.Request().Context()
+	subject, err := h.service.ArchiveSubject(ctx, subjectID)
+	if err != nil {
+		return h.handleError(e, err, "Failed to archive subject")
+	}
+	return e.JSON(http.StatusOK, subject)
+}
+
+// UnarchiveSubject handles restoring an archived study subject
+//
+//	@Summary		Unarchive subject
+//	@Description	Restore one of the user's archived study subjects
+//	@Tags			subjects
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id	path		string	true	"Subject ID"
+//	@Success		200	{object}	models.Subject
+//	@Failure		400	{object}	map[string]string
+//	@Failure		404	{object}	map[string]string	"Subject not found"
+//	@Failure		409	{object}	map[string]string	"Subject name already in use"
+//	@Failure		500	{object}	map[string]string
+//	@Router			/subjects/{id}/unarchive [post]
+func (h *subjectHandler) UnarchiveSubject(e echo.Context) error {
+	subjectID, err := uuid.Parse(e.Param("id"))
+	if err != nil {
+		return e.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid subject id"})
+	}
+
+	ctx := e.Request().Context()
+	subject, err := h.service.UnarchiveSubject(ctx, subjectID)
+	if err != nil {
+		return h.handleError(e, err, "Failed to unarchive subject")
+	}
+	return e.JSON(http.StatusOK, subject)
+}
+
+// CreateRelation handles linking two study subjects
+//
+//	@Summary		Create subject relation
+//	@Description	Make a subject the parent or a prerequisite of another subject
+//	@Tags			subjects
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			request	body		service.CreateRelationRequest	true	"Relation data"
+//	@Success		201		{object}	models.SubjectRelation
+//	@Failure		400		{object}	map[string]string
+//	@Failure		404		{object}	map[string]string	"Subject not found"
+//	@Failure		409		{object}	map[string]string	"Relation already exists or subject already has a parent"
+//	@Failure		422		{object}	map[string]string	"Relation would create a cycle"
+//	@Failure		500		{object}	map[string]string
+//	@Router			/subjects/relations [post]
+func (h *subjectHandler) CreateRelation(e echo.Context) error {
+	var req service.CreateRelationRequest
+	if err := e.Bind(&req); err != nil {
+		return e.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid request format"})
+	}
+
+	ctx := e.Request().Context()
+	relation, err := h.service.CreateRelation(ctx, req)
+	if err != nil {
+		return h.handleError(e, err, "Failed to create subject relation")
+	}
+	return e.JSON(http.StatusCreated, relation)
+}
+
+// ListRelations handles listing the relations between the user's subjects
+//
+//	@Summary		List subject relations
+//	@Description	List the parent and prerequisite relations between the user's subjects
+//	@Tags			subjects
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Success		200	{object}	[]models.SubjectRelation
+//	@Failure		500	{object}	map[string]string
+//	@Router			/subjects/relations [get]
+func (h *subjectHandler) ListRelations(e echo.Context) error {
+	ctx := e.Request().Context()
+	relations, err := h.service.ListRelations(ctx)
+	if err != nil {
+		return h.handleError(e, err, "Failed to list subject relations")
+	}
+	return e.JSON(http.StatusOK, relations)
+}
+
+// DeleteRelation handles unlinking two study subjects
+//
+//	@Summary		Delete subject relation
+//	@Description	Remove a relation between two of the user's subjects
+//	@Tags			subjects
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id	path	string	true	"Relation ID"
+//	@Success		204
+//	@Failure		400	{object}	map[string]string
+//	@Failure		404	{object}	map[string]string	"Relation not found"
+//	@Failure		500	{object}	map[string]string
+//	@Router			/subjects/relations/{id} [delete]
+func (h *subjectHandler) DeleteRelation(e echo.Context) error {
+	relationID, err := uuid.Parse(e.Param("id"))
+	if err != nil {
+		return e.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid relation id"})
+	}
+
+	ctx := e.Request().Context()
+	if err := h.service.DeleteRelation(ctx, relationID); err != nil {
+		return h.handleError(e, err, "Failed to delete subject relation")
+	}
+	return e.NoContent(http.StatusNoContent)
+}
+
+// GetSubjectTree handles retrieving the subject hierarchy
+//
+//	@Summary		Get subject tree
+//	@Description	Get the user's active subjects nested by their parent relations
+//	@Tags			subjects
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Success		200	{object}	[]models.SubjectNode
+//	@Failure		500	{object}	map[string]string
+//	@Router			/subjects/tree [get]
+func (h *subjectHandler) GetSubjectTree(e echo.Context) error {
+	ctx := e.Request().Context()
+	tree, err := h.service.GetSubjectTree(ctx)
+	if err != nil {
+		return h.handleError(e, err, "Failed to get subject tree")
+	}
+	return e.JSON(http.StatusOK, tree)
+}
+
+// GetStudyOrder handles retrieving the order in which subjects should be studied
+//
+//	@Summary		Get study order
+//	@Description	Get the user's active subjects sorted so that every subject comes after its prerequisites
+//	@Tags			subjects
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Success		200	{object}	[]models.Subject
+//	@Failure		500	{object}	map[string]string
+//	@Router			/subjects/study-order [get]
+func (h *subjectHandler) GetStudyOrder(e echo.Context) error {
+	ctx := e.Request().Context()
+	subjects, err := h.service.GetStudyOrder(ctx)
+	if err != nil {
+		return h.handleError(e, err, "Failed to get study order")
+	}
+	return e.JSON(http.StatusOK, subjects)
+}
+
+// handleError maps the subject errors to their HTTP responses
+func (h *subjectHandler) handleError(e echo.Context, err error, message string) error {
+	switch err {
+	case models.ErrInvalidSubject:
+		return e.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid subject"})
+	case models.ErrSubjectNotFound:
+		return e.JSON(http.StatusNotFound, map[string]string{"error": "Subject not found"})
+	case models.ErrSubjectNameTaken:
+		return e.JSON(http.StatusConflict, map[string]string{"error": "Subject name already in use"})
+	case models.ErrInvalidRelation:
+		return e.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid subject relation"})
+	case models.ErrRelationNotFound:
+		return e.JSON(http.StatusNotFound, map[string]string{"error": "Subject relation not found"})
+	case models.ErrRelationExists:
+		return e.JSON(http.StatusConflict, map[string]string{"error": "Subject relation already exists"})
+	case models.ErrSubjectHasParent:
+		return e.JSON(http.StatusConflict, map[string]string{"error": "Subject already has a parent"})
+	case models.ErrRelationCycle:
+		return e.JSON(http.StatusUnprocessableEntity, map[string]string{"error": "Subject relation would create a cycle"})
+	default:
+		h.logger.Error(message, zap.Error(err))
+		return e.JSON(http.StatusInternalServerError, map[string]string{"error": message})
+	}
+}
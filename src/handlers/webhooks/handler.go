@@ -0,0 +1,265 @@
+package webhooks
+
+import (
+	"net/http"
+
+	models "go-api/src/models/webhooks"
+	service "go-api/src/services/webhooks"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"go.uber.org/fx"
+	"go.uber.org/zap"
+)
+
+// WebhookHandler defines the interface for webhook API handlers
+type WebhookHandler interface {
+	CreateWebhookEndpoint(e echo.Context) error
+	ListWebhookEndpoints(e echo.Context) error
+	GetWebhookEndpoint(e echo.Context) error
+	UpdateWebhookEndpoint(e echo.Context) error
+	DeleteWebhookEndpoint(e echo.Context) error
+	ListWebhookDeliveries(e echo.Context) error
+	RedeliverWebhook(e echo.Context) error
+}
+
+// WebhookHandlerParams defines the dependencies for the webhook handler
+type WebhookHandlerParams struct {
+	fx.In
+
+	Service service.WebhookService
+	Logger  *zap.Logger
+}
+
+type webhookHandler struct {
+	service service.WebhookService
+	logger  *zap.Logger
+}
+
+// NewWebhookHandler creates a new webhook handler with injected dependencies
+func NewWebhookHandler(p WebhookHandlerParams) WebhookHandler {
+	return &webhookHandler{
+		service: p.Service,
+		logger:  p.Logger,
+	}
+}
+
+// CreateWebhookEndpoint handles registering a webhook endpoint
+//
+//	@Summary		Create a webhook endpoint
+//	@Description	Register a URL that receives the events of the user's sessions. Payloads are signed with HMAC-SHA256 in the X-Webhook-Signature header as "t=<unix time>,v1=<hex signature>", the signed content is "<unix time>.<body>". The secret is only returned once.
+//	@Tags			webhooks
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			request	body		service.CreateEndpointRequest	true	"Webhook endpoint data"
+//	@Success		201		{object}	models.CreatedEndpoint
+//	@Failure		400		{object}	map[string]string
+//	@Failure		409		{object}	map[string]string	"Too many webhook endpoints"
+//	@Failure		500		{object}	map[string]string
+//	@Router			/webhooks [post]
+func (h *webhookHandler) CreateWebhookEndpoint(e echo.Context) error {
+	var req service.CreateEndpointRequest
+	if err := e.Bind(&req); err != nil {
+		return e.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid request format"})
+	}
+
+	ctx := e.Request().Context()
+	endpoint, err := h.service.CreateEndpoint(ctx, req)
+	if err != nil {
+		return h.handleError(e, err, "Failed to create webhook endpoint")
+	}
+	return e.JSON(http.StatusCreated, endpoint)
+}
+
+// ListWebhookEndpoints handles listing the user's webhook endpoints
+//
+//	@Summary		List webhook endpoints
+//	@Description	List the webhook endpoints registered by the user
+//	@Tags			webhooks
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Success		200	{object}	[]models.Endpoint
+//	@Failure		500	{object}	map[string]string
+//	@Router			/webhooks [get]
+func (h *webhookHandler) ListWebhookEndpoints(e echo.Context) error {
+	ctx := e.Request().Context()
+	endpoints, err := h.service.ListEndpoints(ctx)
+	if err != nil {
+		return h.handleError(e, err, "Failed to list webhook endpoints")
+	}
+	return e.JSON(http.StatusOK, endpoints)
+}
+
+// GetWebhookEndpoint handles getting a webhook endpoint
+//
+//	@Summary		Get a webhook endpoint
+//	@Description	Get one of the user's webhook endpoints
+//	@Tags			webhooks
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id	path		string	true	"Webhook endpoint ID"
+//	@Success		200	{object}	models.Endpoint
+//	@Failure		400	{object}	map[string]string
+//	@Failure		404	{object}	map[string]string	"Webhook endpoint not found"
+//	@Failure		500	{object}	map[string]string
+//	@Router			/webhooks/{id} [get]
+func (h *webhookHandler) GetWebhookEndpoint(e echo.Context) error {
+	endpointID, err := uuid.Parse(e.Param("id"))
+	if err != nil {
+		return e.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid webhook endpoint id"})
+	}
+
+	ctx := e.Request().Context()
+	endpoint, err := h.service.GetEndpoint(ctx, endpointID)
+	if err != nil {
+		return h.handleError(e, err, "Failed to get webhook endpoint")
+	}
+	return e.JSON(http.StatusOK, endpoint)
+}
+
+// UpdateWebhookEndpoint handles editing a webhook endpoint
+//
+//	@Summary		Update a webhook endpoint
+//	@Description	Update the URL, description and event types of a webhook endpoint, or pause and resume its deliveries
+//	@Tags			webhooks
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id		path		string							true	"Webhook endpoint ID"
+//	@Param			request	body		service.UpdateEndpointRequest	true	"Fields to update"
+//	@Success		200		{object}	models.Endpoint
+//	@Failure		400		{object}	map[string]string
+//	@Failure		404		{object}	map[string]string	"Webhook endpoint not found"
+//	@Failure		500		{object}	map[string]string
+//	@Router			/webhooks/{id} [patch]
+func (h *webhookHandler) UpdateWebhookEndpoint(e echo.Context) error {
+	endpointID, err := uuid.Parse(e.Param("id"))
+	if err != nil {
+		return e.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid webhook endpoint id"})
+	}
+	var req service.UpdateEndpointRequest
+	if err := e.Bind(&req); err != nil {
+		return e.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid request format"})
+	}
+
+	ctx := e.Request().Context()
+	endpoint, err := h.service.UpdateEndpoint(ctx, endpointID, req)
+	if err != nil {
+		return h.handleError(e, err, "Failed to update webhook endpoint")
+	}
+	return e.JSON(http.StatusOK, endpoint)
+}
+
+// DeleteWebhookEndpoint handles deleting a webhook endpoint
+//
+//	@Summary		Delete a webhook endpoint
+//	@Description	Delete a webhook endpoint along with its delivery log, pending deliveries are dropped
+//	@Tags			webhooks
+//	@Security		BearerAuth
+//	@Param			id	path	string	true	"Webhook endpoint ID"
+//	@Success		204
+//	@Failure		400	{object}	map[string]string
+//	@Failure		404	{object}	map[string]string	"Webhook endpoint not found"
+//	@Failure		500	{object}	map[string]string
+//	@Router			/webhooks/{id} [delete]
+func (h *webhookHandler) DeleteWebhookEndpoint(e echo.Context) error {
+	endpointID, err := uuid.Parse(e.Param("id"))
+	if err != nil {
+		return e.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid webhook endpoint id"})
+	}
+
+	ctx := e.Request().Context()
+	if err := h.service.DeleteEndpoint(ctx, endpointID); err != nil {
+		return h.handleError(e, err, "Failed to delete webhook endpoint")
+	}
+	return e.NoContent(http.StatusNoContent)
+}
+
+// ListWebhookDeliveries handles listing the delivery log of a webhook endpoint
+//
+//	@Summary		List webhook deliveries
+//	@Description	List the latest deliveries of a webhook endpoint, newest first, with the response code of their last attempt
+//	@Tags			webhooks
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id		path		string	true	"Webhook endpoint ID"
+//	@Param			limit	query		int		false	"Number of deliveries, 50 by default and at most 100"
+//	@Success		200		{object}	[]models.Delivery
+//	@Failure		400		{object}	map[string]string
+//	@Failure		404		{object}	map[string]string	"Webhook endpoint not found"
+//	@Failure		500		{object}	map[string]string
+//	@Router			/webhooks/{id}/deliveries [get]
+func (h *webhookHandler) ListWebhookDeliveries(e echo.Context) error {
+	endpointID, err := uuid.Parse(e.Param("id"))
+	if err != nil {
+		return e.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid webhook endpoint id"})
+	}
+	var req service.ListDeliveriesRequest
+	if err := e.Bind(&req); err != nil {
+		return e.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid request format"})
+	}
+
+	ctx := e.Request().Context()
+	deliveries, err := h.service.ListDeliveries(ctx, endpointID, req)
+	if err != nil {
+		return h.handleError(e, err, "Failed to list webhook deliveries")
+	}
+	return e.JSON(http.StatusOK, deliveries)
+}
+
+// RedeliverWebhook handles the manual redelivery of a webhook
+//
+//	@Summary		Redeliver a webhook
+//	@Description	Send the event of a finished delivery to the endpoint again. The redelivery is a new delivery with its own attempts, the event keeps its id.
+//	@Tags			webhooks
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id			path		string	true	"Webhook endpoint ID"
+//	@Param			deliveryId	path		string	true	"Webhook delivery ID"
+//	@Success		202			{object}	models.Delivery
+//	@Failure		400			{object}	map[string]string
+//	@Failure		404			{object}	map[string]string	"Webhook delivery not found"
+//	@Failure		409			{object}	map[string]string	"Webhook delivery is still pending"
+//	@Failure		500			{object}	map[string]string
+//	@Router			/webhooks/{id}/deliveries/{deliveryId}/redeliver [post]
+func (h *webhookHandler) RedeliverWebhook(e echo.Context) error {
+	endpointID, err := uuid.Parse(e.Param("id"))
+	if err != nil {
+		return e.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid webhook endpoint id"})
+	}
+	deliveryID, err := uuid.Parse(e.Param("deliveryId"))
+	if err != nil {
+		return e.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid webhook delivery id"})
+	}
+
+	ctx := e.Request().Context()
+	delivery, err := h.service.Redeliver(ctx, endpointID, deliveryID)
+	if err != nil {
+		return h.handleError(e, err, "Failed to redeliver webhook")
+	}
+	return e.JSON(http.StatusAccepted, delivery)
+}
+
+// handleError maps the webhook errors to their HTTP responses
+func (h *webhookHandler) handleError(e echo.Context, err error, message string) error {
+	switch err {
+	case models.ErrInvalidEndpoint:
+		return e.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid webhook endpoint"})
+	case models.ErrInvalidEventTypes:
+		return e.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid webhook event types"})
+	case models.ErrInvalidDeliveryLimit:
+		return e.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid limit"})
+	case models.ErrEndpointNotFound:
+		return e.JSON(http.StatusNotFound, map[string]string{"error": "Webhook endpoint not found"})
+	case models.ErrDeliveryNotFound:
+		return e.JSON(http.StatusNotFound, map[string]string{"error": "Webhook delivery not found"})
+	case models.ErrEndpointLimit:
+		return e.JSON(http.StatusConflict, map[string]string{"error": "Too many webhook endpoints"})
+	case models.ErrDeliveryPending:
+		return e.JSON(http.StatusConflict, map[string]string{"error": "Webhook delivery is still pending"})
+	default:
+		h.logger.Error(message, zap.Error(err))
+		return e.JSON(http.StatusInternalServerError, map[string]string{"error": message})
+	}
+}
@@ -1,6 +1,10 @@
 package auth
 
-import "github.com/google/uuid"
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
 
 type CreateSessionRequest struct {
 	Username string `json:"username" validate:"required"`
@@ -49,4 +53,7 @@ type UserInfo struct {
 	GivenName         string         `json:"given_name"`
 	FamilyName        string         `json:"family_name"`
 	ResourceAccess    ResourceAccess `json:"resource_access"`
+	// ExpiresAt is when the access token the user was authenticated with
+	// expires, zero when unknown
+	ExpiresAt time.Time `json:"-"`
 }
@@ -0,0 +1,10 @@
+package feeds
+
+import "errors"
+
+var (
+	ErrFeedTokenNotFound = errors.New("feed token not found")
+	ErrInvalidFeedToken  = errors.New("invalid feed token")
+	ErrFeedTokenLimit    = errors.New("too many feed tokens")
+	ErrFeedNotFound      = errors.New("feed not found")
+)
@@ -0,0 +1,41 @@
+package feeds
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// FeedToken grants read-only access to the calendar feed of its user
+type FeedToken struct {
+	ID         uuid.UUID  `json:"id"`
+	UserID     uuid.UUID  `json:"user_id"`
+	Name       string     `json:"name"`
+	CreatedAt  time.Time  `json:"created_at"`
+	LastUsedAt *time.Time `json:"last_used_at,omitempty"`
+	RevokedAt  *time.Time `json:"revoked_at,omitempty"`
+}
+
+// CreatedFeedToken is returned once, when the token is created. Only the
+// hash of the token is stored so it can't be retrieved afterwards.
+type CreatedFeedToken struct {
+	FeedToken
+	Token string `json:"token"`
+	URL   string `json:"url"`
+}
+
+// FeedState is what the feed version is derived from: the number of sessions
+// in the feed and the last time a session or subject was updated
+type FeedState struct {
+	SessionCount      int64
+	SessionsUpdatedAt *time.Time
+	SubjectsUpdatedAt *time.Time
+}
+
+// FeedVersion identifies the content of a user's calendar feed, it changes
+// whenever a session or subject shown in the feed changes
+type FeedVersion struct {
+	UserID       uuid.UUID
+	ETag         string
+	LastModified time.Time
+}
@@ -0,0 +1,9 @@
+package idempotency
+
+import "errors"
+
+var (
+	ErrInvalidKey = errors.New("invalid idempotency key")
+	ErrKeyInUse   = errors.New("idempotency key is used by a request in progress")
+	ErrKeyReused  = errors.New("idempotency key reused with a different request")
+)
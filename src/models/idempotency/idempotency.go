@@ -0,0 +1,26 @@
+package idempotency
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Response is the first response to a request sent with an idempotency key
+type Response struct {
+	StatusCode int
+	Header     http.Header
+	Body       []byte
+}
+
+// Record ties an idempotency key of a user to the request it was first sent
+// with, Response is nil while that request is in progress
+type Record struct {
+	UserID      uuid.UUID
+	Key         string
+	RequestHash string
+	Response    *Response
+	CreatedAt   time.Time
+	ExpiresAt   time.Time
+}
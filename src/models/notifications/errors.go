@@ -0,0 +1,7 @@
+package notifications
+
+import "errors"
+
+var (
+	ErrInvalidLastEventID = errors.New("invalid last event id")
+)
@@ -0,0 +1,34 @@
+package notifications
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// NotificationType is the change a notification reports, it's the event
+// name of the notification on the session stream
+type NotificationType string
+
+const (
+	NotificationTypeStarted  NotificationType = "session.started"
+	NotificationTypePaused   NotificationType = "session.paused"
+	NotificationTypeResumed  NotificationType = "session.resumed"
+	NotificationTypeFinished NotificationType = "session.finished"
+	NotificationTypeCanceled NotificationType = "session.canceled"
+	NotificationTypeUpdated  NotificationType = "session.updated"
+	NotificationTypeCreated  NotificationType = "session.created"
+	NotificationTypeDeleted  NotificationType = "session.deleted"
+	NotificationTypeRestored NotificationType = "session.restored"
+)
+
+// Notification tells the clients of a user that one of their sessions
+// changed, clients fetch the session to get its new state. IDs increase in
+// the order notifications of a user are published.
+type Notification struct {
+	ID        int64            `json:"id"`
+	UserID    uuid.UUID        `json:"-"`
+	SessionID uuid.UUID        `json:"session_id"`
+	Type      NotificationType `json:"type"`
+	CreatedAt time.Time        `json:"created_at"`
+}
@@ -0,0 +1,9 @@
+package stats
+
+import "errors"
+
+var (
+	ErrInvalidStatsFilter    = errors.New("invalid stats filter")
+	ErrInvalidTimezone       = errors.New("invalid timezone")
+	ErrInvalidStreakSettings = errors.New("invalid streak settings")
+)
@@ -0,0 +1,114 @@
+package stats
+
+import (
+	sessionmodels "go-api/src/models/studysession"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// HeatmapLevels is the number of intensity levels of the days with focused
+// time, days without focused time have level 0
+const HeatmapLevels = 4
+
+// SessionDayRollup is the focused time of a session within one local day
+type SessionDayRollup struct {
+	SessionID      uuid.UUID
+	UserID         uuid.UUID
+	Day            time.Time
+	FocusedSeconds int64
+}
+
+type HeatmapDay struct {
+	Date           string `json:"date"`
+	FocusedMinutes int64  `json:"focused_minutes"`
+	Level          int    `json:"level"`
+}
+
+// Heatmap has one entry per day of the year. Thresholds are the minimum
+// focused minutes of levels 2 to HeatmapLevels, they are the quantiles of
+// the user's own days with focused time.
+type Heatmap struct {
+	Year       int          `json:"year"`
+	Timezone   string       `json:"timezone"`
+	Thresholds []int64      `json:"thresholds"`
+	Days       []HeatmapDay `json:"days"`
+}
+
+// RollupSession splits the focused time of a session per local day
+func RollupSession(sessionID uuid.UUID, userID uuid.UUID, events []sessionmodels.SessionEvent, location *time.Location, now time.Time) []SessionDayRollup {
+	daily := DailyFocusedSeconds(sessionmodels.FocusedIntervals(events, now), location)
+	rollups := make([]SessionDayRollup, 0, len(daily))
+	for day, seconds := range daily {
+		rollups = append(rollups, SessionDayRollup{
+			SessionID:      sessionID,
+			UserID:         userID,
+			Day:            day,
+			FocusedSeconds: seconds,
+		})
+	}
+	if len(rollups) == 0 && len(events) > 0 {
+		// Keep track of sessions without focused time so they aren't rebuilt
+		rollups = append(rollups, SessionDayRollup{
+			SessionID: sessionID,
+			UserID:    userID,
+			Day:       LocalDate(events[0].EventTime.In(location)),
+		})
+	}
+	sort.Slice(rollups, func(i, j int) bool {
+		return rollups[i].Day.Before(rollups[j].Day)
+	})
+	return rollups
+}
+
+// BuildHeatmap lays out the daily focused seconds of a year, keyed by
+// LocalDate, and assigns each day its intensity level
+func BuildHeatmap(year int, timezone string, daily map[time.Time]int64) Heatmap {
+	heatmap := Heatmap{
+		Year:       year,
+		Timezone:   timezone,
+		Thresholds: []int64{},
+		Days:       []HeatmapDay{},
+	}
+
+	var activeMinutes []int64
+	start := time.Date(year, time.January, 1, 0, 0, 0, 0, time.UTC)
+	end := start.AddDate(1, 0, 0)
+	for day := start; day.Before(end); day = day.AddDate(0, 0, 1) {
+		minutes := daily[day] / 60
+		heatmap.Days = append(heatmap.Days, HeatmapDay{
+			Date:           day.Format(time.DateOnly),
+			FocusedMinutes: minutes,
+		})
+		if minutes > 0 {
+			activeMinutes = append(activeMinutes, minutes)
+		}
+	}
+	if len(activeMinutes) == 0 {
+		return heatmap
+	}
+
+	sort.Slice(activeMinutes, func(i, j int) bool { return activeMinutes[i] < activeMinutes[j] })
+	for level := 1; level < HeatmapLevels; level++ {
+		index := len(activeMinutes) * level / HeatmapLevels
+		heatmap.Thresholds = append(heatmap.Thresholds, activeMinutes[index])
+	}
+	for i := range heatmap.Days {
+		heatmap.Days[i].Level = heatmapLevel(heatmap.Days[i].FocusedMinutes, heatmap.Thresholds)
+	}
+	return heatmap
+}
+
+func heatmapLevel(minutes int64, thresholds []int64) int {
+	if minutes <= 0 {
+		return 0
+	}
+	level := 1
+	for _, threshold := range thresholds {
+		if minutes >= threshold {
+			level++
+		}
+	}
+	return level
+}
@@ -0,0 +1,58 @@
+package stats
+
+import (
+	sessionmodels "go-api/src/models/studysession"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRollupSession(t *testing.T) {
+	sessionID, userID := uuid.New(), uuid.New()
+	tokyo, err := time.LoadLocation("Asia/Tokyo")
+	assert.NoError(t, err)
+	// 23:00 to 01:00 in Tokyo
+	start := time.Date(2025, 3, 1, 14, 0, 0, 0, time.UTC)
+	events := []sessionmodels.SessionEvent{
+		{EventType: sessionmodels.EventTypeStart, EventTime: start},
+		{EventType: sessionmodels.EventTypeStop, EventTime: start.Add(2 * time.Hour)},
+	}
+
+	assert.Equal(t, []SessionDayRollup{
+		{SessionID: sessionID, UserID: userID, Day: time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC), FocusedSeconds: 3600},
+		{SessionID: sessionID, UserID: userID, Day: time.Date(2025, 3, 2, 0, 0, 0, 0, time.UTC), FocusedSeconds: 3600},
+	}, RollupSession(sessionID, userID, events, tokyo, start.Add(3*time.Hour)))
+
+	// Sessions without focused time still get a row
+	assert.Equal(t, []SessionDayRollup{
+		{SessionID: sessionID, UserID: userID, Day: time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)},
+	}, RollupSession(sessionID, userID, append(events[:1:1], sessionmodels.SessionEvent{EventType: sessionmodels.EventTypeStop, EventTime: start}), time.UTC, start))
+}
+
+func TestBuildHeatmap(t *testing.T) {
+	day := func(month time.Month, day int) time.Time {
+		return time.Date(2024, month, day, 0, 0, 0, 0, time.UTC)
+	}
+
+	heatmap := BuildHeatmap(2024, "UTC", map[time.Time]int64{
+		day(1, 1):   10 * 60,
+		day(1, 2):   20 * 60,
+		day(2, 29):  30 * 60,
+		day(12, 31): 40*60 + 59,
+	})
+
+	assert.Equal(t, 2024, heatmap.Year)
+	assert.Len(t, heatmap.Days, 366)
+	assert.Equal(t, []int64{20, 30, 40}, heatmap.Thresholds)
+	assert.Equal(t, HeatmapDay{Date: "2024-01-01", FocusedMinutes: 10, Level: 1}, heatmap.Days[0])
+	assert.Equal(t, HeatmapDay{Date: "2024-01-02", FocusedMinutes: 20, Level: 2}, heatmap.Days[1])
+	assert.Equal(t, HeatmapDay{Date: "2024-01-03"}, heatmap.Days[2])
+	assert.Equal(t, HeatmapDay{Date: "2024-02-29", FocusedMinutes: 30, Level: 3}, heatmap.Days[59])
+	assert.Equal(t, HeatmapDay{Date: "2024-12-31", FocusedMinutes: 40, Level: 4}, heatmap.Days[365])
+
+	empty := BuildHeatmap(2025, "UTC", map[time.Time]int64{})
+	assert.Len(t, empty.Days, 365)
+	assert.Empty(t, empty.Thresholds)
+}
@@ -0,0 +1,79 @@
+package stats
+
+import (
+	"time"
+)
+
+type Granularity string
+
+const (
+	GranularityDay   Granularity = "day"
+	GranularityWeek  Granularity = "week"
+	GranularityMonth Granularity = "month"
+)
+
+func (g Granularity) IsValid() bool {
+	switch g {
+	case GranularityDay, GranularityWeek, GranularityMonth:
+		return true
+	}
+	return false
+}
+
+// BucketStart returns the local midnight that starts the bucket containing t,
+// in the location of t. Weeks start on Monday.
+func (g Granularity) BucketStart(t time.Time) time.Time {
+	year, month, day := t.Date()
+	switch g {
+	case GranularityWeek:
+		daysSinceMonday := (int(t.Weekday()) + 6) % 7
+		return time.Date(year, month, day-daysSinceMonday, 0, 0, 0, 0, t.Location())
+	case GranularityMonth:
+		return time.Date(year, month, 1, 0, 0, 0, 0, t.Location())
+	default:
+		return time.Date(year, month, day, 0, 0, 0, 0, t.Location())
+	}
+}
+
+// NextBucket returns the start of the bucket following the one starting at
+// start. Calendar arithmetic keeps buckets aligned on local midnights across
+// daylight saving changes.
+func (g Granularity) NextBucket(start time.Time) time.Time {
+	switch g {
+	case GranularityWeek:
+		return start.AddDate(0, 0, 7)
+	case GranularityMonth:
+		return start.AddDate(0, 1, 0)
+	default:
+		return start.AddDate(0, 0, 1)
+	}
+}
+
+// PreviousBucket returns the start of the bucket preceding the one starting at start
+func (g Granularity) PreviousBucket(start time.Time) time.Time {
+	switch g {
+	case GranularityWeek:
+		return start.AddDate(0, 0, -7)
+	case GranularityMonth:
+		return start.AddDate(0, -1, 0)
+	default:
+		return start.AddDate(0, 0, -1)
+	}
+}
+
+// StatsBucket aggregates the completed sessions started within one period
+type StatsBucket struct {
+	Start                 time.Time `json:"start"`
+	FocusedSeconds        int64     `json:"focused_seconds"`
+	SessionCount          int       `json:"session_count"`
+	AverageSessionSeconds int64     `json:"average_session_seconds"`
+	LongestSessionSeconds int64     `json:"longest_session_seconds"`
+}
+
+// StudyStats holds one bucket per period of the requested range, periods
+// without sessions are included with zero values
+type StudyStats struct {
+	Granularity Granularity   `json:"granularity"`
+	Timezone    string        `json:"timezone"`
+	Buckets     []StatsBucket `json:"buckets"`
+}
@@ -0,0 +1,66 @@
+package stats
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBucketStart(t *testing.T) {
+	saoPaulo, err := time.LoadLocation("America/Sao_Paulo")
+	assert.NoError(t, err)
+	// Wednesday late in the evening, already Thursday in UTC
+	instant := time.Date(2025, 1, 15, 22, 30, 0, 0, saoPaulo)
+
+	tests := map[string]struct {
+		Granularity   Granularity
+		Time          time.Time
+		ExpectedStart time.Time
+	}{
+		"day in local time": {
+			Granularity:   GranularityDay,
+			Time:          instant,
+			ExpectedStart: time.Date(2025, 1, 15, 0, 0, 0, 0, saoPaulo),
+		},
+		"day in utc": {
+			Granularity:   GranularityDay,
+			Time:          instant.UTC(),
+			ExpectedStart: time.Date(2025, 1, 16, 0, 0, 0, 0, time.UTC),
+		},
+		"week starts on monday": {
+			Granularity:   GranularityWeek,
+			Time:          instant,
+			ExpectedStart: time.Date(2025, 1, 13, 0, 0, 0, 0, saoPaulo),
+		},
+		"sunday belongs to the previous week": {
+			Granularity:   GranularityWeek,
+			Time:          time.Date(2025, 1, 19, 12, 0, 0, 0, time.UTC),
+			ExpectedStart: time.Date(2025, 1, 13, 0, 0, 0, 0, time.UTC),
+		},
+		"month": {
+			Granularity:   GranularityMonth,
+			Time:          instant,
+			ExpectedStart: time.Date(2025, 1, 1, 0, 0, 0, 0, saoPaulo),
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.True(t, tc.ExpectedStart.Equal(tc.Granularity.BucketStart(tc.Time)))
+		})
+	}
+}
+
+func TestNextBucketAcrossDaylightSaving(t *testing.T) {
+	newYork, err := time.LoadLocation("America/New_York")
+	assert.NoError(t, err)
+
+	// Clocks moved forward on 2025-03-09, the day only lasted 23 hours
+	start := time.Date(2025, 3, 9, 0, 0, 0, 0, newYork)
+	next := GranularityDay.NextBucket(start)
+
+	assert.Equal(t, time.Date(2025, 3, 10, 0, 0, 0, 0, newYork), next)
+	assert.Equal(t, 23*time.Hour, next.Sub(start))
+	assert.Equal(t, start, GranularityDay.PreviousBucket(next))
+}
@@ -0,0 +1,139 @@
+package stats
+
+import (
+	sessionmodels "go-api/src/models/studysession"
+	"time"
+)
+
+const (
+	DefaultDailyGoalMinutes = 30
+	MaxDailyGoalMinutes     = 24 * 60
+)
+
+// StreakSettings are the per user preferences the streaks are computed with
+type StreakSettings struct {
+	DailyGoalMinutes int       `json:"daily_goal_minutes"`
+	Timezone         string    `json:"timezone"`
+	UpdatedAt        time.Time `json:"updated_at"`
+}
+
+// StreakRules defines how days are counted and how freezes are earned
+type StreakRules struct {
+	DailyGoalSeconds int64
+	// FreezeEarnDays is the number of goal days that earns a freeze
+	FreezeEarnDays int
+	// MaxFreezes is the number of freezes that can be banked
+	MaxFreezes int
+}
+
+// Streak is the state of the user's streak as of today. Days are counted in
+// the user's timezone, a day counts when its focused time reaches the daily
+// goal. A missed day spends a banked freeze instead of breaking the streak.
+type Streak struct {
+	DailyGoalMinutes    int    `json:"daily_goal_minutes"`
+	Timezone            string `json:"timezone"`
+	CurrentStreak       int    `json:"current_streak"`
+	LongestStreak       int    `json:"longest_streak"`
+	FreezesAvailable    int    `json:"freezes_available"`
+	FreezesUsed         int    `json:"freezes_used"`
+	TodayFocusedSeconds int64  `json:"today_focused_seconds"`
+	GoalMetToday        bool   `json:"goal_met_today"`
+}
+
+// StreakUpdate describes how a finished session changed the user's streak
+type StreakUpdate struct {
+	PreviousStreak   int  `json:"previous_streak"`
+	CurrentStreak    int  `json:"current_streak"`
+	LongestStreak    int  `json:"longest_streak"`
+	FreezesAvailable int  `json:"freezes_available"`
+	GoalReached      bool `json:"goal_reached"`
+	NewRecord        bool `json:"new_record"`
+	FreezeEarned     bool `json:"freeze_earned"`
+}
+
+// NewStreakUpdate compares the streak computed without and with a session
+func NewStreakUpdate(before Streak, after Streak) StreakUpdate {
+	return StreakUpdate{
+		PreviousStreak:   before.CurrentStreak,
+		CurrentStreak:    after.CurrentStreak,
+		LongestStreak:    after.LongestStreak,
+		FreezesAvailable: after.FreezesAvailable,
+		GoalReached:      after.GoalMetToday && !before.GoalMetToday,
+		NewRecord:        after.LongestStreak > before.LongestStreak,
+		FreezeEarned:     after.FreezesAvailable > before.FreezesAvailable,
+	}
+}
+
+// LocalDate is the calendar date of t in its location, as a UTC midnight so
+// that dates from different timezones can be compared and used as map keys
+func LocalDate(t time.Time) time.Time {
+	year, month, day := t.Date()
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+// DailyFocusedSeconds sums the focused intervals per local date, intervals
+// spanning midnight are split between both days
+func DailyFocusedSeconds(intervals []sessionmodels.TimeInterval, location *time.Location) map[time.Time]int64 {
+	daily := map[time.Time]time.Duration{}
+	for _, interval := range intervals {
+		start := interval.Start.In(location)
+		end := interval.End.In(location)
+		for start.Before(end) {
+			nextMidnight := GranularityDay.NextBucket(GranularityDay.BucketStart(start))
+			periodEnd := end
+			if nextMidnight.Before(end) {
+				periodEnd = nextMidnight
+			}
+			daily[LocalDate(start)] += periodEnd.Sub(start)
+			start = periodEnd
+		}
+	}
+
+	seconds := make(map[time.Time]int64, len(daily))
+	for date, focused := range daily {
+		seconds[date] = int64(focused.Seconds())
+	}
+	return seconds
+}
+
+// ComputeStreak walks the days from the first study day up to today. Today
+// only extends the streak once its goal is met, until then the streak is
+// still the one reached yesterday.
+func ComputeStreak(daily map[time.Time]int64, today time.Time, rules StreakRules) Streak {
+	var streak Streak
+	today = LocalDate(today)
+	first := today
+	for date := range daily {
+		if date.Before(first) {
+			first = date
+		}
+	}
+
+	goalDays := 0
+	for date := first; !date.After(today); date = date.AddDate(0, 0, 1) {
+		goalMet := daily[date] >= rules.DailyGoalSeconds && daily[date] > 0
+		switch {
+		case goalMet:
+			streak.CurrentStreak++
+			if streak.CurrentStreak > streak.LongestStreak {
+				streak.LongestStreak = streak.CurrentStreak
+			}
+			goalDays++
+			if rules.FreezeEarnDays > 0 && goalDays%rules.FreezeEarnDays == 0 && streak.FreezesAvailable < rules.MaxFreezes {
+				streak.FreezesAvailable++
+			}
+		case date.Equal(today):
+			// The day is not over yet
+		case streak.CurrentStreak > 0 && streak.FreezesAvailable > 0:
+			streak.FreezesAvailable--
+			streak.FreezesUsed++
+		default:
+			streak.CurrentStreak = 0
+			goalDays = 0
+		}
+	}
+
+	streak.TodayFocusedSeconds = daily[today]
+	streak.GoalMetToday = daily[today] > 0 && daily[today] >= rules.DailyGoalSeconds
+	return streak
+}
@@ -0,0 +1,121 @@
+package stats
+
+import (
+	sessionmodels "go-api/src/models/studysession"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDailyFocusedSeconds(t *testing.T) {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	assert.NoError(t, err)
+
+	// 23:30 to 00:45 in Berlin, the session spans midnight
+	intervals := []sessionmodels.TimeInterval{
+		{
+			Start: time.Date(2025, 1, 1, 22, 30, 0, 0, time.UTC),
+			End:   time.Date(2025, 1, 1, 23, 45, 0, 0, time.UTC),
+		},
+	}
+
+	assert.Equal(t, map[time.Time]int64{
+		time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC): 30 * 60,
+		time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC): 45 * 60,
+	}, DailyFocusedSeconds(intervals, berlin))
+
+	assert.Equal(t, map[time.Time]int64{
+		time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC): 75 * 60,
+	}, DailyFocusedSeconds(intervals, time.UTC))
+}
+
+func TestComputeStreak(t *testing.T) {
+	today := time.Date(2025, 1, 10, 18, 0, 0, 0, time.UTC)
+	day := func(offset int) time.Time {
+		return time.Date(2025, 1, 10+offset, 0, 0, 0, 0, time.UTC)
+	}
+	rules := StreakRules{DailyGoalSeconds: 1800, FreezeEarnDays: 3, MaxFreezes: 1}
+
+	tests := map[string]struct {
+		Daily          map[time.Time]int64
+		ExpectedStreak Streak
+	}{
+		"no sessions": {
+			Daily:          map[time.Time]int64{},
+			ExpectedStreak: Streak{},
+		},
+		"today not reached yet keeps yesterday's streak": {
+			Daily: map[time.Time]int64{day(-2): 1800, day(-1): 2000, day(0): 600},
+			ExpectedStreak: Streak{
+				CurrentStreak:       2,
+				LongestStreak:       2,
+				TodayFocusedSeconds: 600,
+			},
+		},
+		"today reached extends the streak": {
+			Daily: map[time.Time]int64{day(-1): 2000, day(0): 1800},
+			ExpectedStreak: Streak{
+				CurrentStreak:       2,
+				LongestStreak:       2,
+				TodayFocusedSeconds: 1800,
+				GoalMetToday:        true,
+			},
+		},
+		"days below the goal break the streak": {
+			Daily: map[time.Time]int64{day(-4): 1800, day(-3): 1800, day(-2): 100, day(-1): 1800},
+			ExpectedStreak: Streak{
+				CurrentStreak: 1,
+				LongestStreak: 2,
+			},
+		},
+		"a banked freeze covers a missed day": {
+			Daily: map[time.Time]int64{day(-5): 1800, day(-4): 1800, day(-3): 1800, day(-1): 1800},
+			ExpectedStreak: Streak{
+				CurrentStreak: 4,
+				LongestStreak: 4,
+				FreezesUsed:   1,
+			},
+		},
+		"freezes run out": {
+			Daily: map[time.Time]int64{day(-6): 1800, day(-5): 1800, day(-4): 1800, day(-1): 1800},
+			ExpectedStreak: Streak{
+				CurrentStreak: 1,
+				LongestStreak: 3,
+				FreezesUsed:   1,
+			},
+		},
+		"freezes are capped": {
+			Daily: map[time.Time]int64{
+				day(-6): 1800, day(-5): 1800, day(-4): 1800,
+				day(-3): 1800, day(-2): 1800, day(-1): 1800,
+			},
+			ExpectedStreak: Streak{
+				CurrentStreak:    6,
+				LongestStreak:    6,
+				FreezesAvailable: 1,
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.ExpectedStreak, ComputeStreak(tc.Daily, today, rules))
+		})
+	}
+}
+
+func TestNewStreakUpdate(t *testing.T) {
+	before := Streak{CurrentStreak: 4, LongestStreak: 4}
+	after := Streak{CurrentStreak: 5, LongestStreak: 5, FreezesAvailable: 1, GoalMetToday: true}
+
+	assert.Equal(t, StreakUpdate{
+		PreviousStreak:   4,
+		CurrentStreak:    5,
+		LongestStreak:    5,
+		FreezesAvailable: 1,
+		GoalReached:      true,
+		NewRecord:        true,
+		FreezeEarned:     true,
+	}, NewStreakUpdate(before, after))
+}
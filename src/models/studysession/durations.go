@@ -0,0 +1,109 @@
+package studysession
+
+import (
+	"sort"
+	"time"
+)
+
+// TimeInterval is the half-open period [Start, End)
+type TimeInterval struct {
+	Start time.Time
+	End   time.Time
+}
+
+// ComputeDurations folds the session events into wall, focused and paused
+// time. Sessions without a stop event are measured up to now. Events that
+// don't make sense in the current state (e.g. a resume while running) are
+// ignored so a single bad event doesn't corrupt the totals.
+func ComputeDurations(events []SessionEvent, now time.Time) SessionDurations {
+	durations, _ := foldEvents(events, now)
+	return durations
+}
+
+// FocusedIntervals returns the periods in which the session timer was
+// running, following the same rules as ComputeDurations
+func FocusedIntervals(events []SessionEvent, now time.Time) []TimeInterval {
+	_, intervals := foldEvents(events, now)
+	return intervals
+}
+
+func foldEvents(events []SessionEvent, now time.Time) (SessionDurations, []TimeInterval) {
+	sorted := make([]SessionEvent, len(events))
+	copy(sorted, events)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].EventTime.Before(sorted[j].EventTime)
+	})
+
+	var (
+		durations SessionDurations
+		intervals []TimeInterval
+		startedAt time.Time
+		markedAt  time.Time
+		started   bool
+		paused    bool
+		stopped   bool
+		focused   time.Duration
+		pausedFor time.Duration
+	)
+	endedAt := now
+
+	for _, event := range sorted {
+		if stopped {
+			break
+		}
+		switch event.EventType {
+		case EventTypeStart:
+			if !started {
+				started = true
+				startedAt = event.EventTime
+				markedAt = event.EventTime
+			}
+		case EventTypePause:
+			if started && !paused {
+				focused += event.EventTime.Sub(markedAt)
+				intervals = appendInterval(intervals, markedAt, event.EventTime)
+				markedAt = event.EventTime
+				paused = true
+				durations.PauseCount++
+			}
+		case EventTypeResume:
+			if started && paused {
+				pausedFor += event.EventTime.Sub(markedAt)
+				markedAt = event.EventTime
+				paused = false
+			}
+		case EventTypeStop:
+			if started {
+				endedAt = event.EventTime
+				stopped = true
+			}
+		}
+	}
+	if !started {
+		return durations, intervals
+	}
+
+	// Close the interval that is still open at the end of the session
+	if endedAt.After(markedAt) {
+		if paused {
+			pausedFor += endedAt.Sub(markedAt)
+		} else {
+			focused += endedAt.Sub(markedAt)
+			intervals = appendInterval(intervals, markedAt, endedAt)
+		}
+	}
+	if endedAt.After(startedAt) {
+		durations.TotalSeconds = int64(endedAt.Sub(startedAt).Seconds())
+	}
+	durations.FocusedSeconds = int64(focused.Seconds())
+	durations.PausedSeconds = int64(pausedFor.Seconds())
+	return durations, intervals
+}
+
+// appendInterval skips empty intervals, e.g. a pause right after the start
+func appendInterval(intervals []TimeInterval, start time.Time, end time.Time) []TimeInterval {
+	if !end.After(start) {
+		return intervals
+	}
+	return append(intervals, TimeInterval{Start: start, End: end})
+}
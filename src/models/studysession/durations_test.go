@@ -0,0 +1,128 @@
+package studysession
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestComputeDurations(t *testing.T) {
+	start := time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)
+	at := func(minutes int) time.Time {
+		return start.Add(time.Duration(minutes) * time.Minute)
+	}
+
+	tests := map[string]struct {
+		Events            []SessionEvent
+		Now               time.Time
+		ExpectedDurations SessionDurations
+	}{
+		"no events": {
+			Now:               at(10),
+			ExpectedDurations: SessionDurations{},
+		},
+		"active session without pauses": {
+			Events: []SessionEvent{
+				{EventType: EventTypeStart, EventTime: at(0)},
+			},
+			Now: at(25),
+			ExpectedDurations: SessionDurations{
+				TotalSeconds:   25 * 60,
+				FocusedSeconds: 25 * 60,
+			},
+		},
+		"active session currently paused": {
+			Events: []SessionEvent{
+				{EventType: EventTypeStart, EventTime: at(0)},
+				{EventType: EventTypePause, EventTime: at(20)},
+			},
+			Now: at(30),
+			ExpectedDurations: SessionDurations{
+				TotalSeconds:   30 * 60,
+				FocusedSeconds: 20 * 60,
+				PausedSeconds:  10 * 60,
+				PauseCount:     1,
+			},
+		},
+		"finished session with pauses": {
+			Events: []SessionEvent{
+				{EventType: EventTypeStart, EventTime: at(0)},
+				{EventType: EventTypePause, EventTime: at(25)},
+				{EventType: EventTypeResume, EventTime: at(30)},
+				{EventType: EventTypePause, EventTime: at(55)},
+				{EventType: EventTypeResume, EventTime: at(60)},
+				{EventType: EventTypeStop, EventTime: at(90)},
+			},
+			Now: at(600),
+			ExpectedDurations: SessionDurations{
+				TotalSeconds:   90 * 60,
+				FocusedSeconds: 80 * 60,
+				PausedSeconds:  10 * 60,
+				PauseCount:     2,
+			},
+		},
+		"stopped while paused": {
+			Events: []SessionEvent{
+				{EventType: EventTypeStart, EventTime: at(0)},
+				{EventType: EventTypePause, EventTime: at(40)},
+				{EventType: EventTypeStop, EventTime: at(45)},
+			},
+			Now: at(600),
+			ExpectedDurations: SessionDurations{
+				TotalSeconds:   45 * 60,
+				FocusedSeconds: 40 * 60,
+				PausedSeconds:  5 * 60,
+				PauseCount:     1,
+			},
+		},
+		"unordered and repeated events": {
+			Events: []SessionEvent{
+				{EventType: EventTypeStop, EventTime: at(60)},
+				{EventType: EventTypePause, EventTime: at(10)},
+				{EventType: EventTypeStart, EventTime: at(0)},
+				{EventType: EventTypePause, EventTime: at(15)},
+				{EventType: EventTypeResume, EventTime: at(20)},
+				{EventType: EventTypeResume, EventTime: at(25)},
+			},
+			Now: at(600),
+			ExpectedDurations: SessionDurations{
+				TotalSeconds:   60 * 60,
+				FocusedSeconds: 50 * 60,
+				PausedSeconds:  10 * 60,
+				PauseCount:     1,
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			durations := ComputeDurations(tc.Events, tc.Now)
+			assert.Equal(t, tc.ExpectedDurations, durations)
+		})
+	}
+}
+
+func TestFocusedIntervals(t *testing.T) {
+	start := time.Date(2025, 1, 1, 23, 30, 0, 0, time.UTC)
+	at := func(minutes int) time.Time {
+		return start.Add(time.Duration(minutes) * time.Minute)
+	}
+
+	events := []SessionEvent{
+		{EventType: EventTypeStart, EventTime: at(0)},
+		{EventType: EventTypePause, EventTime: at(20)},
+		{EventType: EventTypeResume, EventTime: at(25)},
+		{EventType: EventTypeStop, EventTime: at(60)},
+	}
+
+	assert.Equal(t, []TimeInterval{
+		{Start: at(0), End: at(20)},
+		{Start: at(25), End: at(60)},
+	}, FocusedIntervals(events, at(90)))
+
+	// A running session is measured up to now
+	assert.Equal(t, []TimeInterval{
+		{Start: at(0), End: at(10)},
+	}, FocusedIntervals(events[:1], at(10)))
+}
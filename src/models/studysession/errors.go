@@ -3,6 +3,24 @@ package studysession
 import "errors"
 
 var (
-	ErrActiveSessionExists   = errors.New("active session already exists")
-	ErrActiveSessionNotFound = errors.New("session not found or not active")
+	ErrActiveSessionExists    = errors.New("active session already exists")
+	ErrActiveSessionNotFound  = errors.New("session not found or not active")
+	ErrSessionNotFound        = errors.New("session not found")
+	ErrSessionIsActive        = errors.New("session is active")
+	ErrInvalidFinishRequest   = errors.New("invalid finish request")
+	ErrInvalidSessionUpdate   = errors.New("invalid session update")
+	ErrSessionModified        = errors.New("session was modified")
+	ErrInvalidSessionSubjects = errors.New("invalid session subjects")
+	ErrSubjectNotFound        = errors.New("subject not found")
+	ErrInvalidHistoryFilter   = errors.New("invalid history filter")
+	ErrInvalidHistoryCursor   = errors.New("invalid history cursor")
+	ErrInvalidExportFormat    = errors.New("invalid export format")
+	ErrInvalidImportOptions   = errors.New("invalid import options")
+	ErrInvalidImportFile      = errors.New("invalid import file")
+	ErrImportTooLarge         = errors.New("import file too large")
+	ErrInvalidManualSession   = errors.New("invalid manual session")
+	ErrSessionOverlap         = errors.New("session overlaps another session")
+	ErrInvalidPomodoro        = errors.New("invalid pomodoro settings")
+	ErrInvalidStaleAction     = errors.New("invalid stale session action")
+	ErrInvalidSyncCursor      = errors.New("invalid sync cursor")
 )
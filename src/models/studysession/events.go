@@ -0,0 +1,142 @@
+package studysession
+
+import (
+	"fmt"
+	"sort"
+	"time"
+)
+
+// MaxClockSkew is how far ahead of the server clock an event may be
+// timestamped, to tolerate client clocks that run slightly fast
+const MaxClockSkew = time.Minute
+
+// TimerStatus is the position of a session in the event state machine
+type TimerStatus string
+
+const (
+	TimerStatusNotStarted TimerStatus = "not_started"
+	TimerStatusRunning    TimerStatus = "running"
+	TimerStatusPaused     TimerStatus = "paused"
+	TimerStatusStopped    TimerStatus = "stopped"
+)
+
+// Next returns the status reached by applying the event type, and false
+// when the transition is not allowed
+func (s TimerStatus) Next(eventType EventType) (TimerStatus, bool) {
+	switch {
+	case s == TimerStatusNotStarted && eventType == EventTypeStart:
+		return TimerStatusRunning, true
+	case s == TimerStatusRunning && eventType == EventTypePause:
+		return TimerStatusPaused, true
+	case s == TimerStatusPaused && eventType == EventTypeResume:
+		return TimerStatusRunning, true
+	case (s == TimerStatusRunning || s == TimerStatusPaused) && eventType == EventTypeStop:
+		return TimerStatusStopped, true
+	}
+	return s, false
+}
+
+// InvalidEventError reports an event that breaks the session state machine
+type InvalidEventError struct {
+	Event  SessionEvent
+	Reason string
+}
+
+func (e *InvalidEventError) Error() string {
+	return fmt.Sprintf("invalid %q event at %s: %s",
+		e.Event.EventType, e.Event.EventTime.Format(time.RFC3339), e.Reason)
+}
+
+// SortEvents orders the events by event time, keeping the relative order
+// of events that happened at the same time
+func SortEvents(events []SessionEvent) {
+	sort.SliceStable(events, func(i, j int) bool {
+		return events[i].EventTime.Before(events[j].EventTime)
+	})
+}
+
+// StatusAfter returns the status reached by the time ordered events of a
+// session. Transitions that are not allowed are skipped, so sessions stored
+// before the state machine was enforced can still receive new events.
+func StatusAfter(events []SessionEvent) TimerStatus {
+	status := TimerStatusNotStarted
+	for _, event := range events {
+		if next, ok := status.Next(event.EventType); ok {
+			status = next
+		}
+	}
+	return status
+}
+
+// ValidateNewEvents checks that the incoming events, sorted by time, can be
+// appended to the existing ones. Events can't happen before the last stored
+// event nor after now plus MaxClockSkew.
+func ValidateNewEvents(existing []SessionEvent, incoming []SessionEvent, now time.Time) error {
+	status := StatusAfter(existing)
+
+	var lastEventTime time.Time
+	if len(existing) > 0 {
+		lastEventTime = existing[len(existing)-1].EventTime
+	}
+	latestAllowed := now.Add(MaxClockSkew)
+
+	for _, event := range incoming {
+		switch {
+		case event.EventTime.IsZero():
+			return &InvalidEventError{Event: event, Reason: "event time is required"}
+		case event.EventTime.Before(lastEventTime):
+			return &InvalidEventError{Event: event, Reason: "happens before the last session event"}
+		case event.EventTime.After(latestAllowed):
+			return &InvalidEventError{Event: event, Reason: "happens in the future"}
+		}
+
+		next, ok := status.Next(event.EventType)
+		if !ok {
+			return &InvalidEventError{
+				Event:  event,
+				Reason: fmt.Sprintf("not allowed while session is %s", status),
+			}
+		}
+		status = next
+		lastEventTime = event.EventTime
+	}
+	return nil
+}
+
+// ValidateSyncedEvents checks the incoming events, sorted by time, replayed by
+// a client that may have recorded them offline. Events at or after the last
+// stored event must follow the state machine as in ValidateNewEvents. Earlier
+// events were recorded by another device while this one was offline: they are
+// merged into the timeline by event time and, like any stored event, skipped
+// when folding if the transition doesn't fit the merged timeline.
+func ValidateSyncedEvents(existing []SessionEvent, incoming []SessionEvent, now time.Time) error {
+	var lastEventTime, startedAt time.Time
+	if len(existing) > 0 {
+		lastEventTime = existing[len(existing)-1].EventTime
+	}
+	for _, event := range existing {
+		if event.EventType == EventTypeStart {
+			startedAt = event.EventTime
+			break
+		}
+	}
+
+	merged := make([]SessionEvent, len(existing), len(existing)+len(incoming))
+	copy(merged, existing)
+	var current []SessionEvent
+	for _, event := range incoming {
+		if event.EventTime.IsZero() || !event.EventTime.Before(lastEventTime) {
+			current = append(current, event)
+			continue
+		}
+		switch {
+		case event.EventType != EventTypePause && event.EventType != EventTypeResume:
+			return &InvalidEventError{Event: event, Reason: "happens before the last session event"}
+		case event.EventTime.Before(startedAt):
+			return &InvalidEventError{Event: event, Reason: "happens before the session start"}
+		}
+		merged = append(merged, event)
+	}
+	SortEvents(merged)
+	return ValidateNewEvents(merged, current, now)
+}
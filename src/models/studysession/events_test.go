@@ -0,0 +1,160 @@
+package studysession
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateNewEvents(t *testing.T) {
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	at := func(minutes int) time.Time {
+		return now.Add(time.Duration(minutes) * time.Minute)
+	}
+	started := []SessionEvent{{EventType: EventTypeStart, EventTime: at(-60)}}
+
+	tests := map[string]struct {
+		Existing      []SessionEvent
+		Incoming      []SessionEvent
+		ExpectedError bool
+	}{
+		"pause and resume": {
+			Existing: started,
+			Incoming: []SessionEvent{
+				{EventType: EventTypePause, EventTime: at(-30)},
+				{EventType: EventTypeResume, EventTime: at(-20)},
+			},
+		},
+		"stop while paused": {
+			Existing: append(started, SessionEvent{EventType: EventTypePause, EventTime: at(-10)}),
+			Incoming: []SessionEvent{{EventType: EventTypeStop, EventTime: at(0)}},
+		},
+		"within clock skew": {
+			Existing: started,
+			Incoming: []SessionEvent{{EventType: EventTypePause, EventTime: now.Add(MaxClockSkew / 2)}},
+		},
+		"skips broken stored transitions": {
+			Existing: append(started,
+				SessionEvent{EventType: EventTypePause, EventTime: at(-50)},
+				SessionEvent{EventType: EventTypePause, EventTime: at(-40)},
+			),
+			Incoming: []SessionEvent{{EventType: EventTypeResume, EventTime: at(-30)}},
+		},
+		"fail - two pauses in a row": {
+			Existing: started,
+			Incoming: []SessionEvent{
+				{EventType: EventTypePause, EventTime: at(-30)},
+				{EventType: EventTypePause, EventTime: at(-20)},
+			},
+			ExpectedError: true,
+		},
+		"fail - resume without pause": {
+			Existing:      started,
+			Incoming:      []SessionEvent{{EventType: EventTypeResume, EventTime: at(-30)}},
+			ExpectedError: true,
+		},
+		"fail - event before start": {
+			Existing:      started,
+			Incoming:      []SessionEvent{{EventType: EventTypePause, EventTime: at(-90)}},
+			ExpectedError: true,
+		},
+		"fail - event in the future": {
+			Existing:      started,
+			Incoming:      []SessionEvent{{EventType: EventTypePause, EventTime: now.Add(2 * MaxClockSkew)}},
+			ExpectedError: true,
+		},
+		"fail - second start": {
+			Existing:      started,
+			Incoming:      []SessionEvent{{EventType: EventTypeStart, EventTime: at(-30)}},
+			ExpectedError: true,
+		},
+		"fail - missing event time": {
+			Existing:      started,
+			Incoming:      []SessionEvent{{EventType: EventTypePause}},
+			ExpectedError: true,
+		},
+		"fail - unknown event type": {
+			Existing:      started,
+			Incoming:      []SessionEvent{{EventType: "snooze", EventTime: at(-30)}},
+			ExpectedError: true,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := ValidateNewEvents(tc.Existing, tc.Incoming, now)
+			if tc.ExpectedError {
+				var invalidEventErr *InvalidEventError
+				assert.ErrorAs(t, err, &invalidEventErr)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestValidateSyncedEvents(t *testing.T) {
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	at := func(minutes int) time.Time {
+		return now.Add(time.Duration(minutes) * time.Minute)
+	}
+	existing := []SessionEvent{
+		{EventType: EventTypeStart, EventTime: at(-60)},
+		{EventType: EventTypePause, EventTime: at(-20)},
+		{EventType: EventTypeResume, EventTime: at(-10)},
+	}
+
+	tests := map[string]struct {
+		Incoming      []SessionEvent
+		ExpectedError bool
+	}{
+		"events after the last stored event": {
+			Incoming: []SessionEvent{{EventType: EventTypePause, EventTime: at(-5)}},
+		},
+		"late events from another device are merged": {
+			Incoming: []SessionEvent{
+				{EventType: EventTypePause, EventTime: at(-50)},
+				{EventType: EventTypeResume, EventTime: at(-40)},
+			},
+		},
+		"late events that don't fit the timeline": {
+			Incoming: []SessionEvent{{EventType: EventTypePause, EventTime: at(-15)}},
+		},
+		"late and current events": {
+			Incoming: []SessionEvent{
+				{EventType: EventTypePause, EventTime: at(-50)},
+				{EventType: EventTypeResume, EventTime: at(-40)},
+				{EventType: EventTypePause, EventTime: at(-5)},
+			},
+		},
+		"fail - current events break the state machine": {
+			Incoming:      []SessionEvent{{EventType: EventTypeResume, EventTime: at(-5)}},
+			ExpectedError: true,
+		},
+		"fail - late event before the session start": {
+			Incoming:      []SessionEvent{{EventType: EventTypePause, EventTime: at(-90)}},
+			ExpectedError: true,
+		},
+		"fail - late start": {
+			Incoming:      []SessionEvent{{EventType: EventTypeStart, EventTime: at(-30)}},
+			ExpectedError: true,
+		},
+		"fail - missing event time": {
+			Incoming:      []SessionEvent{{EventType: EventTypePause}},
+			ExpectedError: true,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := ValidateSyncedEvents(existing, tc.Incoming, now)
+			if tc.ExpectedError {
+				var invalidEventErr *InvalidEventError
+				assert.ErrorAs(t, err, &invalidEventErr)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,67 @@
+package studysession
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ImportMapping selects how the columns of an imported CSV are read
+type ImportMapping string
+
+const (
+	ImportMappingGeneric  ImportMapping = "generic"
+	ImportMappingToggl    ImportMapping = "toggl"
+	ImportMappingClockify ImportMapping = "clockify"
+)
+
+type ImportRowStatus string
+
+const (
+	// ImportRowStatusValid rows are imported, or would be in a dry run
+	ImportRowStatusValid     ImportRowStatus = "valid"
+	ImportRowStatusImported  ImportRowStatus = "imported"
+	ImportRowStatusDuplicate ImportRowStatus = "duplicate"
+	ImportRowStatusInvalid   ImportRowStatus = "invalid"
+)
+
+// ImportedSession is a completed session read from an import file
+type ImportedSession struct {
+	// ImportKey identifies the row content so that it's only imported once
+	ImportKey string
+	Title     string
+	Notes     string
+	StartedAt time.Time
+	StoppedAt time.Time
+	Subjects  []SessionSubject
+}
+
+// SessionInterval is the period covered by an existing session, StoppedAt
+// is nil while the session is active
+type SessionInterval struct {
+	SessionID uuid.UUID
+	StartedAt time.Time
+	StoppedAt *time.Time
+}
+
+// ImportRowResult is the outcome of a single CSV row, Row is the line number
+// in the file with the header being line 1
+type ImportRowResult struct {
+	Row       int             `json:"row"`
+	Status    ImportRowStatus `json:"status"`
+	Title     string          `json:"title,omitempty"`
+	StartedAt *time.Time      `json:"started_at,omitempty"`
+	StoppedAt *time.Time      `json:"stopped_at,omitempty"`
+	Errors    []string        `json:"errors,omitempty"`
+}
+
+// ImportReport summarizes an import. Sessions are only imported when no row
+// is invalid, Imported stays 0 for dry runs and rejected imports.
+type ImportReport struct {
+	DryRun     bool              `json:"dry_run"`
+	Valid      int               `json:"valid"`
+	Duplicates int               `json:"duplicates"`
+	Invalid    int               `json:"invalid"`
+	Imported   int               `json:"imported"`
+	Rows       []ImportRowResult `json:"rows"`
+}
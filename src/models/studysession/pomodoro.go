@@ -0,0 +1,175 @@
+package studysession
+
+import "time"
+
+const (
+	MaxPomodoroPhaseMinutes = 240
+	MaxPomodoroCycles       = 12
+)
+
+type PomodoroPhase string
+
+const (
+	PomodoroPhaseWork       PomodoroPhase = "work"
+	PomodoroPhaseShortBreak PomodoroPhase = "short_break"
+	PomodoroPhaseLongBreak  PomodoroPhase = "long_break"
+)
+
+// EventType is the event recorded when the phase starts
+func (p PomodoroPhase) EventType() EventType {
+	switch p {
+	case PomodoroPhaseShortBreak:
+		return EventTypeShortBreak
+	case PomodoroPhaseLongBreak:
+		return EventTypeLongBreak
+	default:
+		return EventTypeWorkPhase
+	}
+}
+
+// PomodoroSettings are chosen when a session starts. Cycles is the number of
+// work phases before a long break, the other work phases are followed by a
+// short break.
+type PomodoroSettings struct {
+	WorkMinutes       int `json:"work_minutes"`
+	ShortBreakMinutes int `json:"short_break_minutes"`
+	LongBreakMinutes  int `json:"long_break_minutes"`
+	Cycles            int `json:"cycles"`
+}
+
+func (s PomodoroSettings) IsValid() bool {
+	for _, minutes := range []int{s.WorkMinutes, s.ShortBreakMinutes, s.LongBreakMinutes} {
+		if minutes < 1 || minutes > MaxPomodoroPhaseMinutes {
+			return false
+		}
+	}
+	return s.Cycles >= 1 && s.Cycles <= MaxPomodoroCycles
+}
+
+// Pomodoro is the state of a pomodoro session. The phases only advance
+// while the session timer is running, pausing the session pauses the phase.
+// PhaseEndsAt is only set while the session is running.
+type Pomodoro struct {
+	Settings           PomodoroSettings `json:"settings"`
+	Phase              PomodoroPhase    `json:"phase"`
+	PhaseEndsAt        *time.Time       `json:"phase_ends_at,omitempty"`
+	RemainingSeconds   int64            `json:"remaining_seconds"`
+	CompletedPomodoros int              `json:"completed_pomodoros"`
+}
+
+// pomodoroPhase is a phase positioned on the running time of the session
+type pomodoroPhase struct {
+	Phase PomodoroPhase
+	Start time.Duration
+	End   time.Duration
+	// Completed is the number of work phases completed before this phase
+	Completed int
+}
+
+// phases calls fn with every phase starting before the running time,
+// until fn returns false
+func (s PomodoroSettings) phases(running time.Duration, fn func(pomodoroPhase) bool) {
+	if !s.IsValid() {
+		// Empty phases would never end
+		return
+	}
+	phase := pomodoroPhase{Phase: PomodoroPhaseWork}
+	for {
+		phase.End = phase.Start + s.length(phase.Phase)
+		if !fn(phase) || phase.End > running {
+			return
+		}
+		next := pomodoroPhase{Start: phase.End, Completed: phase.Completed}
+		switch {
+		case phase.Phase != PomodoroPhaseWork:
+			next.Phase = PomodoroPhaseWork
+		case (phase.Completed+1)%s.Cycles == 0:
+			next.Phase, next.Completed = PomodoroPhaseLongBreak, phase.Completed+1
+		default:
+			next.Phase, next.Completed = PomodoroPhaseShortBreak, phase.Completed+1
+		}
+		phase = next
+	}
+}
+
+func (s PomodoroSettings) length(phase PomodoroPhase) time.Duration {
+	switch phase {
+	case PomodoroPhaseShortBreak:
+		return time.Duration(s.ShortBreakMinutes) * time.Minute
+	case PomodoroPhaseLongBreak:
+		return time.Duration(s.LongBreakMinutes) * time.Minute
+	default:
+		return time.Duration(s.WorkMinutes) * time.Minute
+	}
+}
+
+// ComputePomodoro derives the pomodoro state from the session events,
+// sessions without a stop event are measured up to now
+func ComputePomodoro(settings PomodoroSettings, events []SessionEvent, now time.Time) Pomodoro {
+	intervals := FocusedIntervals(events, now)
+	var running time.Duration
+	for _, interval := range intervals {
+		running += interval.End.Sub(interval.Start)
+	}
+
+	var current pomodoroPhase
+	settings.phases(running, func(phase pomodoroPhase) bool {
+		current = phase
+		return true
+	})
+	pomodoro := Pomodoro{
+		Settings:           settings,
+		Phase:              current.Phase,
+		RemainingSeconds:   int64((current.End - running).Seconds()),
+		CompletedPomodoros: current.Completed,
+	}
+	if StatusAfter(events) == TimerStatusRunning {
+		phaseEndsAt := now.Add(current.End - running)
+		pomodoro.PhaseEndsAt = &phaseEndsAt
+	}
+	return pomodoro
+}
+
+// PomodoroPhaseEvents returns the phase transitions that happened while the
+// session timer was running, up to until. The first work phase starts with
+// the session and has no phase event.
+func PomodoroPhaseEvents(settings PomodoroSettings, events []SessionEvent, until time.Time) []SessionEvent {
+	var elapsed []SessionEvent
+	for _, event := range events {
+		if !event.EventTime.After(until) {
+			elapsed = append(elapsed, event)
+		}
+	}
+	intervals := FocusedIntervals(elapsed, until)
+	var running time.Duration
+	for _, interval := range intervals {
+		running += interval.End.Sub(interval.Start)
+	}
+
+	var phaseEvents []SessionEvent
+	settings.phases(running, func(phase pomodoroPhase) bool {
+		if phase.Start == 0 {
+			return true
+		}
+		phaseEvents = append(phaseEvents, SessionEvent{
+			EventType: phase.Phase.EventType(),
+			EventTime: wallTime(intervals, phase.Start),
+		})
+		return true
+	})
+	return phaseEvents
+}
+
+// wallTime maps an offset on the running time of a session back to the
+// time it happened at
+func wallTime(intervals []TimeInterval, running time.Duration) time.Time {
+	var elapsed time.Duration
+	for _, interval := range intervals {
+		length := interval.End.Sub(interval.Start)
+		if running <= elapsed+length {
+			return interval.Start.Add(running - elapsed)
+		}
+		elapsed += length
+	}
+	return intervals[len(intervals)-1].End
+}
@@ -0,0 +1,120 @@
+package studysession
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestComputePomodoro(t *testing.T) {
+	startedAt := time.Date(2025, 1, 1, 9, 0, 0, 0, time.UTC)
+	at := func(minutes int) time.Time {
+		return startedAt.Add(time.Duration(minutes) * time.Minute)
+	}
+	settings := PomodoroSettings{WorkMinutes: 25, ShortBreakMinutes: 5, LongBreakMinutes: 15, Cycles: 2}
+	started := []SessionEvent{{EventType: EventTypeStart, EventTime: startedAt}}
+	endsAt := func(minutes int) *time.Time {
+		endsAt := at(minutes)
+		return &endsAt
+	}
+
+	tests := map[string]struct {
+		Events           []SessionEvent
+		Now              time.Time
+		ExpectedPomodoro Pomodoro
+	}{
+		"first work phase": {
+			Events: started,
+			Now:    at(10),
+			ExpectedPomodoro: Pomodoro{
+				Settings: settings, Phase: PomodoroPhaseWork, PhaseEndsAt: endsAt(25), RemainingSeconds: 15 * 60,
+			},
+		},
+		"short break after the first pomodoro": {
+			Events: started,
+			Now:    at(25),
+			ExpectedPomodoro: Pomodoro{
+				Settings: settings, Phase: PomodoroPhaseShortBreak, PhaseEndsAt: endsAt(30), RemainingSeconds: 5 * 60, CompletedPomodoros: 1,
+			},
+		},
+		"long break after the last cycle": {
+			Events: started,
+			Now:    at(60),
+			ExpectedPomodoro: Pomodoro{
+				Settings: settings, Phase: PomodoroPhaseLongBreak, PhaseEndsAt: endsAt(70), RemainingSeconds: 10 * 60, CompletedPomodoros: 2,
+			},
+		},
+		"cycle starts over after the long break": {
+			Events: started,
+			Now:    at(75),
+			ExpectedPomodoro: Pomodoro{
+				Settings: settings, Phase: PomodoroPhaseWork, PhaseEndsAt: endsAt(95), RemainingSeconds: 20 * 60, CompletedPomodoros: 2,
+			},
+		},
+		"paused session keeps its remaining time": {
+			Events: append(started,
+				SessionEvent{EventType: EventTypePause, EventTime: at(20)},
+			),
+			Now: at(120),
+			ExpectedPomodoro: Pomodoro{
+				Settings: settings, Phase: PomodoroPhaseWork, RemainingSeconds: 5 * 60,
+			},
+		},
+		"pauses push the phase end": {
+			Events: append(started,
+				SessionEvent{EventType: EventTypePause, EventTime: at(20)},
+				SessionEvent{EventType: EventTypeResume, EventTime: at(30)},
+			),
+			Now: at(32),
+			ExpectedPomodoro: Pomodoro{
+				Settings: settings, Phase: PomodoroPhaseWork, PhaseEndsAt: endsAt(35), RemainingSeconds: 3 * 60,
+			},
+		},
+		"phase events are ignored": {
+			Events: append(started,
+				SessionEvent{EventType: EventTypeShortBreak, EventTime: at(25)},
+				SessionEvent{EventType: EventTypeStop, EventTime: at(27)},
+			),
+			Now: at(120),
+			ExpectedPomodoro: Pomodoro{
+				Settings: settings, Phase: PomodoroPhaseShortBreak, RemainingSeconds: 3 * 60, CompletedPomodoros: 1,
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.ExpectedPomodoro, ComputePomodoro(settings, tc.Events, tc.Now))
+		})
+	}
+}
+
+func TestPomodoroPhaseEvents(t *testing.T) {
+	startedAt := time.Date(2025, 1, 1, 9, 0, 0, 0, time.UTC)
+	at := func(minutes int) time.Time {
+		return startedAt.Add(time.Duration(minutes) * time.Minute)
+	}
+	settings := PomodoroSettings{WorkMinutes: 25, ShortBreakMinutes: 5, LongBreakMinutes: 15, Cycles: 2}
+	events := []SessionEvent{
+		{EventType: EventTypeStart, EventTime: startedAt},
+		{EventType: EventTypePause, EventTime: at(10)},
+		{EventType: EventTypeResume, EventTime: at(20)},
+		{EventType: EventTypeStop, EventTime: at(70)},
+	}
+
+	assert.Empty(t, PomodoroPhaseEvents(settings, events, at(30)))
+	assert.Equal(t, []SessionEvent{
+		{EventType: EventTypeShortBreak, EventTime: at(35)},
+		{EventType: EventTypeWorkPhase, EventTime: at(40)},
+		{EventType: EventTypeLongBreak, EventTime: at(65)},
+	}, PomodoroPhaseEvents(settings, events, at(120)))
+}
+
+func TestPomodoroSettingsIsValid(t *testing.T) {
+	assert.True(t, PomodoroSettings{WorkMinutes: 25, ShortBreakMinutes: 5, LongBreakMinutes: 15, Cycles: 4}.IsValid())
+	assert.False(t, PomodoroSettings{WorkMinutes: 0, ShortBreakMinutes: 5, LongBreakMinutes: 15, Cycles: 4}.IsValid())
+	assert.False(t, PomodoroSettings{WorkMinutes: 25, ShortBreakMinutes: 5, LongBreakMinutes: 15}.IsValid())
+	assert.False(t, PomodoroSettings{WorkMinutes: 25, ShortBreakMinutes: 5, LongBreakMinutes: MaxPomodoroPhaseMinutes + 1, Cycles: 4}.IsValid())
+	assert.Empty(t, PomodoroPhaseEvents(PomodoroSettings{}, []SessionEvent{{EventType: EventTypeStart}}, time.Now()))
+}
@@ -0,0 +1,50 @@
+package studysession
+
+// StaleSessionAction is what happens to an active session that received no
+// event for longer than the idle threshold
+type StaleSessionAction string
+
+const (
+	// StaleSessionActionFinish completes the session
+	StaleSessionActionFinish StaleSessionAction = "finish"
+	// StaleSessionActionPause pauses a running session, it stays active so
+	// the user can resume or finish it
+	StaleSessionActionPause StaleSessionAction = "pause"
+)
+
+func (a StaleSessionAction) IsValid() bool {
+	return a == StaleSessionActionFinish || a == StaleSessionActionPause
+}
+
+// LastActivity returns the last timer event of the time ordered events.
+// Phase events are recorded by the server so they are not user activity.
+func LastActivity(events []SessionEvent) (SessionEvent, bool) {
+	for i := len(events) - 1; i >= 0; i-- {
+		if !events[i].EventType.IsPhase() {
+			return events[i], true
+		}
+	}
+	return SessionEvent{}, false
+}
+
+// StaleSessionEvent returns the event closing an idle session. It happens at
+// the last activity, so the time the timer was left running is trimmed from
+// the session. It returns false when there is nothing to do, e.g. pausing a
+// session that is already paused.
+func StaleSessionEvent(events []SessionEvent, action StaleSessionAction) (SessionEvent, bool) {
+	lastEvent, ok := LastActivity(events)
+	if !ok {
+		return SessionEvent{}, false
+	}
+	status := StatusAfter(events)
+	event := SessionEvent{EventTime: lastEvent.EventTime}
+	switch {
+	case action == StaleSessionActionFinish && (status == TimerStatusRunning || status == TimerStatusPaused):
+		event.EventType = EventTypeStop
+	case action == StaleSessionActionPause && status == TimerStatusRunning:
+		event.EventType = EventTypePause
+	default:
+		return SessionEvent{}, false
+	}
+	return event, true
+}
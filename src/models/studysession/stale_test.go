@@ -0,0 +1,87 @@
+package studysession
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStaleSessionEvent(t *testing.T) {
+	start := time.Date(2025, 1, 1, 9, 0, 0, 0, time.UTC)
+	at := func(minutes int) time.Time {
+		return start.Add(time.Duration(minutes) * time.Minute)
+	}
+	running := []SessionEvent{
+		{EventType: EventTypeStart, EventTime: at(0)},
+		{EventType: EventTypePause, EventTime: at(30)},
+		{EventType: EventTypeResume, EventTime: at(40)},
+	}
+	paused := []SessionEvent{
+		{EventType: EventTypeStart, EventTime: at(0)},
+		{EventType: EventTypePause, EventTime: at(30)},
+	}
+	withPhase := []SessionEvent{
+		{EventType: EventTypeStart, EventTime: at(0)},
+		{EventType: EventTypeWorkPhase, EventTime: at(0)},
+		{EventType: EventTypePause, EventTime: at(20)},
+		{EventType: EventTypeResume, EventTime: at(25)},
+		{EventType: EventTypeShortBreak, EventTime: at(30)},
+	}
+
+	tests := map[string]struct {
+		Events        []SessionEvent
+		Action        StaleSessionAction
+		ExpectedEvent SessionEvent
+		ExpectedOK    bool
+	}{
+		"finish running session at the last resume": {
+			Events:        running,
+			Action:        StaleSessionActionFinish,
+			ExpectedEvent: SessionEvent{EventType: EventTypeStop, EventTime: at(40)},
+			ExpectedOK:    true,
+		},
+		"finish paused session at the pause": {
+			Events:        paused,
+			Action:        StaleSessionActionFinish,
+			ExpectedEvent: SessionEvent{EventType: EventTypeStop, EventTime: at(30)},
+			ExpectedOK:    true,
+		},
+		"pause running session at the last resume": {
+			Events:        running,
+			Action:        StaleSessionActionPause,
+			ExpectedEvent: SessionEvent{EventType: EventTypePause, EventTime: at(40)},
+			ExpectedOK:    true,
+		},
+		"phase events are not activity": {
+			Events:        withPhase,
+			Action:        StaleSessionActionFinish,
+			ExpectedEvent: SessionEvent{EventType: EventTypeStop, EventTime: at(25)},
+			ExpectedOK:    true,
+		},
+		"paused session is already paused": {
+			Events: paused,
+			Action: StaleSessionActionPause,
+		},
+		"session without events": {
+			Action: StaleSessionActionFinish,
+		},
+		"stopped session": {
+			Events: append(running, SessionEvent{EventType: EventTypeStop, EventTime: at(50)}),
+			Action: StaleSessionActionFinish,
+		},
+		"unknown action": {
+			Events: running,
+			Action: "delete",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			event, ok := StaleSessionEvent(tc.Events, tc.Action)
+
+			assert.Equal(t, tc.ExpectedOK, ok)
+			assert.Equal(t, tc.ExpectedEvent, event)
+		})
+	}
+}
@@ -13,11 +13,44 @@ const (
 	EventTypePause  EventType = "pause"
 	EventTypeResume EventType = "resume"
 	EventTypeStop   EventType = "stop"
+	// Phase events are recorded by the server for pomodoro sessions, each one
+	// marks the start of a phase and doesn't change the timer status
+	EventTypeWorkPhase  EventType = "work_phase"
+	EventTypeShortBreak EventType = "short_break"
+	EventTypeLongBreak  EventType = "long_break"
 )
 
+// IsPhase reports whether the event is a pomodoro phase transition
+func (t EventType) IsPhase() bool {
+	return t == EventTypeWorkPhase || t == EventTypeShortBreak || t == EventTypeLongBreak
+}
+
 type SessionEvent struct {
+	// ID is generated by offline capable clients so that replaying an event
+	// records it once, the server generates it when it's missing
+	ID        uuid.UUID `json:"id"`
 	EventType EventType `json:"event_type"`
 	EventTime time.Time `json:"event_time"`
+	// DeviceID identifies the client device that recorded the event
+	DeviceID string `json:"device_id,omitempty"`
+}
+
+// SyncCursor points after the last event of a session seen by a client.
+// Seq follows the order in which the server recorded the events, so events
+// arriving late with an earlier event time are still picked up.
+type SyncCursor struct {
+	SessionID uuid.UUID
+	Seq       int64
+}
+
+// SessionEventSync holds the events of a session recorded after a cursor
+type SessionEventSync struct {
+	Events []SessionEvent
+	// Cursor points after the last event recorded for the session
+	Cursor SyncCursor
+	// Added holds the events inserted by the request in time order, replays
+	// are left out
+	Added []SessionEvent
 }
 
 type SessionState string
@@ -25,13 +58,129 @@ type SessionState string
 const (
 	SessionStateActive    SessionState = "active"
 	SessionStateCompleted SessionState = "completed"
+	SessionStateAbandoned SessionState = "abandoned"
 )
 
+// FinishOptions defines when the stop event of a finished session happens
+type FinishOptions struct {
+	// FinishedAt is the stop time, the current time is used when it's zero
+	FinishedAt time.Time
+	// AtLastPause stops a paused session at the time it was paused
+	AtLastPause bool
+}
+
+// SessionDurations are derived from the session events. For sessions that
+// are not finished yet they are computed up to the current time.
+type SessionDurations struct {
+	TotalSeconds   int64 `json:"total_seconds"`
+	FocusedSeconds int64 `json:"focused_seconds"`
+	PausedSeconds  int64 `json:"paused_seconds"`
+	PauseCount     int   `json:"pause_count"`
+}
+
+// SessionSubject links a session to one of the user's subjects. Percentage is
+// the share of the session time spent on the subject, when it's nil the time
+// is split evenly between the session subjects.
+type SessionSubject struct {
+	SubjectID  uuid.UUID `json:"subject_id"`
+	Percentage *int      `json:"percentage,omitempty"`
+}
+
 type StudySession struct {
-	ID           uuid.UUID    `json:"id"`
-	UserID       uuid.UUID    `json:"user_id"`
-	Title        string       `json:"title"`
-	Notes        string       `json:"notes"`
-	Date         time.Time    `json:"date"`
-	SessionState SessionState `json:"session_state"`
+	ID           uuid.UUID        `json:"id"`
+	UserID       uuid.UUID        `json:"user_id"`
+	Title        string           `json:"title"`
+	Notes        string           `json:"notes"`
+	Date         time.Time        `json:"date"`
+	SessionState SessionState     `json:"session_state"`
+	Durations    SessionDurations `json:"durations"`
+	Subjects     []SessionSubject `json:"subjects"`
+	Pomodoro     *Pomodoro        `json:"pomodoro,omitempty"`
+	CreatedAt    time.Time        `json:"created_at"`
+	UpdatedAt    time.Time        `json:"updated_at"`
+	DeletedAt    *time.Time       `json:"deleted_at,omitempty"`
+	// AutoClosedAt is set when the session was finished or paused because
+	// its timer was left running, so the user can review it
+	AutoClosedAt *time.Time `json:"auto_closed_at,omitempty"`
+}
+
+// SessionUpdate holds the editable fields of a session, nil fields are kept
+type SessionUpdate struct {
+	Title *string
+	Notes *string
+	// Subjects replaces the session subjects, an empty list removes them all
+	Subjects *[]SessionSubject
+	// IfUpdatedAt rejects the update when the session was modified after
+	// the version known by the client
+	IfUpdatedAt *time.Time
+}
+
+// StudySessionDetails is a study session along with its full event timeline
+type StudySessionDetails struct {
+	StudySession
+	Events []SessionEvent `json:"events"`
+}
+
+// HistoryCursor marks the position of the last session returned in a
+// history page. Sessions are ordered by date and id, both descending.
+type HistoryCursor struct {
+	Date time.Time
+	ID   uuid.UUID
+}
+
+// HistoryFilter narrows down the sessions listed in a user's history
+type HistoryFilter struct {
+	From   *time.Time
+	To     *time.Time
+	States []SessionState
+	Title  string
+	// AutoClosed only lists the sessions closed by the stale session sweeper
+	AutoClosed bool
+	Cursor     *HistoryCursor
+	Limit      int
+}
+
+type StudySessionPage struct {
+	Sessions   []StudySession `json:"sessions"`
+	NextCursor string         `json:"next_cursor,omitempty"`
+}
+
+// SubjectTime is the focused time spent on a subject
+type SubjectTime struct {
+	SubjectID      uuid.UUID `json:"subject_id"`
+	FocusedSeconds int64     `json:"focused_seconds"`
+	SessionCount   int       `json:"session_count"`
+}
+
+// SubjectTimeReport splits the focused time of the sessions in a date range
+// between their subjects
+type SubjectTimeReport struct {
+	Subjects          []SubjectTime `json:"subjects"`
+	UnassignedSeconds int64         `json:"unassigned_seconds"`
+}
+
+type ExportFormat string
+
+const (
+	ExportFormatCSV  ExportFormat = "csv"
+	ExportFormatJSON ExportFormat = "json"
+)
+
+// ParseExportFormat defaults to CSV when no format is given
+func ParseExportFormat(format string) (ExportFormat, error) {
+	switch ExportFormat(format) {
+	case "", ExportFormatCSV:
+		return ExportFormatCSV, nil
+	case ExportFormatJSON:
+		return ExportFormatJSON, nil
+	default:
+		return "", ErrInvalidExportFormat
+	}
+}
+
+func (f ExportFormat) ContentType() string {
+	if f == ExportFormatJSON {
+		return "application/json; charset=utf-8"
+	}
+	return "text/csv; charset=utf-8"
 }
@@ -0,0 +1,14 @@
+package subjects
+
+import "errors"
+
+var (
+	ErrSubjectNotFound  = errors.New("subject not found")
+	ErrSubjectNameTaken = errors.New("subject name already in use")
+	ErrInvalidSubject   = errors.New("invalid subject")
+	ErrRelationNotFound = errors.New("subject relation not found")
+	ErrRelationExists   = errors.New("subject relation already exists")
+	ErrInvalidRelation  = errors.New("invalid subject relation")
+	ErrSubjectHasParent = errors.New("subject already has a parent")
+	ErrRelationCycle    = errors.New("subject relation would create a cycle")
+)
@@ -0,0 +1,140 @@
+package subjects
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// CreatesCycle reports whether adding the edge from -> to to the given
+// relations would create a cycle, i.e. whether from is reachable from to
+func CreatesCycle(relations []SubjectRelation, from uuid.UUID, to uuid.UUID) bool {
+	if from == to {
+		return true
+	}
+	next := make(map[uuid.UUID][]uuid.UUID)
+	for _, relation := range relations {
+		next[relation.FromSubjectID] = append(next[relation.FromSubjectID], relation.ToSubjectID)
+	}
+
+	visited := map[uuid.UUID]bool{to: true}
+	pending := []uuid.UUID{to}
+	for len(pending) > 0 {
+		current := pending[len(pending)-1]
+		pending = pending[:len(pending)-1]
+		for _, subjectID := range next[current] {
+			if subjectID == from {
+				return true
+			}
+			if !visited[subjectID] {
+				visited[subjectID] = true
+				pending = append(pending, subjectID)
+			}
+		}
+	}
+	return false
+}
+
+// BuildSubjectTree nests the subjects following their parent relations.
+// Subjects whose parent is not in the list are returned as roots.
+func BuildSubjectTree(subjects []Subject, relations []SubjectRelation) []SubjectNode {
+	byID := make(map[uuid.UUID]Subject, len(subjects))
+	for _, subject := range subjects {
+		byID[subject.ID] = subject
+	}
+	children := make(map[uuid.UUID][]Subject)
+	hasParent := make(map[uuid.UUID]bool)
+	for _, relation := range relations {
+		if relation.RelationType != RelationTypeParent {
+			continue
+		}
+		child, childFound := byID[relation.ToSubjectID]
+		if _, parentFound := byID[relation.FromSubjectID]; !parentFound || !childFound {
+			continue
+		}
+		children[relation.FromSubjectID] = append(children[relation.FromSubjectID], child)
+		hasParent[child.ID] = true
+	}
+
+	var buildNodes func(subjects []Subject) []SubjectNode
+	buildNodes = func(subjects []Subject) []SubjectNode {
+		sortSubjects(subjects)
+		nodes := make([]SubjectNode, len(subjects))
+		for i, subject := range subjects {
+			nodes[i] = SubjectNode{
+				Subject:  subject,
+				Children: buildNodes(children[subject.ID]),
+			}
+		}
+		return nodes
+	}
+
+	roots := []Subject{}
+	for _, subject := range subjects {
+		if !hasParent[subject.ID] {
+			roots = append(roots, subject)
+		}
+	}
+	return buildNodes(roots)
+}
+
+// StudyOrder sorts the subjects so every subject comes after its
+// prerequisites. Subjects that are ready at the same time are ordered by
+// name, so the result is stable.
+func StudyOrder(subjects []Subject, relations []SubjectRelation) ([]Subject, error) {
+	byID := make(map[uuid.UUID]Subject, len(subjects))
+	for _, subject := range subjects {
+		byID[subject.ID] = subject
+	}
+	dependents := make(map[uuid.UUID][]uuid.UUID)
+	pendingPrerequisites := make(map[uuid.UUID]int)
+	for _, relation := range relations {
+		if relation.RelationType != RelationTypePrerequisite {
+			continue
+		}
+		_, fromFound := byID[relation.FromSubjectID]
+		_, toFound := byID[relation.ToSubjectID]
+		if !fromFound || !toFound {
+			continue
+		}
+		dependents[relation.FromSubjectID] = append(dependents[relation.FromSubjectID], relation.ToSubjectID)
+		pendingPrerequisites[relation.ToSubjectID]++
+	}
+
+	ready := []Subject{}
+	for _, subject := range subjects {
+		if pendingPrerequisites[subject.ID] == 0 {
+			ready = append(ready, subject)
+		}
+	}
+
+	order := make([]Subject, 0, len(subjects))
+	for len(ready) > 0 {
+		sortSubjects(ready)
+		current := ready[0]
+		ready = ready[1:]
+		order = append(order, current)
+
+		for _, dependentID := range dependents[current.ID] {
+			pendingPrerequisites[dependentID]--
+			if pendingPrerequisites[dependentID] == 0 {
+				ready = append(ready, byID[dependentID])
+			}
+		}
+	}
+	if len(order) != len(subjects) {
+		return nil, ErrRelationCycle
+	}
+	return order, nil
+}
+
+func sortSubjects(subjects []Subject) {
+	sort.SliceStable(subjects, func(i, j int) bool {
+		left, right := strings.ToLower(subjects[i].Name), strings.ToLower(subjects[j].Name)
+		if left != right {
+			return left < right
+		}
+		return subjects[i].ID.String() < subjects[j].ID.String()
+	})
+}
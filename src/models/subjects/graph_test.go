@@ -0,0 +1,105 @@
+package subjects
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreatesCycle(t *testing.T) {
+	a, b, c, d := uuid.New(), uuid.New(), uuid.New(), uuid.New()
+	relations := []SubjectRelation{
+		{FromSubjectID: a, ToSubjectID: b},
+		{FromSubjectID: b, ToSubjectID: c},
+	}
+
+	tests := map[string]struct {
+		From           uuid.UUID
+		To             uuid.UUID
+		ExpectedResult bool
+	}{
+		"new branch":           {From: a, To: d},
+		"shortcut":             {From: a, To: c},
+		"self relation":        {From: a, To: a, ExpectedResult: true},
+		"direct back edge":     {From: b, To: a, ExpectedResult: true},
+		"transitive back edge": {From: c, To: a, ExpectedResult: true},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.ExpectedResult, CreatesCycle(relations, tc.From, tc.To))
+		})
+	}
+}
+
+func TestBuildSubjectTree(t *testing.T) {
+	calculus := Subject{ID: uuid.New(), Name: "Calculus"}
+	integrals := Subject{ID: uuid.New(), Name: "Integrals"}
+	derivatives := Subject{ID: uuid.New(), Name: "Derivatives"}
+	history := Subject{ID: uuid.New(), Name: "History"}
+	relations := []SubjectRelation{
+		{FromSubjectID: calculus.ID, ToSubjectID: integrals.ID, RelationType: RelationTypeParent},
+		{FromSubjectID: calculus.ID, ToSubjectID: derivatives.ID, RelationType: RelationTypeParent},
+		{FromSubjectID: derivatives.ID, ToSubjectID: integrals.ID, RelationType: RelationTypePrerequisite},
+	}
+
+	tree := BuildSubjectTree([]Subject{integrals, history, calculus, derivatives}, relations)
+
+	assert.Equal(t, []SubjectNode{
+		{Subject: calculus, Children: []SubjectNode{
+			{Subject: derivatives, Children: []SubjectNode{}},
+			{Subject: integrals, Children: []SubjectNode{}},
+		}},
+		{Subject: history, Children: []SubjectNode{}},
+	}, tree)
+}
+
+func TestStudyOrder(t *testing.T) {
+	algebra := Subject{ID: uuid.New(), Name: "Algebra"}
+	calculus := Subject{ID: uuid.New(), Name: "Calculus"}
+	physics := Subject{ID: uuid.New(), Name: "Physics"}
+	art := Subject{ID: uuid.New(), Name: "Art"}
+
+	tests := map[string]struct {
+		Relations     []SubjectRelation
+		ExpectedOrder []Subject
+		ExpectedError error
+	}{
+		"no prerequisites are sorted by name": {
+			ExpectedOrder: []Subject{algebra, art, calculus, physics},
+		},
+		"prerequisites come first": {
+			Relations: []SubjectRelation{
+				{FromSubjectID: calculus.ID, ToSubjectID: algebra.ID, RelationType: RelationTypePrerequisite},
+				{FromSubjectID: physics.ID, ToSubjectID: calculus.ID, RelationType: RelationTypePrerequisite},
+			},
+			ExpectedOrder: []Subject{art, physics, calculus, algebra},
+		},
+		"parent relations are ignored": {
+			Relations: []SubjectRelation{
+				{FromSubjectID: physics.ID, ToSubjectID: algebra.ID, RelationType: RelationTypeParent},
+			},
+			ExpectedOrder: []Subject{algebra, art, calculus, physics},
+		},
+		"fail - cycle": {
+			Relations: []SubjectRelation{
+				{FromSubjectID: calculus.ID, ToSubjectID: algebra.ID, RelationType: RelationTypePrerequisite},
+				{FromSubjectID: algebra.ID, ToSubjectID: calculus.ID, RelationType: RelationTypePrerequisite},
+			},
+			ExpectedError: ErrRelationCycle,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			order, err := StudyOrder([]Subject{physics, calculus, art, algebra}, tc.Relations)
+			if tc.ExpectedError != nil {
+				assert.ErrorIs(t, err, tc.ExpectedError)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.ExpectedOrder, order)
+		})
+	}
+}
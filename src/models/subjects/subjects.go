@@ -0,0 +1,50 @@
+package subjects
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type Subject struct {
+	ID         uuid.UUID  `json:"id"`
+	UserID     uuid.UUID  `json:"user_id"`
+	Name       string     `json:"name"`
+	Color      string     `json:"color"`
+	Icon       string     `json:"icon"`
+	ArchivedAt *time.Time `json:"archived_at,omitempty"`
+	CreatedAt  time.Time  `json:"created_at"`
+	UpdatedAt  time.Time  `json:"updated_at"`
+}
+
+// SubjectUpdate holds the editable fields of a subject, nil fields are kept
+type SubjectUpdate struct {
+	Name  *string
+	Color *string
+	Icon  *string
+}
+
+type RelationType string
+
+const (
+	// RelationTypeParent makes the From subject the parent of the To subject
+	RelationTypeParent RelationType = "parent"
+	// RelationTypePrerequisite means the From subject must be studied before the To subject
+	RelationTypePrerequisite RelationType = "prerequisite"
+)
+
+// SubjectRelation is a directed edge between two subjects of the same user
+type SubjectRelation struct {
+	ID            uuid.UUID    `json:"id"`
+	UserID        uuid.UUID    `json:"user_id"`
+	FromSubjectID uuid.UUID    `json:"from_subject_id"`
+	ToSubjectID   uuid.UUID    `json:"to_subject_id"`
+	RelationType  RelationType `json:"relation_type"`
+	CreatedAt     time.Time    `json:"created_at"`
+}
+
+// SubjectNode is a subject of the hierarchy along with its children
+type SubjectNode struct {
+	Subject
+	Children []SubjectNode `json:"children"`
+}
@@ -0,0 +1,13 @@
+package webhooks
+
+import "errors"
+
+var (
+	ErrEndpointNotFound     = errors.New("webhook endpoint not found")
+	ErrInvalidEndpoint      = errors.New("invalid webhook endpoint")
+	ErrInvalidEventTypes    = errors.New("invalid webhook event types")
+	ErrEndpointLimit        = errors.New("too many webhook endpoints")
+	ErrDeliveryNotFound     = errors.New("webhook delivery not found")
+	ErrDeliveryPending      = errors.New("webhook delivery is still pending")
+	ErrInvalidDeliveryLimit = errors.New("invalid webhook delivery limit")
+)
@@ -0,0 +1,137 @@
+package webhooks
+
+import (
+	studysessionmodels "go-api/src/models/studysession"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// EventType is the session change an event reports, endpoints subscribe to
+// a set of them
+type EventType string
+
+const (
+	EventTypeStarted  EventType = "session.started"
+	EventTypePaused   EventType = "session.paused"
+	EventTypeResumed  EventType = "session.resumed"
+	EventTypeFinished EventType = "session.finished"
+	EventTypeCanceled EventType = "session.canceled"
+	EventTypeUpdated  EventType = "session.updated"
+	EventTypeCreated  EventType = "session.created"
+	EventTypeDeleted  EventType = "session.deleted"
+	EventTypeRestored EventType = "session.restored"
+)
+
+// EventTypes lists the event types endpoints can subscribe to
+var EventTypes = []EventType{
+	EventTypeStarted,
+	EventTypePaused,
+	EventTypeResumed,
+	EventTypeFinished,
+	EventTypeCanceled,
+	EventTypeUpdated,
+	EventTypeCreated,
+	EventTypeDeleted,
+	EventTypeRestored,
+}
+
+func (t EventType) IsValid() bool {
+	for _, eventType := range EventTypes {
+		if t == eventType {
+			return true
+		}
+	}
+	return false
+}
+
+// DeliveryStatus is pending until the endpoint accepts the event or the
+// attempts run out
+type DeliveryStatus string
+
+const (
+	DeliveryStatusPending   DeliveryStatus = "pending"
+	DeliveryStatusSucceeded DeliveryStatus = "succeeded"
+	DeliveryStatusFailed    DeliveryStatus = "failed"
+)
+
+// Endpoint receives the events of the given types for the sessions of its
+// user. Inactive endpoints keep their pending deliveries until reactivated.
+type Endpoint struct {
+	ID          uuid.UUID   `json:"id"`
+	UserID      uuid.UUID   `json:"user_id"`
+	URL         string      `json:"url"`
+	Description string      `json:"description"`
+	EventTypes  []EventType `json:"event_types"`
+	Active      bool        `json:"active"`
+	CreatedAt   time.Time   `json:"created_at"`
+	UpdatedAt   time.Time   `json:"updated_at"`
+}
+
+// CreatedEndpoint is returned once, when the endpoint is created. The secret
+// signs the payloads sent to the endpoint.
+type CreatedEndpoint struct {
+	Endpoint
+	Secret string `json:"secret"`
+}
+
+// EndpointUpdate holds the editable fields of an endpoint, nil fields are kept
+type EndpointUpdate struct {
+	URL         *string
+	Description *string
+	EventTypes  []EventType
+	Active      *bool
+}
+
+// Event is the payload sent to the endpoints, the session is its state right
+// after the change was committed
+type Event struct {
+	ID        uuid.UUID `json:"id"`
+	Type      EventType `json:"type"`
+	CreatedAt time.Time `json:"created_at"`
+	Data      EventData `json:"data"`
+}
+
+type EventData struct {
+	Session studysessionmodels.StudySession `json:"session"`
+}
+
+// Delivery is an entry of the delivery log of an endpoint
+type Delivery struct {
+	ID            uuid.UUID      `json:"id"`
+	EventID       uuid.UUID      `json:"event_id"`
+	EndpointID    uuid.UUID      `json:"endpoint_id"`
+	EventType     EventType      `json:"event_type"`
+	Status        DeliveryStatus `json:"status"`
+	Attempts      int            `json:"attempts"`
+	NextAttemptAt *time.Time     `json:"next_attempt_at,omitempty"`
+	LastAttemptAt *time.Time     `json:"last_attempt_at,omitempty"`
+	// ResponseCode is the HTTP status of the last attempt, it's nil when
+	// the endpoint couldn't be reached
+	ResponseCode *int      `json:"response_code,omitempty"`
+	LastError    *string   `json:"last_error,omitempty"`
+	CreatedAt    time.Time `json:"created_at"`
+}
+
+// PendingDelivery is a delivery claimed for an attempt, with what is needed
+// to send it. Attempts includes the claimed attempt.
+type PendingDelivery struct {
+	ID        uuid.UUID
+	EventID   uuid.UUID
+	EventType EventType
+	Attempts  int
+	URL       string
+	Secret    string
+	Payload   []byte
+}
+
+// DeliveryResult is the outcome of an attempt, NextAttemptAt is set when the
+// delivery is retried
+type DeliveryResult struct {
+	DeliveryID    uuid.UUID
+	Status        DeliveryStatus
+	AttemptedAt   time.Time
+	NextAttemptAt *time.Time
+	ResponseCode  *int
+	Error         *string
+}
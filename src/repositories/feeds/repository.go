@@ -0,0 +1,182 @@
+package feeds
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"go-api/src/clients/postgres"
+	models "go-api/src/models/feeds"
+	sessionmodels "go-api/src/models/studysession"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/fx"
+	"go.uber.org/zap"
+)
+
+type FeedRepository interface {
+	CreateFeedToken(ctx context.Context, userID uuid.UUID, name string, tokenHash string, maxActiveTokens int) (*models.FeedToken, error)
+	ListFeedTokens(ctx context.Context, userID uuid.UUID) ([]models.FeedToken, error)
+	RevokeFeedToken(ctx context.Context, userID uuid.UUID, tokenID uuid.UUID) error
+	UseFeedToken(ctx context.Context, tokenHash string) (uuid.UUID, error)
+	GetFeedState(ctx context.Context, userID uuid.UUID) (*models.FeedState, error)
+}
+
+type feedRepository struct {
+	logger   *zap.Logger
+	pgclient postgres.PostgresClient
+}
+
+type FeedRepositoryParams struct {
+	fx.In
+
+	Logger   *zap.Logger
+	PGClient postgres.PostgresClient
+}
+
+func NewFeedRepository(p FeedRepositoryParams) (FeedRepository, error) {
+	return &feedRepository{
+		logger:   p.Logger,
+		pgclient: p.PGClient,
+	}, nil
+}
+
+func (r *feedRepository) CreateFeedToken(ctx context.Context, userID uuid.UUID, name string, tokenHash string, maxActiveTokens int) (*models.FeedToken, error) {
+	tx, err := postgres.NewTransaction(ctx, r.pgclient, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.SafeRollback()
+
+	// Serialize the token creations of the user so the limit can't be exceeded
+	if _, err := tx.ExecContext(ctx, "SELECT pg_advisory_xact_lock(hashtext($1))", "feed_tokens:"+userID.String()); err != nil {
+		return nil, fmt.Errorf("failed to lock feed tokens: %w", err)
+	}
+	var activeTokens int
+	err = tx.GetContext(ctx, &activeTokens,
+		"SELECT count(*) FROM feed_tokens WHERE user_id = $1 AND revoked_at IS NULL",
+		userID.String(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count feed tokens: %w", err)
+	}
+	if activeTokens >= maxActiveTokens {
+		return nil, models.ErrFeedTokenLimit
+	}
+
+	var dbToken DBFeedToken
+	err = tx.GetContext(ctx, &dbToken,
+		`INSERT INTO feed_tokens (user_id, name, token_hash)
+		VALUES ($1, $2, $3)
+		RETURNING *`,
+		userID.String(), name, tokenHash,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create feed token: %w", err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, fmt.Errorf("commit failed: %w", err)
+	}
+	return dbToken.ToFeedToken()
+}
+
+// ListFeedTokens returns the tokens of the user that weren't revoked
+func (r *feedRepository) ListFeedTokens(ctx context.Context, userID uuid.UUID) ([]models.FeedToken, error) {
+	var dbTokens []DBFeedToken
+	err := r.pgclient.QuerySelect(ctx, &dbTokens,
+		"SELECT * FROM feed_tokens WHERE user_id = $1 AND revoked_at IS NULL ORDER BY created_at, id",
+		userID.String(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list feed tokens: %w", err)
+	}
+	tokens := make([]models.FeedToken, len(dbTokens))
+	for i, dbToken := range dbTokens {
+		token, err := dbToken.ToFeedToken()
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse feed token: %w", err)
+		}
+		tokens[i] = *token
+	}
+	return tokens, nil
+}
+
+func (r *feedRepository) RevokeFeedToken(ctx context.Context, userID uuid.UUID, tokenID uuid.UUID) error {
+	tx, err := postgres.NewTransaction(ctx, r.pgclient, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.SafeRollback()
+
+	result, err := tx.ExecContext(ctx,
+		"UPDATE feed_tokens SET revoked_at = $1 WHERE id = $2 AND user_id = $3 AND revoked_at IS NULL",
+		time.Now().UTC(), tokenID.String(), userID.String(),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to revoke feed token: %w", err)
+	}
+	revoked, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to revoke feed token: %w", err)
+	}
+	if revoked == 0 {
+		return models.ErrFeedTokenNotFound
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("commit failed: %w", err)
+	}
+	return nil
+}
+
+// UseFeedToken returns the user of an active token and records its use
+func (r *feedRepository) UseFeedToken(ctx context.Context, tokenHash string) (uuid.UUID, error) {
+	tx, err := postgres.NewTransaction(ctx, r.pgclient, nil)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.SafeRollback()
+
+	var userID string
+	err = tx.GetContext(ctx, &userID,
+		`UPDATE feed_tokens SET last_used_at = $1
+		WHERE token_hash = $2 AND revoked_at IS NULL
+		RETURNING user_id`,
+		time.Now().UTC(), tokenHash,
+	)
+	if errors.Is(err, sql.ErrNoRows) {
+		return uuid.Nil, models.ErrFeedNotFound
+	}
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("failed to use feed token: %w", err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return uuid.Nil, fmt.Errorf("commit failed: %w", err)
+	}
+	return uuid.Parse(userID)
+}
+
+// GetFeedState returns the number of sessions in the user's feed and the
+// last time a session or subject shown in it was updated
+func (r *feedRepository) GetFeedState(ctx context.Context, userID uuid.UUID) (*models.FeedState, error) {
+	var states []DBFeedState
+	err := r.pgclient.QuerySelect(ctx, &states,
+		`SELECT
+			(SELECT count(*) FROM study_sessions
+				WHERE user_id = $1 AND session_state = $2 AND deleted_at IS NULL) AS session_count,
+			(SELECT max(updated_at) FROM study_sessions
+				WHERE user_id = $1 AND session_state = $2) AS sessions_updated_at,
+			(SELECT max(updated_at) FROM subjects WHERE user_id = $1) AS subjects_updated_at`,
+		userID.String(), string(sessionmodels.SessionStateCompleted),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get feed state: %w", err)
+	}
+	if len(states) == 0 {
+		return &models.FeedState{}, nil
+	}
+	return states[0].ToFeedState(), nil
+}
@@ -0,0 +1,51 @@
+package feeds
+
+import (
+	models "go-api/src/models/feeds"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type DBFeedToken struct {
+	ID         string     `db:"id" json:"id"`
+	UserID     string     `db:"user_id" json:"user_id"`
+	Name       string     `db:"name" json:"name"`
+	TokenHash  string     `db:"token_hash" json:"token_hash"`
+	CreatedAt  time.Time  `db:"created_at" json:"created_at"`
+	LastUsedAt *time.Time `db:"last_used_at" json:"last_used_at"`
+	RevokedAt  *time.Time `db:"revoked_at" json:"revoked_at"`
+}
+
+type DBFeedState struct {
+	SessionCount      int64      `db:"session_count" json:"session_count"`
+	SessionsUpdatedAt *time.Time `db:"sessions_updated_at" json:"sessions_updated_at"`
+	SubjectsUpdatedAt *time.Time `db:"subjects_updated_at" json:"subjects_updated_at"`
+}
+
+func (t DBFeedToken) ToFeedToken() (*models.FeedToken, error) {
+	id, err := uuid.Parse(t.ID)
+	if err != nil {
+		return nil, err
+	}
+	userID, err := uuid.Parse(t.UserID)
+	if err != nil {
+		return nil, err
+	}
+	return &models.FeedToken{
+		ID:         id,
+		UserID:     userID,
+		Name:       t.Name,
+		CreatedAt:  t.CreatedAt,
+		LastUsedAt: t.LastUsedAt,
+		RevokedAt:  t.RevokedAt,
+	}, nil
+}
+
+func (s DBFeedState) ToFeedState() *models.FeedState {
+	return &models.FeedState{
+		SessionCount:      s.SessionCount,
+		SessionsUpdatedAt: s.SessionsUpdatedAt,
+		SubjectsUpdatedAt: s.SubjectsUpdatedAt,
+	}
+}
@@ -0,0 +1,201 @@
+package idempotency
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"go-api/src/clients/postgres"
+	models "go-api/src/models/idempotency"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/fx"
+	"go.uber.org/zap"
+)
+
+type IdempotencyRepository interface {
+	ReserveKey(ctx context.Context, record models.Record, takeOverBefore time.Time) (bool, error)
+	GetKey(ctx context.Context, userID uuid.UUID, key string) (*models.Record, error)
+	SaveResponse(ctx context.Context, record models.Record, response models.Response) error
+	ReleaseKey(ctx context.Context, record models.Record) error
+	PurgeExpiredKeys(ctx context.Context, expiredBefore time.Time) (int64, error)
+}
+
+type idempotencyRepository struct {
+	logger   *zap.Logger
+	pgclient postgres.PostgresClient
+}
+
+type IdempotencyRepositoryParams struct {
+	fx.In
+
+	Logger   *zap.Logger
+	PGClient postgres.PostgresClient
+}
+
+func NewIdempotencyRepository(p IdempotencyRepositoryParams) (IdempotencyRepository, error) {
+	return &idempotencyRepository{
+		logger:   p.Logger,
+		pgclient: p.PGClient,
+	}, nil
+}
+
+// ReserveKey stores the key for a new request and reports whether it was
+// reserved. A key that expired, or whose request didn't complete before
+// takeOverBefore, is reserved again.
+func (r *idempotencyRepository) ReserveKey(ctx context.Context, record models.Record, takeOverBefore time.Time) (bool, error) {
+	tx, err := postgres.NewTransaction(ctx, r.pgclient, nil)
+	if err != nil {
+		return false, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.SafeRollback()
+
+	var reserved bool
+	err = tx.GetContext(ctx, &reserved,
+		`INSERT INTO idempotency_keys (user_id, idempotency_key, request_hash, created_at, expires_at)
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (user_id, idempotency_key) DO UPDATE SET
+			request_hash = EXCLUDED.request_hash,
+			status_code = NULL,
+			response_headers = NULL,
+			response_body = NULL,
+			created_at = EXCLUDED.created_at,
+			completed_at = NULL,
+			expires_at = EXCLUDED.expires_at
+		WHERE idempotency_keys.expires_at <= EXCLUDED.created_at
+			OR (idempotency_keys.completed_at IS NULL AND idempotency_keys.created_at <= $6)
+		RETURNING true`,
+		record.UserID.String(),
+		record.Key,
+		record.RequestHash,
+		record.CreatedAt.UTC(),
+		record.ExpiresAt.UTC(),
+		takeOverBefore.UTC(),
+	)
+	if errors.Is(err, sql.ErrNoRows) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("failed to reserve idempotency key: %w", err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return false, fmt.Errorf("commit failed: %w", err)
+	}
+	return reserved, nil
+}
+
+func (r *idempotencyRepository) GetKey(ctx context.Context, userID uuid.UUID, key string) (*models.Record, error) {
+	var dbKeys []DBIdempotencyKey
+	err := r.pgclient.QuerySelect(ctx, &dbKeys,
+		"SELECT * FROM idempotency_keys WHERE user_id = $1 AND idempotency_key = $2",
+		userID.String(), key,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get idempotency key: %w", err)
+	}
+	if len(dbKeys) == 0 {
+		return nil, nil
+	}
+	record, err := dbKeys[0].ToRecord()
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse idempotency key: %w", err)
+	}
+	return record, nil
+}
+
+// SaveResponse completes the reservation, it fails with ErrKeyInUse when the
+// reservation was taken over by another request in the meantime
+func (r *idempotencyRepository) SaveResponse(ctx context.Context, record models.Record, response models.Response) error {
+	headers, err := json.Marshal(response.Header)
+	if err != nil {
+		return fmt.Errorf("failed to encode response headers: %w", err)
+	}
+
+	tx, err := postgres.NewTransaction(ctx, r.pgclient, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.SafeRollback()
+
+	result, err := tx.ExecContext(ctx,
+		`UPDATE idempotency_keys
+		SET status_code = $1, response_headers = $2, response_body = $3, completed_at = $4
+		WHERE user_id = $5 AND idempotency_key = $6 AND created_at = $7 AND completed_at IS NULL`,
+		response.StatusCode,
+		headers,
+		response.Body,
+		time.Now().UTC(),
+		record.UserID.String(),
+		record.Key,
+		record.CreatedAt.UTC(),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to save idempotent response: %w", err)
+	}
+	saved, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to save idempotent response: %w", err)
+	}
+	if saved == 0 {
+		return models.ErrKeyInUse
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("commit failed: %w", err)
+	}
+	return nil
+}
+
+// ReleaseKey deletes the reservation of a request that didn't complete, so
+// that it can be retried
+func (r *idempotencyRepository) ReleaseKey(ctx context.Context, record models.Record) error {
+	tx, err := postgres.NewTransaction(ctx, r.pgclient, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.SafeRollback()
+
+	_, err = tx.ExecContext(ctx,
+		`DELETE FROM idempotency_keys
+		WHERE user_id = $1 AND idempotency_key = $2 AND created_at = $3 AND completed_at IS NULL`,
+		record.UserID.String(),
+		record.Key,
+		record.CreatedAt.UTC(),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to release idempotency key: %w", err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("commit failed: %w", err)
+	}
+	return nil
+}
+
+func (r *idempotencyRepository) PurgeExpiredKeys(ctx context.Context, expiredBefore time.Time) (int64, error) {
+	tx, err := postgres.NewTransaction(ctx, r.pgclient, nil)
+	if err != nil {
+		return 0, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.SafeRollback()
+
+	result, err := tx.ExecContext(ctx,
+		"DELETE FROM idempotency_keys WHERE expires_at <= $1",
+		expiredBefore.UTC(),
+	)
+	if err != nil {
+		return 0, fmt.Errorf("failed to purge idempotency keys: %w", err)
+	}
+	purged, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to count purged idempotency keys: %w", err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return 0, fmt.Errorf("commit failed: %w", err)
+	}
+	return purged, nil
+}
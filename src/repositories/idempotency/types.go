@@ -0,0 +1,51 @@
+package idempotency
+
+import (
+	"encoding/json"
+	models "go-api/src/models/idempotency"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type DBIdempotencyKey struct {
+	UserID          string     `db:"user_id" json:"user_id"`
+	IdempotencyKey  string     `db:"idempotency_key" json:"idempotency_key"`
+	RequestHash     string     `db:"request_hash" json:"request_hash"`
+	StatusCode      *int       `db:"status_code" json:"status_code"`
+	ResponseHeaders []byte     `db:"response_headers" json:"response_headers"`
+	ResponseBody    []byte     `db:"response_body" json:"response_body"`
+	CreatedAt       time.Time  `db:"created_at" json:"created_at"`
+	CompletedAt     *time.Time `db:"completed_at" json:"completed_at"`
+	ExpiresAt       time.Time  `db:"expires_at" json:"expires_at"`
+}
+
+func (k DBIdempotencyKey) ToRecord() (*models.Record, error) {
+	userID, err := uuid.Parse(k.UserID)
+	if err != nil {
+		return nil, err
+	}
+	record := &models.Record{
+		UserID:      userID,
+		Key:         k.IdempotencyKey,
+		RequestHash: k.RequestHash,
+		CreatedAt:   k.CreatedAt,
+		ExpiresAt:   k.ExpiresAt,
+	}
+	if k.StatusCode == nil {
+		return record, nil
+	}
+	header := http.Header{}
+	if len(k.ResponseHeaders) > 0 {
+		if err := json.Unmarshal(k.ResponseHeaders, &header); err != nil {
+			return nil, err
+		}
+	}
+	record.Response = &models.Response{
+		StatusCode: *k.StatusCode,
+		Header:     header,
+		Body:       k.ResponseBody,
+	}
+	return record, nil
+}
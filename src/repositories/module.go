@@ -1,7 +1,13 @@
 package repositories
 
 import (
+	"go-api/src/repositories/feeds"
+	"go-api/src/repositories/idempotency"
+	"go-api/src/repositories/notifications"
+	"go-api/src/repositories/stats"
 	"go-api/src/repositories/studysession"
+	"go-api/src/repositories/subjects"
+	"go-api/src/repositories/webhooks"
 
 	"go.uber.org/fx"
 )
@@ -9,5 +15,11 @@ import (
 var Module = fx.Options(
 	fx.Provide(
 		studysession.NewStudySessionRepository,
+		subjects.NewSubjectRepository,
+		stats.NewStatsRepository,
+		feeds.NewFeedRepository,
+		idempotency.NewIdempotencyRepository,
+		notifications.NewNotificationRepository,
+		webhooks.NewWebhookRepository,
 	),
 )
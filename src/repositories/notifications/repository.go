@@ -0,0 +1,149 @@
+package notifications
+
+import (
+	"context"
+	"fmt"
+	"go-api/src/clients/postgres"
+	models "go-api/src/models/notifications"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/fx"
+	"go.uber.org/zap"
+)
+
+// NotificationChannel is the Postgres channel notifications are announced
+// on, the payload is the id of the user the notification is for
+const NotificationChannel = "session_notifications"
+
+type NotificationRepository interface {
+	CreateNotification(ctx context.Context, notification models.Notification) (*models.Notification, error)
+	ListNotifications(ctx context.Context, userID uuid.UUID, afterID int64, limit int) ([]models.Notification, error)
+	GetLatestNotificationID(ctx context.Context, userID uuid.UUID) (int64, error)
+	PurgeNotifications(ctx context.Context, createdBefore time.Time) (int64, error)
+}
+
+type notificationRepository struct {
+	logger   *zap.Logger
+	pgclient postgres.PostgresClient
+}
+
+type NotificationRepositoryParams struct {
+	fx.In
+
+	Logger   *zap.Logger
+	PGClient postgres.PostgresClient
+}
+
+func NewNotificationRepository(p NotificationRepositoryParams) (NotificationRepository, error) {
+	return &notificationRepository{
+		logger:   p.Logger,
+		pgclient: p.PGClient,
+	}, nil
+}
+
+// CreateNotification stores the notification and announces it on the
+// notification channel once committed. Notifications of the same user are
+// created one at a time, so their ids are committed in increasing order and
+// a client resuming after an id can't miss one committed late.
+func (r *notificationRepository) CreateNotification(ctx context.Context, notification models.Notification) (*models.Notification, error) {
+	tx, err := postgres.NewTransaction(ctx, r.pgclient, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.SafeRollback()
+
+	userID := notification.UserID.String()
+	if _, err := tx.ExecContext(ctx, "SELECT pg_advisory_xact_lock(hashtext($1))", "session_notifications:"+userID); err != nil {
+		return nil, fmt.Errorf("failed to lock user notifications: %w", err)
+	}
+
+	var dbNotification DBSessionNotification
+	err = tx.QueryRowxContext(ctx,
+		`INSERT INTO session_notifications (user_id, session_id, notification_type)
+		VALUES ($1, $2, $3)
+		RETURNING *`,
+		userID,
+		notification.SessionID.String(),
+		string(notification.Type),
+	).StructScan(&dbNotification)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create notification: %w", err)
+	}
+	if _, err := tx.ExecContext(ctx, "SELECT pg_notify($1, $2)", NotificationChannel, userID); err != nil {
+		return nil, fmt.Errorf("failed to announce notification: %w", err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, fmt.Errorf("commit failed: %w", err)
+	}
+	created, err := dbNotification.ToNotification()
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse notification: %w", err)
+	}
+	return created, nil
+}
+
+// ListNotifications returns, oldest first, at most limit notifications of
+// the user published after the given id
+func (r *notificationRepository) ListNotifications(ctx context.Context, userID uuid.UUID, afterID int64, limit int) ([]models.Notification, error) {
+	var dbNotifications []DBSessionNotification
+	err := r.pgclient.QuerySelect(ctx, &dbNotifications,
+		"SELECT * FROM session_notifications WHERE user_id = $1 AND id > $2 ORDER BY id LIMIT $3",
+		userID.String(), afterID, limit,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list notifications: %w", err)
+	}
+	notifications := make([]models.Notification, len(dbNotifications))
+	for i, dbNotification := range dbNotifications {
+		notification, err := dbNotification.ToNotification()
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse notification: %w", err)
+		}
+		notifications[i] = *notification
+	}
+	return notifications, nil
+}
+
+// GetLatestNotificationID returns the id of the last notification of the
+// user, or 0 when there is none
+func (r *notificationRepository) GetLatestNotificationID(ctx context.Context, userID uuid.UUID) (int64, error) {
+	var ids []int64
+	err := r.pgclient.QuerySelect(ctx, &ids,
+		"SELECT COALESCE(MAX(id), 0) FROM session_notifications WHERE user_id = $1",
+		userID.String(),
+	)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get latest notification: %w", err)
+	}
+	if len(ids) == 0 {
+		return 0, nil
+	}
+	return ids[0], nil
+}
+
+func (r *notificationRepository) PurgeNotifications(ctx context.Context, createdBefore time.Time) (int64, error) {
+	tx, err := postgres.NewTransaction(ctx, r.pgclient, nil)
+	if err != nil {
+		return 0, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.SafeRollback()
+
+	result, err := tx.ExecContext(ctx,
+		"DELETE FROM session_notifications WHERE created_at < $1",
+		createdBefore.UTC(),
+	)
+	if err != nil {
+		return 0, fmt.Errorf("failed to purge notifications: %w", err)
+	}
+	purged, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to count purged notifications: %w", err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return 0, fmt.Errorf("commit failed: %w", err)
+	}
+	return purged, nil
+}
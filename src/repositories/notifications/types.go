@@ -0,0 +1,34 @@
+package notifications
+
+import (
+	models "go-api/src/models/notifications"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type DBSessionNotification struct {
+	ID               int64     `db:"id" json:"id"`
+	UserID           string    `db:"user_id" json:"user_id"`
+	SessionID        string    `db:"session_id" json:"session_id"`
+	NotificationType string    `db:"notification_type" json:"notification_type"`
+	CreatedAt        time.Time `db:"created_at" json:"created_at"`
+}
+
+func (n DBSessionNotification) ToNotification() (*models.Notification, error) {
+	userID, err := uuid.Parse(n.UserID)
+	if err != nil {
+		return nil, err
+	}
+	sessionID, err := uuid.Parse(n.SessionID)
+	if err != nil {
+		return nil, err
+	}
+	return &models.Notification{
+		ID:        n.ID,
+		UserID:    userID,
+		SessionID: sessionID,
+		Type:      models.NotificationType(n.NotificationType),
+		CreatedAt: n.CreatedAt,
+	}, nil
+}
@@ -0,0 +1,273 @@
+package stats
+
+import (
+	"context"
+	"fmt"
+	"go-api/src/clients/postgres"
+	models "go-api/src/models/stats"
+	sessionmodels "go-api/src/models/studysession"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/fx"
+	"go.uber.org/zap"
+)
+
+type StatsRepository interface {
+	ListCompletedSessionEvents(ctx context.Context, userID uuid.UUID, startedFrom time.Time, startedBefore time.Time) (map[uuid.UUID][]sessionmodels.SessionEvent, error)
+	GetStreakSettings(ctx context.Context, userID uuid.UUID) (*models.StreakSettings, error)
+	UpsertStreakSettings(ctx context.Context, userID uuid.UUID, settings models.StreakSettings) (*models.StreakSettings, error)
+	ListSessionEventsWithoutRollups(ctx context.Context, userID uuid.UUID, timezone string, fromDate time.Time, toDate time.Time) (map[uuid.UUID][]sessionmodels.SessionEvent, error)
+	ListAllSessionEventsWithoutRollups(ctx context.Context, userID uuid.UUID, timezone string) (map[uuid.UUID][]sessionmodels.SessionEvent, error)
+	SaveSessionDayRollups(ctx context.Context, timezone string, rollups []models.SessionDayRollup) error
+	GetDailyFocusedSeconds(ctx context.Context, userID uuid.UUID, timezone string, fromDate time.Time, toDate time.Time) (map[time.Time]int64, error)
+	GetAllDailyFocusedSeconds(ctx context.Context, userID uuid.UUID, timezone string) (map[time.Time]int64, error)
+	GetSessionDailyFocusedSeconds(ctx context.Context, sessionID uuid.UUID, timezone string) (map[time.Time]int64, error)
+}
+
+type statsRepository struct {
+	logger   *zap.Logger
+	pgclient postgres.PostgresClient
+}
+
+type StatsRepositoryParams struct {
+	fx.In
+
+	Logger   *zap.Logger
+	PGClient postgres.PostgresClient
+}
+
+func NewStatsRepository(p StatsRepositoryParams) (StatsRepository, error) {
+	return &statsRepository{
+		logger:   p.Logger,
+		pgclient: p.PGClient,
+	}, nil
+}
+
+// ListCompletedSessionEvents returns the events of the user's completed
+// sessions that started within [startedFrom, startedBefore), grouped by session
+func (r *statsRepository) ListCompletedSessionEvents(ctx context.Context, userID uuid.UUID, startedFrom time.Time, startedBefore time.Time) (map[uuid.UUID][]sessionmodels.SessionEvent, error) {
+	// The date column holds the UTC date of the start event, filtering on it
+	// lets the history index narrow down the sessions before the events join
+	var dbEvents []DBSessionEvent
+	err := r.pgclient.QuerySelect(ctx, &dbEvents,
+		`SELECT e.session_id, e.event_type, e.event_time
+		FROM session_events e
+		JOIN study_sessions s ON s.id = e.session_id
+		WHERE s.user_id = $1
+			AND s.session_state = $2
+			AND s.deleted_at IS NULL
+			AND s.date BETWEEN $6 AND $7
+			AND EXISTS (
+				SELECT 1 FROM session_events start_event
+				WHERE start_event.session_id = s.id
+					AND start_event.event_type = $5
+					AND start_event.event_time >= $3
+					AND start_event.event_time < $4
+			)
+		ORDER BY e.event_time, e.id`,
+		userID.String(), string(sessionmodels.SessionStateCompleted),
+		startedFrom.UTC(), startedBefore.UTC(), string(sessionmodels.EventTypeStart),
+		startedFrom.UTC().Format(time.DateOnly), startedBefore.UTC().Format(time.DateOnly),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list session events: %w", err)
+	}
+	return groupEventsBySession(dbEvents)
+}
+
+// GetStreakSettings returns the user's streak settings, or the defaults when
+// the user never changed them
+func (r *statsRepository) GetStreakSettings(ctx context.Context, userID uuid.UUID) (*models.StreakSettings, error) {
+	var dbSettings []DBStreakSettings
+	err := r.pgclient.QuerySelect(ctx, &dbSettings,
+		"SELECT * FROM streak_settings WHERE user_id = $1",
+		userID.String(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get streak settings: %w", err)
+	}
+	if len(dbSettings) == 0 {
+		return &models.StreakSettings{
+			DailyGoalMinutes: models.DefaultDailyGoalMinutes,
+			Timezone:         time.UTC.String(),
+		}, nil
+	}
+	return dbSettings[0].ToStreakSettings(), nil
+}
+
+func (r *statsRepository) UpsertStreakSettings(ctx context.Context, userID uuid.UUID, settings models.StreakSettings) (*models.StreakSettings, error) {
+	tx, err := postgres.NewTransaction(ctx, r.pgclient, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.SafeRollback()
+
+	var dbSettings DBStreakSettings
+	err = tx.GetContext(ctx, &dbSettings,
+		`INSERT INTO streak_settings (user_id, daily_goal_minutes, timezone)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (user_id) DO UPDATE
+		SET daily_goal_minutes = EXCLUDED.daily_goal_minutes, timezone = EXCLUDED.timezone
+		RETURNING *`,
+		userID.String(), settings.DailyGoalMinutes, settings.Timezone,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to save streak settings: %w", err)
+	}
+	if err = tx.Commit(); err != nil {
+		return nil, fmt.Errorf("commit failed: %w", err)
+	}
+	return dbSettings.ToStreakSettings(), nil
+}
+
+// ListSessionEventsWithoutRollups returns the events of the user's completed
+// sessions whose UTC date is within [fromDate, toDate] and that have no
+// rollups in the timezone yet
+func (r *statsRepository) ListSessionEventsWithoutRollups(ctx context.Context, userID uuid.UUID, timezone string, fromDate time.Time, toDate time.Time) (map[uuid.UUID][]sessionmodels.SessionEvent, error) {
+	var dbEvents []DBSessionEvent
+	err := r.pgclient.QuerySelect(ctx, &dbEvents,
+		`SELECT e.session_id, e.event_type, e.event_time
+		FROM session_events e
+		JOIN study_sessions s ON s.id = e.session_id
+		WHERE s.user_id = $1
+			AND s.session_state = $2
+			AND s.deleted_at IS NULL
+			AND s.date BETWEEN $3 AND $4
+			AND NOT EXISTS (
+				SELECT 1 FROM session_day_rollups r
+				WHERE r.session_id = s.id AND r.timezone = $5
+			)
+		ORDER BY e.event_time, e.id`,
+		userID.String(), string(sessionmodels.SessionStateCompleted),
+		fromDate.Format(time.DateOnly), toDate.Format(time.DateOnly), timezone,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list session events: %w", err)
+	}
+	return groupEventsBySession(dbEvents)
+}
+
+// ListAllSessionEventsWithoutRollups returns the events of all the user's
+// completed sessions that have no rollups in the timezone yet. Sessions are
+// rolled up once, only the new and the changed ones are returned afterwards.
+func (r *statsRepository) ListAllSessionEventsWithoutRollups(ctx context.Context, userID uuid.UUID, timezone string) (map[uuid.UUID][]sessionmodels.SessionEvent, error) {
+	var dbEvents []DBSessionEvent
+	err := r.pgclient.QuerySelect(ctx, &dbEvents,
+		`SELECT e.session_id, e.event_type, e.event_time
+		FROM session_events e
+		JOIN study_sessions s ON s.id = e.session_id
+		WHERE s.user_id = $1
+			AND s.session_state = $2
+			AND s.deleted_at IS NULL
+			AND NOT EXISTS (
+				SELECT 1 FROM session_day_rollups r
+				WHERE r.session_id = s.id AND r.timezone = $3
+			)
+		ORDER BY e.event_time, e.id`,
+		userID.String(), string(sessionmodels.SessionStateCompleted), timezone,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list session events: %w", err)
+	}
+	return groupEventsBySession(dbEvents)
+}
+
+func (r *statsRepository) SaveSessionDayRollups(ctx context.Context, timezone string, rollups []models.SessionDayRollup) error {
+	if len(rollups) == 0 {
+		return nil
+	}
+	tx, err := postgres.NewTransaction(ctx, r.pgclient, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.SafeRollback()
+
+	dbRollups := make([]DBSessionDayRollup, len(rollups))
+	for i, rollup := range rollups {
+		dbRollups[i] = NewDBSessionDayRollup(rollup, timezone)
+	}
+	// Concurrent requests may build the same rollups, the first one wins
+	_, err = tx.NamedExecContext(ctx,
+		`INSERT INTO session_day_rollups (session_id, user_id, timezone, day, focused_seconds)
+		VALUES (:session_id, :user_id, :timezone, :day, :focused_seconds)
+		ON CONFLICT DO NOTHING`,
+		dbRollups,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to save session day rollups: %w", err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("commit failed: %w", err)
+	}
+	return nil
+}
+
+// GetDailyFocusedSeconds sums the rollups of the user's completed sessions
+// per day within [fromDate, toDate]
+func (r *statsRepository) GetDailyFocusedSeconds(ctx context.Context, userID uuid.UUID, timezone string, fromDate time.Time, toDate time.Time) (map[time.Time]int64, error) {
+	return r.getDailyFocusedSeconds(ctx,
+		`SELECT r.day, SUM(r.focused_seconds) AS focused_seconds
+		FROM session_day_rollups r
+		JOIN study_sessions s ON s.id = r.session_id
+		WHERE r.user_id = $1
+			AND r.timezone = $2
+			AND r.day BETWEEN $3 AND $4
+			AND s.session_state = $5
+			AND s.deleted_at IS NULL
+		GROUP BY r.day`,
+		userID.String(), timezone, fromDate.Format(time.DateOnly), toDate.Format(time.DateOnly),
+		string(sessionmodels.SessionStateCompleted),
+	)
+}
+
+// GetAllDailyFocusedSeconds sums the rollups of all the user's completed
+// sessions per day
+func (r *statsRepository) GetAllDailyFocusedSeconds(ctx context.Context, userID uuid.UUID, timezone string) (map[time.Time]int64, error) {
+	return r.getDailyFocusedSeconds(ctx,
+		`SELECT r.day, SUM(r.focused_seconds) AS focused_seconds
+		FROM session_day_rollups r
+		JOIN study_sessions s ON s.id = r.session_id
+		WHERE r.user_id = $1
+			AND r.timezone = $2
+			AND s.session_state = $3
+			AND s.deleted_at IS NULL
+		GROUP BY r.day`,
+		userID.String(), timezone, string(sessionmodels.SessionStateCompleted),
+	)
+}
+
+// GetSessionDailyFocusedSeconds returns the rollups of a session per day
+func (r *statsRepository) GetSessionDailyFocusedSeconds(ctx context.Context, sessionID uuid.UUID, timezone string) (map[time.Time]int64, error) {
+	return r.getDailyFocusedSeconds(ctx,
+		`SELECT day, focused_seconds
+		FROM session_day_rollups
+		WHERE session_id = $1 AND timezone = $2`,
+		sessionID.String(), timezone,
+	)
+}
+
+func (r *statsRepository) getDailyFocusedSeconds(ctx context.Context, query string, args ...any) (map[time.Time]int64, error) {
+	var dbTotals []DBDailyFocus
+	if err := r.pgclient.QuerySelect(ctx, &dbTotals, query, args...); err != nil {
+		return nil, fmt.Errorf("failed to get daily focused time: %w", err)
+	}
+	daily := make(map[time.Time]int64, len(dbTotals))
+	for _, total := range dbTotals {
+		daily[models.LocalDate(total.Day)] = total.FocusedSeconds
+	}
+	return daily, nil
+}
+
+func groupEventsBySession(dbEvents []DBSessionEvent) (map[uuid.UUID][]sessionmodels.SessionEvent, error) {
+	eventsBySession := make(map[uuid.UUID][]sessionmodels.SessionEvent)
+	for _, dbEvent := range dbEvents {
+		sessionID, err := uuid.Parse(dbEvent.SessionID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse session id: %w", err)
+		}
+		eventsBySession[sessionID] = append(eventsBySession[sessionID], dbEvent.ToSessionEvent())
+	}
+	return eventsBySession, nil
+}
@@ -0,0 +1,59 @@
+package stats
+
+import (
+	models "go-api/src/models/stats"
+	sessionmodels "go-api/src/models/studysession"
+	"time"
+)
+
+type DBSessionEvent struct {
+	SessionID string    `db:"session_id" json:"session_id"`
+	EventType string    `db:"event_type" json:"event_type"`
+	EventTime time.Time `db:"event_time" json:"event_time"`
+}
+
+func (e DBSessionEvent) ToSessionEvent() sessionmodels.SessionEvent {
+	return sessionmodels.SessionEvent{
+		EventType: sessionmodels.EventType(e.EventType),
+		EventTime: e.EventTime,
+	}
+}
+
+type DBStreakSettings struct {
+	UserID           string    `db:"user_id" json:"user_id"`
+	DailyGoalMinutes int       `db:"daily_goal_minutes" json:"daily_goal_minutes"`
+	Timezone         string    `db:"timezone" json:"timezone"`
+	CreatedAt        time.Time `db:"created_at" json:"created_at"`
+	UpdatedAt        time.Time `db:"updated_at" json:"updated_at"`
+}
+
+func (s DBStreakSettings) ToStreakSettings() *models.StreakSettings {
+	return &models.StreakSettings{
+		DailyGoalMinutes: s.DailyGoalMinutes,
+		Timezone:         s.Timezone,
+		UpdatedAt:        s.UpdatedAt,
+	}
+}
+
+type DBSessionDayRollup struct {
+	SessionID      string    `db:"session_id" json:"session_id"`
+	UserID         string    `db:"user_id" json:"user_id"`
+	Timezone       string    `db:"timezone" json:"timezone"`
+	Day            time.Time `db:"day" json:"day"`
+	FocusedSeconds int64     `db:"focused_seconds" json:"focused_seconds"`
+}
+
+func NewDBSessionDayRollup(rollup models.SessionDayRollup, timezone string) DBSessionDayRollup {
+	return DBSessionDayRollup{
+		SessionID:      rollup.SessionID.String(),
+		UserID:         rollup.UserID.String(),
+		Timezone:       timezone,
+		Day:            rollup.Day,
+		FocusedSeconds: rollup.FocusedSeconds,
+	}
+}
+
+type DBDailyFocus struct {
+	Day            time.Time `db:"day" json:"day"`
+	FocusedSeconds int64     `db:"focused_seconds" json:"focused_seconds"`
+}
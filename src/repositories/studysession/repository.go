@@ -7,10 +7,12 @@ import (
 	"fmt"
 	"go-api/src/clients/postgres"
 	models "go-api/src/models/studysession"
+	webhookmodels "go-api/src/models/webhooks"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
-	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
 	"go.uber.org/fx"
 	"go.uber.org/zap"
 )
@@ -18,9 +20,26 @@ import (
 type StudySessionRepository interface {
 	CreateStudySession(ctx context.Context, session models.StudySession, startTime time.Time) (*models.StudySession, error)
 	GetActiveStudySession(ctx context.Context, userID uuid.UUID) (*models.StudySession, error)
-	GetActiveStudySessionEvents(ctx context.Context, userID uuid.UUID) ([]models.SessionEvent, error)
-	AddActiveStudySessionEvents(ctx context.Context, userID uuid.UUID, events []models.SessionEvent) ([]models.SessionEvent, error)
-	FinishActiveStudySession(ctx context.Context, userID uuid.UUID) (*models.StudySession, error)
+	GetActiveStudySessionEvents(ctx context.Context, userID uuid.UUID, after *models.SyncCursor) (*models.SessionEventSync, error)
+	AddActiveStudySessionEvents(ctx context.Context, userID uuid.UUID, events []models.SessionEvent) (*models.SessionEventSync, error)
+	FinishActiveStudySession(ctx context.Context, userID uuid.UUID, options models.FinishOptions) (*models.StudySession, error)
+	ListStudySessions(ctx context.Context, userID uuid.UUID, filter models.HistoryFilter) ([]models.StudySession, error)
+	GetStudySession(ctx context.Context, userID uuid.UUID, sessionID uuid.UUID) (*models.StudySession, error)
+	UpdateActiveStudySession(ctx context.Context, userID uuid.UUID, update models.SessionUpdate) (*models.StudySession, error)
+	UpdateStudySession(ctx context.Context, userID uuid.UUID, sessionID uuid.UUID, update models.SessionUpdate) (*models.StudySession, error)
+	GetStudySessionEvents(ctx context.Context, userID uuid.UUID, sessionID uuid.UUID) ([]models.SessionEvent, error)
+	ListSessionEvents(ctx context.Context, sessionIDs []uuid.UUID) (map[uuid.UUID][]models.SessionEvent, error)
+	ListSessionSubjects(ctx context.Context, sessionIDs []uuid.UUID) (map[uuid.UUID][]models.SessionSubject, error)
+	StreamStudySessions(ctx context.Context, userID uuid.UUID, fn func(models.StudySessionDetails) error) error
+	ListImportKeys(ctx context.Context, userID uuid.UUID, importKeys []string) ([]string, error)
+	ListSessionIntervals(ctx context.Context, userID uuid.UUID, from time.Time, to time.Time) ([]models.SessionInterval, error)
+	ImportStudySessions(ctx context.Context, userID uuid.UUID, sessions []models.ImportedSession) ([]string, error)
+	CreateManualStudySession(ctx context.Context, session models.StudySession, events []models.SessionEvent) (*models.StudySession, error)
+	CancelActiveStudySession(ctx context.Context, userID uuid.UUID) (*models.StudySession, error)
+	DeleteStudySession(ctx context.Context, userID uuid.UUID, sessionID uuid.UUID) error
+	RestoreStudySession(ctx context.Context, userID uuid.UUID, sessionID uuid.UUID, deletedAfter time.Time) (*models.StudySession, error)
+	PurgeDeletedStudySessions(ctx context.Context, deletedBefore time.Time) (int64, error)
+	CloseStaleStudySessions(ctx context.Context, idleBefore time.Time, action models.StaleSessionAction, limit int) ([]models.StudySession, error)
 }
 
 type studySessionRepository struct {
@@ -47,7 +66,7 @@ func (r *studySessionRepository) CreateStudySession(ctx context.Context, session
 	if err != nil {
 		return nil, fmt.Errorf("failed to begin transaction: %w", err)
 	}
-	defer tx.safeRollback(err)
+	defer tx.SafeRollback()
 
 	existingActiveSession, err := tx.getUserActiveSession(ctx, session.UserID.String())
 	if err != nil {
@@ -69,11 +88,20 @@ func (r *studySessionRepository) CreateStudySession(ctx context.Context, session
 		Date:         startTime.UTC(),
 		SessionState: string(models.SessionStateActive),
 	}
+	if session.Pomodoro != nil {
+		dbSession.setPomodoroSettings(session.Pomodoro.Settings)
+	}
 
-	query := `INSERT INTO 
-				study_sessions (id, user_id, title, notes, date, session_state)
-				VALUES (:id, :user_id, :title, :notes, :date, :session_state)`
-	_, err = tx.NamedExecContext(ctx, query, dbSession)
+	query, params, err := tx.BindNamed(`INSERT INTO 
+				study_sessions (id, user_id, title, notes, date, session_state, pomodoro_work_minutes,
+					pomodoro_short_break_minutes, pomodoro_long_break_minutes, pomodoro_cycles)
+				VALUES (:id, :user_id, :title, :notes, :date, :session_state, :pomodoro_work_minutes,
+					:pomodoro_short_break_minutes, :pomodoro_long_break_minutes, :pomodoro_cycles)
+				RETURNING *`, dbSession)
+	if err != nil {
+		return nil, fmt.Errorf("failed to bind study session: %w", err)
+	}
+	err = tx.GetContext(ctx, &dbSession, query, params...)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create study session: %w", err)
 	}
@@ -87,38 +115,106 @@ func (r *studySessionRepository) CreateStudySession(ctx context.Context, session
 		return nil, fmt.Errorf("failed to create start event: %w", err)
 	}
 
+	if err = tx.replaceSessionSubjects(ctx, dbSession.UserID, dbSession.ID, session.Subjects); err != nil {
+		return nil, err
+	}
+	if err = tx.enqueueWebhookEvent(ctx, &dbSession, webhookmodels.EventTypeStarted); err != nil {
+		return nil, err
+	}
+
 	if err = tx.Commit(); err != nil {
 		return nil, fmt.Errorf("commit failed: %w", err)
 	}
 	return dbSession.ToStudySession()
 }
 
-func (r *studySessionRepository) AddActiveStudySessionEvents(ctx context.Context, userID uuid.UUID, events []models.SessionEvent) ([]models.SessionEvent, error) {
+// AddActiveStudySessionEvents records the events sent by a client. Events
+// whose id is already recorded in the session are replays and are skipped,
+// an id recorded in another session is rejected. Late events are
+// merged into the timeline by event time, the pomodoro phases recorded after
+// them are derived again. The sync lists the inserted events in Added.
+func (r *studySessionRepository) AddActiveStudySessionEvents(ctx context.Context, userID uuid.UUID, events []models.SessionEvent) (*models.SessionEventSync, error) {
 	tx, err := r.beginTransaction(ctx, nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to begin transaction: %w", err)
 	}
-	defer tx.safeRollback(err)
+	defer tx.SafeRollback()
 
-	activeSession, err := tx.getUserActiveSession(ctx, userID.String())
+	activeSession, err := tx.lockUserActiveSession(ctx, userID.String())
 	if err != nil {
 		return nil, fmt.Errorf("failed to check active sessions: %w", err)
 	}
 	if activeSession == nil {
 		return nil, models.ErrActiveSessionNotFound
 	}
-	dbEvents := make([]DBSessionEvent, len(events))
-	for i, event := range events {
-		dbEvents[i] = DBSessionEvent{
-			SessionID: activeSession.ID,
-			EventType: string(event.EventType),
-			EventTime: event.EventTime.UTC(),
+
+	existingEvents, err := tx.getSessionEvents(ctx, activeSession.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed get session events: %w", err)
+	}
+	storedEvents := make([]models.SessionEvent, len(existingEvents))
+	for i, existingEvent := range existingEvents {
+		storedEvents[i] = existingEvent.ToSessionEvent()
+	}
+	recorded, err := tx.getRecordedEventSessions(ctx, events)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check recorded events: %w", err)
+	}
+	newEvents := make([]models.SessionEvent, 0, len(events))
+	for _, event := range events {
+		if event.ID != uuid.Nil {
+			// Only an id recorded in the active session is a replay, ids are
+			// unique across sessions
+			if sessionID, ok := recorded[event.ID]; ok {
+				if sessionID != activeSession.ID {
+					return nil, &models.InvalidEventError{Event: event, Reason: "id is already used by another event"}
+				}
+				continue
+			}
+			recorded[event.ID] = activeSession.ID
 		}
+		// Match the precision of the stored events, pomodoro phases are
+		// computed from both
+		event.EventTime = event.EventTime.Round(time.Microsecond)
+		newEvents = append(newEvents, event)
+	}
+	models.SortEvents(newEvents)
+	if err := models.ValidateSyncedEvents(storedEvents, newEvents, time.Now()); err != nil {
+		return nil, err
 	}
 
-	err = tx.createSessionEvents(ctx, dbEvents)
-	if err != nil {
-		return nil, fmt.Errorf("failed to insert events: %w", err)
+	if len(newEvents) > 0 {
+		firstEventTime := newEvents[0].EventTime
+		if len(storedEvents) > 0 && firstEventTime.Before(storedEvents[len(storedEvents)-1].EventTime) {
+			storedEvents, err = tx.deletePhaseEventsSince(ctx, activeSession.ID, storedEvents, firstEventTime)
+			if err != nil {
+				return nil, fmt.Errorf("failed to delete pomodoro phases: %w", err)
+			}
+		}
+
+		dbEvents := make([]DBSessionEvent, len(newEvents))
+		for i, event := range newEvents {
+			dbEvents[i] = newDBSessionEvent(activeSession.ID, event)
+		}
+		timeline := append(storedEvents, newEvents...)
+		models.SortEvents(timeline)
+		until := timeline[len(timeline)-1].EventTime
+		dbEvents = append(dbEvents, newPhaseEvents(activeSession, timeline, until)...)
+
+		err = tx.createSessionEvents(ctx, dbEvents)
+		if err != nil {
+			return nil, fmt.Errorf("failed to insert events: %w", err)
+		}
+		// One event per transition, in time order
+		for _, event := range newEvents {
+			eventType, ok := timerWebhookEventType(event.EventType)
+			if !ok {
+				continue
+			}
+			if err = tx.enqueueWebhookEvent(ctx, activeSession, eventType); err != nil {
+				return nil, err
+			}
+		}
 	}
 
 	dbSessionEvents, err := tx.getSessionEvents(ctx, activeSession.ID)
@@ -129,35 +225,121 @@ func (r *studySessionRepository) AddActiveStudySessionEvents(ctx context.Context
 	if err = tx.Commit(); err != nil {
 		return nil, fmt.Errorf("commit failed: %w", err)
 	}
-	sessionEvents := make([]models.SessionEvent, len(dbSessionEvents))
-	for i, dbSessionEvent := range dbSessionEvents {
-		sessionEvents[i] = dbSessionEvent.ToSessionEvent()
+	sync, err := newSessionEventSync(activeSession.ID, dbSessionEvents, 0)
+	if err != nil {
+		return nil, err
 	}
-
-	return sessionEvents, nil
+	sync.Added = newEvents
+	return sync, nil
 }
 
-func (r *studySessionRepository) FinishActiveStudySession(ctx context.Context, userID uuid.UUID) (*models.StudySession, error) {
+func (r *studySessionRepository) FinishActiveStudySession(ctx context.Context, userID uuid.UUID, options models.FinishOptions) (*models.StudySession, error) {
 	tx, err := r.beginTransaction(ctx, nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to begin transaction: %w", err)
 	}
-	defer tx.safeRollback(err)
+	defer tx.SafeRollback()
 
-	activeSession, err := tx.getUserActiveSession(ctx, userID.String())
+	activeSession, err := tx.lockUserActiveSession(ctx, userID.String())
 	if err != nil {
 		return nil, fmt.Errorf("failed to check active sessions: %w", err)
 	}
 	if activeSession == nil {
 		return nil, models.ErrActiveSessionNotFound
 	}
+
+	dbEvents, err := tx.getSessionEvents(ctx, activeSession.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed get session events: %w", err)
+	}
+	storedEvents := make([]models.SessionEvent, len(dbEvents))
+	for i, dbEvent := range dbEvents {
+		storedEvents[i] = dbEvent.ToSessionEvent()
+	}
+
+	now := time.Now().UTC().Round(time.Microsecond)
+	stopEvent := models.SessionEvent{
+		EventType: models.EventTypeStop,
+		EventTime: now,
+	}
+	switch {
+	case options.AtLastPause:
+		lastEvent, _ := models.LastActivity(storedEvents)
+		if models.StatusAfter(storedEvents) != models.TimerStatusPaused || lastEvent.EventType != models.EventTypePause {
+			return nil, &models.InvalidEventError{Event: stopEvent, Reason: "session is not paused"}
+		}
+		stopEvent.EventTime = lastEvent.EventTime
+	case !options.FinishedAt.IsZero():
+		stopEvent.EventTime = options.FinishedAt.UTC().Round(time.Microsecond)
+	}
+	if err := models.ValidateNewEvents(storedEvents, []models.SessionEvent{stopEvent}, now); err != nil {
+		return nil, err
+	}
+
 	activeSession.SessionState = string(models.SessionStateCompleted)
 
-	_, err = tx.ExecContext(ctx,
-		"UPDATE study_sessions SET session_state = $1 WHERE id = $2 AND session_state = $3",
+	err = tx.QueryRowxContext(ctx,
+		"UPDATE study_sessions SET session_state = $1 WHERE id = $2 AND session_state = $3 RETURNING updated_at",
 		string(models.SessionStateCompleted),
 		activeSession.ID,
 		string(models.SessionStateActive),
+	).Scan(&activeSession.UpdatedAt)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update session state: %w", err)
+	}
+
+	dbStopEvents := []DBSessionEvent{{
+		SessionID: activeSession.ID,
+		EventType: string(stopEvent.EventType),
+		EventTime: stopEvent.EventTime,
+	}}
+	dbStopEvents = append(dbStopEvents, newPhaseEvents(activeSession, append(storedEvents, stopEvent), stopEvent.EventTime)...)
+	err = tx.createSessionEvents(ctx, dbStopEvents)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create end event: %w", err)
+	}
+	if err = tx.enqueueWebhookEvent(ctx, activeSession, webhookmodels.EventTypeFinished); err != nil {
+		return nil, err
+	}
+
+	// Commit transaction
+	if err = tx.Commit(); err != nil {
+		return nil, fmt.Errorf("commit failed: %w", err)
+	}
+
+	return activeSession.ToStudySession()
+}
+
+// CancelActiveStudySession abandons the active session, its timeline is
+// closed with a stop event so it no longer accumulates time
+func (r *studySessionRepository) CancelActiveStudySession(ctx context.Context, userID uuid.UUID) (*models.StudySession, error) {
+	tx, err := r.beginTransaction(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.SafeRollback()
+
+	activeSession, err := tx.lockUserActiveSession(ctx, userID.String())
+	if err != nil {
+		return nil, fmt.Errorf("failed to check active sessions: %w", err)
+	}
+	if activeSession == nil {
+		return nil, models.ErrActiveSessionNotFound
+	}
+
+	dbEvents, err := tx.getSessionEvents(ctx, activeSession.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed get session events: %w", err)
+	}
+	stopTime := time.Now().UTC()
+	if len(dbEvents) > 0 && dbEvents[len(dbEvents)-1].EventTime.After(stopTime) {
+		stopTime = dbEvents[len(dbEvents)-1].EventTime
+	}
+
+	err = tx.GetContext(ctx, activeSession,
+		"UPDATE study_sessions SET session_state = $1 WHERE id = $2 RETURNING *",
+		string(models.SessionStateAbandoned),
+		activeSession.ID,
 	)
 	if err != nil {
 		return nil, fmt.Errorf("failed to update session state: %w", err)
@@ -166,26 +348,248 @@ func (r *studySessionRepository) FinishActiveStudySession(ctx context.Context, u
 	err = tx.createSessionEvents(ctx, []DBSessionEvent{{
 		SessionID: activeSession.ID,
 		EventType: string(models.EventTypeStop),
-		EventTime: time.Now().UTC(),
+		EventTime: stopTime,
 	}})
 	if err != nil {
 		return nil, fmt.Errorf("failed to create end event: %w", err)
 	}
+	if err = tx.enqueueWebhookEvent(ctx, activeSession, webhookmodels.EventTypeCanceled); err != nil {
+		return nil, err
+	}
 
-	// Commit transaction
 	if err = tx.Commit(); err != nil {
 		return nil, fmt.Errorf("commit failed: %w", err)
 	}
-
 	return activeSession.ToStudySession()
 }
 
+// DeleteStudySession soft deletes a finished session, it stays restorable
+// until it's purged
+func (r *studySessionRepository) DeleteStudySession(ctx context.Context, userID uuid.UUID, sessionID uuid.UUID) error {
+	tx, err := r.beginTransaction(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.SafeRollback()
+
+	session, err := tx.lockUserSession(ctx, userID.String(), sessionID.String())
+	if err != nil {
+		return fmt.Errorf("failed to get session: %w", err)
+	}
+	if session == nil {
+		return models.ErrSessionNotFound
+	}
+	if session.SessionState == string(models.SessionStateActive) {
+		return models.ErrSessionIsActive
+	}
+
+	err = tx.GetContext(ctx, session,
+		"UPDATE study_sessions SET deleted_at = $1 WHERE id = $2 RETURNING *",
+		time.Now().UTC(), session.ID,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to delete session: %w", err)
+	}
+	if err = tx.enqueueWebhookEvent(ctx, session, webhookmodels.EventTypeDeleted); err != nil {
+		return err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("commit failed: %w", err)
+	}
+	return nil
+}
+
+// RestoreStudySession undoes the deletion of a session deleted after the given time
+func (r *studySessionRepository) RestoreStudySession(ctx context.Context, userID uuid.UUID, sessionID uuid.UUID, deletedAfter time.Time) (*models.StudySession, error) {
+	tx, err := r.beginTransaction(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.SafeRollback()
+
+	var session DBStudySession
+	err = tx.GetContext(ctx, &session,
+		`UPDATE study_sessions SET deleted_at = NULL
+		WHERE id = $1 AND user_id = $2 AND deleted_at IS NOT NULL AND deleted_at > $3
+		RETURNING *`,
+		sessionID.String(), userID.String(), deletedAfter.UTC(),
+	)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, models.ErrSessionNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to restore session: %w", err)
+	}
+	if err = tx.enqueueWebhookEvent(ctx, &session, webhookmodels.EventTypeRestored); err != nil {
+		return nil, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, fmt.Errorf("commit failed: %w", err)
+	}
+	return session.ToStudySession()
+}
+
+// PurgeDeletedStudySessions hard deletes the sessions deleted before the
+// given time, their events are removed by the foreign key cascade
+func (r *studySessionRepository) PurgeDeletedStudySessions(ctx context.Context, deletedBefore time.Time) (int64, error) {
+	tx, err := r.beginTransaction(ctx, nil)
+	if err != nil {
+		return 0, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.SafeRollback()
+
+	result, err := tx.ExecContext(ctx,
+		"DELETE FROM study_sessions WHERE deleted_at IS NOT NULL AND deleted_at <= $1",
+		deletedBefore.UTC(),
+	)
+	if err != nil {
+		return 0, fmt.Errorf("failed to purge sessions: %w", err)
+	}
+	purged, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to count purged sessions: %w", err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return 0, fmt.Errorf("commit failed: %w", err)
+	}
+	return purged, nil
+}
+
+// staleSessionsQuery selects the active sessions whose last timer event is
+// older than $3 and isn't one of the types in $4, sessions locked by a user
+// request are skipped and picked up by the next sweep
+const staleSessionsQuery = `
+    SELECT s.* FROM study_sessions s
+    JOIN LATERAL (
+        SELECT e.event_type, e.event_time FROM session_events e
+        WHERE e.session_id = s.id AND e.event_type = ANY($2)
+        ORDER BY e.event_time DESC
+        LIMIT 1
+    ) last_event ON true
+    WHERE s.session_state = $1
+        AND last_event.event_time < $3
+        AND last_event.event_type <> ALL($4)
+    ORDER BY last_event.event_time
+    LIMIT $5
+    FOR UPDATE OF s SKIP LOCKED
+`
+
+// CloseStaleStudySessions applies the action to at most limit active sessions
+// idle since before the given time and flags them as auto closed. Only one
+// sweep runs at a time across replicas, it returns no sessions when another
+// one holds the lock.
+func (r *studySessionRepository) CloseStaleStudySessions(ctx context.Context, idleBefore time.Time, action models.StaleSessionAction, limit int) ([]models.StudySession, error) {
+	tx, err := r.beginTransaction(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.SafeRollback()
+
+	var locked bool
+	if err := tx.GetContext(ctx, &locked, "SELECT pg_try_advisory_xact_lock(hashtext($1))", "stale_sessions_sweep"); err != nil {
+		return nil, fmt.Errorf("failed to acquire sweep lock: %w", err)
+	}
+	if !locked {
+		return nil, nil
+	}
+
+	// Paused sessions are left alone when the action is to pause
+	excludedTypes := []string{}
+	if action == models.StaleSessionActionPause {
+		excludedTypes = append(excludedTypes, string(models.EventTypePause))
+	}
+	timerTypes := []string{
+		string(models.EventTypeStart),
+		string(models.EventTypePause),
+		string(models.EventTypeResume),
+		string(models.EventTypeStop),
+	}
+	var staleSessions []DBStudySession
+	err = tx.SelectContext(ctx, &staleSessions, staleSessionsQuery,
+		string(models.SessionStateActive),
+		pq.Array(timerTypes),
+		idleBefore.UTC(),
+		pq.Array(excludedTypes),
+		limit,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list stale sessions: %w", err)
+	}
+
+	now := time.Now().UTC().Round(time.Microsecond)
+	closed := make([]models.StudySession, 0, len(staleSessions))
+	for i := range staleSessions {
+		staleSession := &staleSessions[i]
+		dbEvents, err := tx.getSessionEvents(ctx, staleSession.ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed get session events: %w", err)
+		}
+		storedEvents := make([]models.SessionEvent, len(dbEvents))
+		for j, dbEvent := range dbEvents {
+			storedEvents[j] = dbEvent.ToSessionEvent()
+		}
+		closeEvent, ok := models.StaleSessionEvent(storedEvents, action)
+		if !ok {
+			continue
+		}
+		if err := models.ValidateNewEvents(storedEvents, []models.SessionEvent{closeEvent}, now); err != nil {
+			r.logger.Warn("Skipping stale session", zap.String("session_id", staleSession.ID), zap.Error(err))
+			continue
+		}
+
+		if closeEvent.EventType == models.EventTypeStop {
+			staleSession.SessionState = string(models.SessionStateCompleted)
+		}
+		err = tx.QueryRowxContext(ctx,
+			"UPDATE study_sessions SET session_state = $1, auto_closed_at = $2 WHERE id = $3 RETURNING updated_at",
+			staleSession.SessionState,
+			now,
+			staleSession.ID,
+		).Scan(&staleSession.UpdatedAt)
+		if err != nil {
+			return nil, fmt.Errorf("failed to close stale session: %w", err)
+		}
+		staleSession.AutoClosedAt = &now
+
+		dbCloseEvents := []DBSessionEvent{{
+			SessionID: staleSession.ID,
+			EventType: string(closeEvent.EventType),
+			EventTime: closeEvent.EventTime.UTC(),
+		}}
+		dbCloseEvents = append(dbCloseEvents, newPhaseEvents(staleSession, append(storedEvents, closeEvent), closeEvent.EventTime)...)
+		if err := tx.createSessionEvents(ctx, dbCloseEvents); err != nil {
+			return nil, fmt.Errorf("failed to create close event: %w", err)
+		}
+		eventType := webhookmodels.EventTypePaused
+		if closeEvent.EventType == models.EventTypeStop {
+			eventType = webhookmodels.EventTypeFinished
+		}
+		if err := tx.enqueueWebhookEvent(ctx, staleSession, eventType); err != nil {
+			return nil, err
+		}
+
+		session, err := staleSession.ToStudySession()
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse study session: %w", err)
+		}
+		closed = append(closed, *session)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, fmt.Errorf("commit failed: %w", err)
+	}
+	return closed, nil
+}
+
 func (r *studySessionRepository) GetActiveStudySession(ctx context.Context, userID uuid.UUID) (*models.StudySession, error) {
 	tx, err := r.beginTransaction(ctx, nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to begin transaction: %w", err)
 	}
-	defer tx.safeRollback(err)
+	defer tx.SafeRollback()
 
 	activeSession, err := tx.getUserActiveSession(ctx, userID.String())
 	if err != nil {
@@ -197,12 +601,15 @@ func (r *studySessionRepository) GetActiveStudySession(ctx context.Context, user
 	return activeSession.ToStudySession()
 }
 
-func (r *studySessionRepository) GetActiveStudySessionEvents(ctx context.Context, userID uuid.UUID) ([]models.SessionEvent, error) {
+// GetActiveStudySessionEvents returns the events of the active session
+// recorded after the cursor, or all of them when the cursor is nil or points
+// into another session
+func (r *studySessionRepository) GetActiveStudySessionEvents(ctx context.Context, userID uuid.UUID, after *models.SyncCursor) (*models.SessionEventSync, error) {
 	tx, err := r.beginTransaction(ctx, nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to begin transaction: %w", err)
 	}
-	defer tx.safeRollback(err)
+	defer tx.SafeRollback()
 
 	activeSession, err := tx.getUserActiveSession(ctx, userID.String())
 	if err != nil {
@@ -215,6 +622,102 @@ func (r *studySessionRepository) GetActiveStudySessionEvents(ctx context.Context
 	if err != nil {
 		return nil, fmt.Errorf("failed to get session events: %w", err)
 	}
+	var afterSeq int64
+	if after != nil && after.SessionID.String() == activeSession.ID {
+		afterSeq = after.Seq
+	}
+	return newSessionEventSync(activeSession.ID, dbEvents, afterSeq)
+}
+
+func (r *studySessionRepository) GetStudySession(ctx context.Context, userID uuid.UUID, sessionID uuid.UUID) (*models.StudySession, error) {
+	tx, err := r.beginTransaction(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.SafeRollback()
+
+	session, err := tx.getUserSession(ctx, userID.String(), sessionID.String())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get session: %w", err)
+	}
+	if session == nil {
+		return nil, models.ErrSessionNotFound
+	}
+	return session.ToStudySession()
+}
+
+func (r *studySessionRepository) UpdateActiveStudySession(ctx context.Context, userID uuid.UUID, update models.SessionUpdate) (*models.StudySession, error) {
+	tx, err := r.beginTransaction(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.SafeRollback()
+
+	activeSession, err := tx.lockUserActiveSession(ctx, userID.String())
+	if err != nil {
+		return nil, fmt.Errorf("failed to check active sessions: %w", err)
+	}
+	if activeSession == nil {
+		return nil, models.ErrActiveSessionNotFound
+	}
+	if err := tx.updateSession(ctx, activeSession, update); err != nil {
+		return nil, err
+	}
+	if err = tx.enqueueWebhookEvent(ctx, activeSession, webhookmodels.EventTypeUpdated); err != nil {
+		return nil, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, fmt.Errorf("commit failed: %w", err)
+	}
+	return activeSession.ToStudySession()
+}
+
+func (r *studySessionRepository) UpdateStudySession(ctx context.Context, userID uuid.UUID, sessionID uuid.UUID, update models.SessionUpdate) (*models.StudySession, error) {
+	tx, err := r.beginTransaction(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.SafeRollback()
+
+	session, err := tx.lockUserSession(ctx, userID.String(), sessionID.String())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get session: %w", err)
+	}
+	if session == nil {
+		return nil, models.ErrSessionNotFound
+	}
+	if err := tx.updateSession(ctx, session, update); err != nil {
+		return nil, err
+	}
+	if err = tx.enqueueWebhookEvent(ctx, session, webhookmodels.EventTypeUpdated); err != nil {
+		return nil, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, fmt.Errorf("commit failed: %w", err)
+	}
+	return session.ToStudySession()
+}
+
+func (r *studySessionRepository) GetStudySessionEvents(ctx context.Context, userID uuid.UUID, sessionID uuid.UUID) ([]models.SessionEvent, error) {
+	tx, err := r.beginTransaction(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.SafeRollback()
+
+	session, err := tx.getUserSession(ctx, userID.String(), sessionID.String())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get session: %w", err)
+	}
+	if session == nil {
+		return nil, models.ErrSessionNotFound
+	}
+	dbEvents, err := tx.getSessionEvents(ctx, session.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get session events: %w", err)
+	}
 	events := make([]models.SessionEvent, len(dbEvents))
 	for i, dbEvent := range dbEvents {
 		events[i] = dbEvent.ToSessionEvent()
@@ -222,17 +725,503 @@ func (r *studySessionRepository) GetActiveStudySessionEvents(ctx context.Context
 	return events, nil
 }
 
+func (r *studySessionRepository) ListStudySessions(ctx context.Context, userID uuid.UUID, filter models.HistoryFilter) ([]models.StudySession, error) {
+	query := "SELECT * FROM study_sessions WHERE user_id = $1 AND deleted_at IS NULL"
+	params := []any{userID.String()}
+
+	if len(filter.States) > 0 {
+		states := make([]string, len(filter.States))
+		for i, state := range filter.States {
+			states[i] = string(state)
+		}
+		params = append(params, pq.Array(states))
+		query += fmt.Sprintf(" AND session_state = ANY($%d)", len(params))
+	}
+	if filter.From != nil {
+		params = append(params, filter.From.UTC())
+		query += fmt.Sprintf(" AND date >= $%d::date", len(params))
+	}
+	if filter.To != nil {
+		params = append(params, filter.To.UTC())
+		query += fmt.Sprintf(" AND date <= $%d::date", len(params))
+	}
+	if filter.Title != "" {
+		params = append(params, "%"+escapeLikePattern(filter.Title)+"%")
+		query += fmt.Sprintf(" AND title ILIKE $%d", len(params))
+	}
+	if filter.AutoClosed {
+		query += " AND auto_closed_at IS NOT NULL"
+	}
+	if filter.Cursor != nil {
+		params = append(params, filter.Cursor.Date.UTC(), filter.Cursor.ID.String())
+		query += fmt.Sprintf(" AND (date, id) < ($%d::date, $%d::uuid)", len(params)-1, len(params))
+	}
+	query += " ORDER BY date DESC, id DESC"
+	if filter.Limit > 0 {
+		params = append(params, filter.Limit)
+		query += fmt.Sprintf(" LIMIT $%d", len(params))
+	}
+
+	var dbSessions []DBStudySession
+	if err := r.pgclient.QuerySelect(ctx, &dbSessions, query, params...); err != nil {
+		return nil, fmt.Errorf("failed to list study sessions: %w", err)
+	}
+	sessions := make([]models.StudySession, len(dbSessions))
+	for i, dbSession := range dbSessions {
+		session, err := dbSession.ToStudySession()
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse study session: %w", err)
+		}
+		sessions[i] = *session
+	}
+	return sessions, nil
+}
+
+// ListSessionEvents returns the events of the given sessions grouped by session id
+func (r *studySessionRepository) ListSessionEvents(ctx context.Context, sessionIDs []uuid.UUID) (map[uuid.UUID][]models.SessionEvent, error) {
+	eventsBySession := make(map[uuid.UUID][]models.SessionEvent, len(sessionIDs))
+	if len(sessionIDs) == 0 {
+		return eventsBySession, nil
+	}
+	ids := make([]string, len(sessionIDs))
+	for i, sessionID := range sessionIDs {
+		ids[i] = sessionID.String()
+	}
+
+	var dbEvents []DBSessionEvent
+	err := r.pgclient.QuerySelect(ctx, &dbEvents,
+		"SELECT * FROM session_events WHERE session_id = ANY($1) ORDER BY event_time, id",
+		pq.Array(ids),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list session events: %w", err)
+	}
+	for _, dbEvent := range dbEvents {
+		sessionID, err := uuid.Parse(dbEvent.SessionID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse session id: %w", err)
+		}
+		eventsBySession[sessionID] = append(eventsBySession[sessionID], dbEvent.ToSessionEvent())
+	}
+	return eventsBySession, nil
+}
+
+// ListSessionSubjects returns the subjects of the given sessions grouped by session id
+func (r *studySessionRepository) ListSessionSubjects(ctx context.Context, sessionIDs []uuid.UUID) (map[uuid.UUID][]models.SessionSubject, error) {
+	subjectsBySession := make(map[uuid.UUID][]models.SessionSubject, len(sessionIDs))
+	if len(sessionIDs) == 0 {
+		return subjectsBySession, nil
+	}
+	ids := make([]string, len(sessionIDs))
+	for i, sessionID := range sessionIDs {
+		ids[i] = sessionID.String()
+	}
+
+	var dbSubjects []DBSessionSubject
+	err := r.pgclient.QuerySelect(ctx, &dbSubjects,
+		"SELECT * FROM session_subjects WHERE session_id = ANY($1) ORDER BY subject_id",
+		pq.Array(ids),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list session subjects: %w", err)
+	}
+	for _, dbSubject := range dbSubjects {
+		sessionID, err := uuid.Parse(dbSubject.SessionID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse session id: %w", err)
+		}
+		subject, err := dbSubject.ToSessionSubject()
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse session subject: %w", err)
+		}
+		subjectsBySession[sessionID] = append(subjectsBySession[sessionID], *subject)
+	}
+	return subjectsBySession, nil
+}
+
+// StreamStudySessions calls fn with every session of the user along with its
+// events and subjects, oldest first. Rows are read from a cursor so that only
+// one session is held in memory at a time. An error returned by fn stops the
+// iteration and is returned as is.
+func (r *studySessionRepository) StreamStudySessions(ctx context.Context, userID uuid.UUID, fn func(models.StudySessionDetails) error) error {
+	rows, err := r.pgclient.QueryRows(ctx,
+		`SELECT s.*, e.id AS event_id, e.event_type, e.event_time, e.device_id AS event_device_id
+		FROM (
+			SELECT s.*, COALESCE((
+				SELECT json_agg(json_build_object('subject_id', ss.subject_id, 'percentage', ss.percentage) ORDER BY ss.subject_id)
+				FROM session_subjects ss
+				WHERE ss.session_id = s.id
+			), '[]') AS subjects
+			FROM study_sessions s
+			WHERE s.user_id = $1 AND s.deleted_at IS NULL
+		) s
+		LEFT JOIN session_events e ON e.session_id = s.id
+		ORDER BY s.date, s.created_at, s.id, e.event_time, e.id`,
+		userID.String(),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to stream study sessions: %w", err)
+	}
+	defer rows.Close()
+
+	var current *models.StudySessionDetails
+	for rows.Next() {
+		var row DBSessionExportRow
+		if err := rows.StructScan(&row); err != nil {
+			return fmt.Errorf("failed to scan study session: %w", err)
+		}
+		if current == nil || current.ID.String() != row.ID {
+			if current != nil {
+				if err := fn(*current); err != nil {
+					return err
+				}
+			}
+			if current, err = row.ToStudySessionDetails(); err != nil {
+				return fmt.Errorf("failed to parse study session: %w", err)
+			}
+		}
+		if event := row.ToSessionEvent(); event != nil {
+			current.Events = append(current.Events, *event)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("failed to stream study sessions: %w", err)
+	}
+	if current != nil {
+		return fn(*current)
+	}
+	return nil
+}
+
+// ListImportKeys returns the given import keys that were already imported,
+// deleted sessions keep their key until they are purged
+func (r *studySessionRepository) ListImportKeys(ctx context.Context, userID uuid.UUID, importKeys []string) ([]string, error) {
+	existingKeys := []string{}
+	if len(importKeys) == 0 {
+		return existingKeys, nil
+	}
+	err := r.pgclient.QuerySelect(ctx, &existingKeys,
+		"SELECT import_key FROM study_sessions WHERE user_id = $1 AND import_key = ANY($2)",
+		userID.String(), pq.Array(importKeys),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list import keys: %w", err)
+	}
+	return existingKeys, nil
+}
+
+// sessionIntervalsQuery selects the sessions that ran at some point between
+// from ($7) and to ($6), see sessionIntervalsParams
+const sessionIntervalsQuery = `SELECT s.id AS session_id,
+		MIN(e.event_time) FILTER (WHERE e.event_type = $3) AS started_at,
+		MAX(e.event_time) FILTER (WHERE e.event_type = $4) AS stopped_at
+	FROM study_sessions s
+	JOIN session_events e ON e.session_id = s.id
+	WHERE s.user_id = $1 AND s.deleted_at IS NULL AND s.session_state <> $2 AND s.date <= $5::date
+	GROUP BY s.id
+	HAVING MIN(e.event_time) FILTER (WHERE e.event_type = $3) < $6
+		AND (MAX(e.event_time) FILTER (WHERE e.event_type = $4) IS NULL
+			OR MAX(e.event_time) FILTER (WHERE e.event_type = $4) > $7)
+	ORDER BY started_at`
+
+func sessionIntervalsParams(userID string, from time.Time, to time.Time) []any {
+	return []any{
+		userID,
+		string(models.SessionStateAbandoned),
+		string(models.EventTypeStart),
+		string(models.EventTypeStop),
+		to.UTC().Format(time.DateOnly),
+		to.UTC(),
+		from.UTC(),
+	}
+}
+
+// ListSessionIntervals returns the sessions that ran at some point between
+// from and to. Abandoned sessions are left out, active sessions are included
+// as they will run up to at least now.
+func (r *studySessionRepository) ListSessionIntervals(ctx context.Context, userID uuid.UUID, from time.Time, to time.Time) ([]models.SessionInterval, error) {
+	var dbIntervals []DBSessionInterval
+	err := r.pgclient.QuerySelect(ctx, &dbIntervals, sessionIntervalsQuery, sessionIntervalsParams(userID.String(), from, to)...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list session intervals: %w", err)
+	}
+	intervals := make([]models.SessionInterval, len(dbIntervals))
+	for i, dbInterval := range dbIntervals {
+		interval, err := dbInterval.ToSessionInterval()
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse session interval: %w", err)
+		}
+		intervals[i] = *interval
+	}
+	return intervals, nil
+}
+
+// ImportStudySessions creates completed sessions with a start and a stop
+// event. Sessions whose import key already exists are skipped, so importing
+// the same rows concurrently or twice creates them only once. It returns the
+// import keys of the sessions created. It fails with ErrSessionOverlap when a
+// new session overlaps a session created since the rows were validated.
+// Imports are backfills of past sessions, they don't emit webhook events.
+func (r *studySessionRepository) ImportStudySessions(ctx context.Context, userID uuid.UUID, sessions []models.ImportedSession) ([]string, error) {
+	tx, err := r.beginTransaction(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.SafeRollback()
+
+	// Serialize with the manual sessions and the other imports of the user so
+	// that sessions created concurrently can't both pass the overlap check
+	if _, err := tx.ExecContext(ctx, "SELECT pg_advisory_xact_lock(hashtext($1))", "manual_sessions:"+userID.String()); err != nil {
+		return nil, fmt.Errorf("failed to lock manual sessions: %w", err)
+	}
+	sessions, err = tx.newImportedSessions(ctx, userID.String(), sessions)
+	if err != nil {
+		return nil, err
+	}
+	if err := tx.checkImportOverlaps(ctx, userID.String(), sessions); err != nil {
+		return nil, err
+	}
+
+	importedKeys := []string{}
+	for _, session := range sessions {
+		importKey := session.ImportKey
+		dbSession := DBStudySession{
+			UserID:       userID.String(),
+			Title:        session.Title,
+			Notes:        session.Notes,
+			Date:         session.StartedAt.UTC(),
+			SessionState: string(models.SessionStateCompleted),
+			ImportKey:    &importKey,
+		}
+		query, params, err := tx.BindNamed(`INSERT INTO
+				study_sessions (user_id, title, notes, date, session_state, import_key)
+				VALUES (:user_id, :title, :notes, :date, :session_state, :import_key)
+				ON CONFLICT (user_id, import_key) WHERE import_key IS NOT NULL DO NOTHING
+				RETURNING id`, dbSession)
+		if err != nil {
+			return nil, fmt.Errorf("failed to bind study session: %w", err)
+		}
+		if err := tx.GetContext(ctx, &dbSession.ID, query, params...); err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				continue
+			}
+			return nil, fmt.Errorf("failed to import study session: %w", err)
+		}
+
+		err = tx.createSessionEvents(ctx, []DBSessionEvent{
+			{SessionID: dbSession.ID, EventType: string(models.EventTypeStart), EventTime: session.StartedAt.UTC()},
+			{SessionID: dbSession.ID, EventType: string(models.EventTypeStop), EventTime: session.StoppedAt.UTC()},
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to create imported session events: %w", err)
+		}
+		if err = tx.replaceSessionSubjects(ctx, dbSession.UserID, dbSession.ID, session.Subjects); err != nil {
+			return nil, err
+		}
+		importedKeys = append(importedKeys, importKey)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, fmt.Errorf("commit failed: %w", err)
+	}
+	return importedKeys, nil
+}
+
+// CreateManualStudySession records a completed session with the given
+// events. It fails with ErrSessionOverlap when the session overlaps another
+// one, including the active session of the user.
+func (r *studySessionRepository) CreateManualStudySession(ctx context.Context, session models.StudySession, events []models.SessionEvent) (*models.StudySession, error) {
+	tx, err := r.beginTransaction(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.SafeRollback()
+
+	userID := session.UserID.String()
+	// Serialize the manual sessions of the user so that two overlapping
+	// sessions logged concurrently can't both pass the overlap check
+	if _, err := tx.ExecContext(ctx, "SELECT pg_advisory_xact_lock(hashtext($1))", "manual_sessions:"+userID); err != nil {
+		return nil, fmt.Errorf("failed to lock manual sessions: %w", err)
+	}
+	startedAt, stoppedAt := events[0].EventTime, events[len(events)-1].EventTime
+	var overlapping []DBSessionInterval
+	err = tx.SelectContext(ctx, &overlapping, sessionIntervalsQuery, sessionIntervalsParams(userID, startedAt, stoppedAt)...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check overlapping sessions: %w", err)
+	}
+	if len(overlapping) > 0 {
+		return nil, models.ErrSessionOverlap
+	}
+
+	dbSession := DBStudySession{
+		UserID:       userID,
+		Title:        session.Title,
+		Notes:        session.Notes,
+		Date:         startedAt.UTC(),
+		SessionState: string(models.SessionStateCompleted),
+	}
+	query, params, err := tx.BindNamed(`INSERT INTO
+				study_sessions (user_id, title, notes, date, session_state)
+				VALUES (:user_id, :title, :notes, :date, :session_state)
+				RETURNING *`, dbSession)
+	if err != nil {
+		return nil, fmt.Errorf("failed to bind study session: %w", err)
+	}
+	if err = tx.GetContext(ctx, &dbSession, query, params...); err != nil {
+		return nil, fmt.Errorf("failed to create study session: %w", err)
+	}
+
+	dbEvents := make([]DBSessionEvent, len(events))
+	for i, event := range events {
+		dbEvents[i] = DBSessionEvent{
+			SessionID: dbSession.ID,
+			EventType: string(event.EventType),
+			EventTime: event.EventTime.UTC(),
+		}
+	}
+	if err = tx.createSessionEvents(ctx, dbEvents); err != nil {
+		return nil, fmt.Errorf("failed to create session events: %w", err)
+	}
+	if err = tx.replaceSessionSubjects(ctx, dbSession.UserID, dbSession.ID, session.Subjects); err != nil {
+		return nil, err
+	}
+	if err = tx.enqueueWebhookEvent(ctx, &dbSession, webhookmodels.EventTypeCreated); err != nil {
+		return nil, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, fmt.Errorf("commit failed: %w", err)
+	}
+	return dbSession.ToStudySession()
+}
+
+// newImportedSessions drops the sessions whose import key already exists,
+// they were imported before or by a concurrent upload
+func (tx openTransaction) newImportedSessions(ctx context.Context, userID string, sessions []models.ImportedSession) ([]models.ImportedSession, error) {
+	importKeys := make([]string, len(sessions))
+	for i, session := range sessions {
+		importKeys[i] = session.ImportKey
+	}
+	var existingKeys []string
+	err := tx.SelectContext(ctx, &existingKeys,
+		"SELECT import_key FROM study_sessions WHERE user_id = $1 AND import_key = ANY($2)",
+		userID, pq.Array(importKeys),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list import keys: %w", err)
+	}
+	existing := make(map[string]bool, len(existingKeys))
+	for _, key := range existingKeys {
+		existing[key] = true
+	}
+	newSessions := make([]models.ImportedSession, 0, len(sessions))
+	for _, session := range sessions {
+		if !existing[session.ImportKey] {
+			newSessions = append(newSessions, session)
+		}
+	}
+	return newSessions, nil
+}
+
+// checkImportOverlaps fails with ErrSessionOverlap when one of the sessions
+// overlaps an existing session of the user, active sessions are considered
+// running until now
+func (tx openTransaction) checkImportOverlaps(ctx context.Context, userID string, sessions []models.ImportedSession) error {
+	if len(sessions) == 0 {
+		return nil
+	}
+	from, to := sessions[0].StartedAt, sessions[0].StoppedAt
+	for _, session := range sessions[1:] {
+		if session.StartedAt.Before(from) {
+			from = session.StartedAt
+		}
+		if session.StoppedAt.After(to) {
+			to = session.StoppedAt
+		}
+	}
+	var intervals []DBSessionInterval
+	err := tx.SelectContext(ctx, &intervals, sessionIntervalsQuery, sessionIntervalsParams(userID, from, to)...)
+	if err != nil {
+		return fmt.Errorf("failed to check overlapping sessions: %w", err)
+	}
+
+	now := time.Now()
+	for _, interval := range intervals {
+		stoppedAt := now
+		if interval.StoppedAt != nil {
+			stoppedAt = *interval.StoppedAt
+		}
+		for _, session := range sessions {
+			if interval.StartedAt.Before(session.StoppedAt) && stoppedAt.After(session.StartedAt) {
+				return models.ErrSessionOverlap
+			}
+		}
+	}
+	return nil
+}
+
+// newSessionEventSync keeps the events recorded after afterSeq, the cursor
+// points after the last recorded event even when none is kept
+func newSessionEventSync(sessionID string, dbEvents []DBSessionEvent, afterSeq int64) (*models.SessionEventSync, error) {
+	id, err := uuid.Parse(sessionID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse session id: %w", err)
+	}
+	sync := &models.SessionEventSync{
+		Events: []models.SessionEvent{},
+		Cursor: models.SyncCursor{SessionID: id, Seq: afterSeq},
+	}
+	for _, dbEvent := range dbEvents {
+		if dbEvent.Seq > sync.Cursor.Seq {
+			sync.Cursor.Seq = dbEvent.Seq
+		}
+		if dbEvent.Seq > afterSeq {
+			sync.Events = append(sync.Events, dbEvent.ToSessionEvent())
+		}
+	}
+	return sync, nil
+}
+
+// newPhaseEvents returns the pomodoro phase transitions up to until that are
+// not stored yet. Phases are derived from the timer events, so the phase
+// events already stored are always a prefix of the computed ones.
+func newPhaseEvents(session *DBStudySession, events []models.SessionEvent, until time.Time) []DBSessionEvent {
+	settings := session.pomodoroSettings()
+	if settings == nil {
+		return nil
+	}
+	recorded := map[models.SessionEvent]bool{}
+	for _, event := range events {
+		if event.EventType.IsPhase() {
+			recorded[models.SessionEvent{EventType: event.EventType, EventTime: event.EventTime.UTC()}] = true
+		}
+	}
+	var dbEvents []DBSessionEvent
+	for _, event := range models.PomodoroPhaseEvents(*settings, events, until) {
+		event.EventTime = event.EventTime.UTC()
+		if recorded[event] {
+			continue
+		}
+		dbEvents = append(dbEvents, DBSessionEvent{
+			SessionID: session.ID,
+			EventType: string(event.EventType),
+			EventTime: event.EventTime,
+		})
+	}
+	return dbEvents
+}
+
 type openTransaction struct {
-	sqlx.Tx
+	postgres.Transaction
 }
 
 func (r *studySessionRepository) beginTransaction(ctx context.Context, opts *sql.TxOptions) (*openTransaction, error) {
-	tx, err := r.pgclient.BeginTransaction(ctx, nil)
+	tx, err := postgres.NewTransaction(ctx, r.pgclient, opts)
 	if err != nil {
-		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+		return nil, err
 	}
 	return &openTransaction{
-		Tx: *tx,
+		Transaction: *tx,
 	}, nil
 }
 
@@ -252,12 +1241,133 @@ func (tx openTransaction) getUserActiveSession(ctx context.Context, userID strin
 	return &activeSession, nil
 }
 
+// lockUserActiveSession returns the active session and locks it until the
+// transaction ends, so concurrent writes see each other's events
+func (tx openTransaction) lockUserActiveSession(ctx context.Context, userID string) (*DBStudySession, error) {
+	var activeSession DBStudySession
+	err := tx.GetContext(ctx, &activeSession,
+		"SELECT * FROM study_sessions WHERE user_id = $1 AND session_state = $2 FOR UPDATE",
+		userID, string(models.SessionStateActive),
+	)
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &activeSession, nil
+}
+
+// getUserSession returns the session only when it belongs to the given user
+func (tx openTransaction) getUserSession(ctx context.Context, userID string, sessionID string) (*DBStudySession, error) {
+	var session DBStudySession
+	err := tx.GetContext(ctx, &session,
+		"SELECT * FROM study_sessions WHERE id = $1 AND user_id = $2 AND deleted_at IS NULL",
+		sessionID, userID,
+	)
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &session, nil
+}
+
+// lockUserSession works like getUserSession but locks the session until the
+// transaction ends
+func (tx openTransaction) lockUserSession(ctx context.Context, userID string, sessionID string) (*DBStudySession, error) {
+	var session DBStudySession
+	err := tx.GetContext(ctx, &session,
+		"SELECT * FROM study_sessions WHERE id = $1 AND user_id = $2 AND deleted_at IS NULL FOR UPDATE",
+		sessionID, userID,
+	)
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &session, nil
+}
+
+// updateSession applies the update to a session locked by the transaction,
+// the stored row is copied back into session
+func (tx openTransaction) updateSession(ctx context.Context, session *DBStudySession, update models.SessionUpdate) error {
+	if update.IfUpdatedAt != nil && !session.UpdatedAt.Equal(*update.IfUpdatedAt) {
+		return models.ErrSessionModified
+	}
+	if update.Title != nil {
+		session.Title = *update.Title
+	}
+	if update.Notes != nil {
+		session.Notes = *update.Notes
+	}
+
+	err := tx.GetContext(ctx, session,
+		"UPDATE study_sessions SET title = $1, notes = $2 WHERE id = $3 RETURNING *",
+		session.Title, session.Notes, session.ID,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to update session: %w", err)
+	}
+	if update.Subjects != nil {
+		return tx.replaceSessionSubjects(ctx, session.UserID, session.ID, *update.Subjects)
+	}
+	return nil
+}
+
+// replaceSessionSubjects sets the subjects of a session, all of them must
+// belong to the session user
+func (tx openTransaction) replaceSessionSubjects(ctx context.Context, userID string, sessionID string, subjects []models.SessionSubject) error {
+	_, err := tx.ExecContext(ctx, "DELETE FROM session_subjects WHERE session_id = $1", sessionID)
+	if err != nil {
+		return fmt.Errorf("failed to clear session subjects: %w", err)
+	}
+	if len(subjects) == 0 {
+		return nil
+	}
+
+	subjectIDs := make([]string, len(subjects))
+	for i, subject := range subjects {
+		subjectIDs[i] = subject.SubjectID.String()
+	}
+	var ownedSubjects int
+	err = tx.GetContext(ctx, &ownedSubjects,
+		"SELECT count(*) FROM subjects WHERE user_id = $1 AND id = ANY($2)",
+		userID, pq.Array(subjectIDs),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to check session subjects: %w", err)
+	}
+	if ownedSubjects != len(subjects) {
+		return models.ErrSubjectNotFound
+	}
+
+	query := "INSERT INTO session_subjects (session_id, subject_id, percentage) VALUES "
+	var params []any
+	for i, subject := range subjects {
+		if i > 0 {
+			query += ", "
+		}
+		query += fmt.Sprintf("($%d, $%d, $%d)", len(params)+1, len(params)+2, len(params)+3)
+		params = append(params, sessionID, subject.SubjectID.String(), subject.Percentage)
+	}
+	if _, err := tx.ExecContext(ctx, query, params...); err != nil {
+		return fmt.Errorf("failed to insert session subjects: %w", err)
+	}
+	return nil
+}
+
 func (tx openTransaction) getSessionEvents(ctx context.Context, sessionID string) ([]DBSessionEvent, error) {
 	var sessionEvents []DBSessionEvent
 	err := tx.SelectContext(
 		ctx,
 		&sessionEvents,
-		"SELECT * FROM session_events WHERE session_id = $1",
+		"SELECT * FROM session_events WHERE session_id = $1 ORDER BY event_time, id",
 		sessionID,
 	)
 
@@ -270,13 +1380,70 @@ func (tx openTransaction) getSessionEvents(ctx context.Context, sessionID string
 	return sessionEvents, nil
 }
 
+// getRecordedEventSessions returns the session of the client generated event
+// ids that are already recorded, in any session
+func (tx openTransaction) getRecordedEventSessions(ctx context.Context, events []models.SessionEvent) (map[uuid.UUID]string, error) {
+	var ids []string
+	for _, event := range events {
+		if event.ID != uuid.Nil {
+			ids = append(ids, event.ID.String())
+		}
+	}
+	recorded := make(map[uuid.UUID]string, len(ids))
+	if len(ids) == 0 {
+		return recorded, nil
+	}
+	var recordedEvents []DBSessionEvent
+	err := tx.SelectContext(ctx, &recordedEvents,
+		"SELECT id, session_id FROM session_events WHERE id = ANY($1::uuid[])",
+		pq.Array(ids),
+	)
+	if err != nil {
+		return nil, err
+	}
+	for _, recordedEvent := range recordedEvents {
+		id, err := uuid.Parse(recordedEvent.ID)
+		if err != nil {
+			return nil, err
+		}
+		recorded[id] = recordedEvent.SessionID
+	}
+	return recorded, nil
+}
+
+// deletePhaseEventsSince removes the pomodoro phases recorded from the given
+// time on, they no longer match a timeline receiving late events. It returns
+// the stored events without them.
+func (tx openTransaction) deletePhaseEventsSince(ctx context.Context, sessionID string, storedEvents []models.SessionEvent, since time.Time) ([]models.SessionEvent, error) {
+	_, err := tx.ExecContext(ctx,
+		"DELETE FROM session_events WHERE session_id = $1 AND event_type = ANY($2) AND event_time >= $3",
+		sessionID,
+		pq.Array([]string{
+			string(models.EventTypeWorkPhase),
+			string(models.EventTypeShortBreak),
+			string(models.EventTypeLongBreak),
+		}),
+		since.UTC(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	kept := make([]models.SessionEvent, 0, len(storedEvents))
+	for _, event := range storedEvents {
+		if !event.EventType.IsPhase() || event.EventTime.Before(since) {
+			kept = append(kept, event)
+		}
+	}
+	return kept, nil
+}
+
 func (tx openTransaction) createSessionEvents(ctx context.Context, events []DBSessionEvent) error {
 	if len(events) == 0 {
 		return nil
 	}
 	query := `
         INSERT INTO session_events 
-            (session_id, event_type, event_time) 
+            (id, session_id, event_type, event_time, device_id) 
         VALUES 
     `
 
@@ -287,25 +1454,27 @@ func (tx openTransaction) createSessionEvents(ctx context.Context, events []DBSe
 		if i > 0 {
 			query += ", "
 		}
-		query += fmt.Sprintf("($%d, $%d, $%d)",
-			paramCount, paramCount+1, paramCount+2)
+		query += fmt.Sprintf("($%d, $%d, $%d, $%d, $%d)",
+			paramCount, paramCount+1, paramCount+2, paramCount+3, paramCount+4)
 
+		id := event.ID
+		if id == "" {
+			id = uuid.New().String()
+		}
 		params = append(params,
+			id,
 			event.SessionID,
 			event.EventType,
 			event.EventTime,
+			event.DeviceID,
 		)
-		paramCount += 3
+		paramCount += 5
 	}
 	_, err := tx.ExecContext(ctx, query, params...)
 	return err
 }
 
-func (tx openTransaction) safeRollback(err error) {
-	if p := recover(); p != nil {
-		tx.Rollback()
-		panic(p)
-	} else if err != nil {
-		tx.Rollback()
-	}
+// escapeLikePattern escapes the LIKE wildcards so user input is matched literally
+func escapeLikePattern(value string) string {
+	return strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(value)
 }
@@ -1,6 +1,7 @@
 package studysession
 
 import (
+	"encoding/json"
 	models "go-api/src/models/studysession"
 	"time"
 
@@ -12,24 +13,81 @@ type DBSessionEvent struct {
 	SessionID string    `db:"session_id" json:"session_id"`
 	EventType string    `db:"event_type" json:"event_type"`
 	EventTime time.Time `db:"event_time" json:"event_time"`
+	Seq       int64     `db:"seq" json:"seq"`
+	DeviceID  *string   `db:"device_id" json:"device_id"`
+}
+
+type DBSessionSubject struct {
+	SessionID  string `db:"session_id" json:"session_id"`
+	SubjectID  string `db:"subject_id" json:"subject_id"`
+	Percentage *int   `db:"percentage" json:"percentage"`
 }
 
 type DBStudySession struct {
-	ID           string    `db:"id" json:"id"`
-	UserID       string    `db:"user_id" json:"user_id"`
-	Title        string    `db:"title" json:"title"`
-	Notes        string    `db:"notes" json:"notes"`
-	Date         time.Time `db:"date" json:"date"`
-	SessionState string    `db:"session_state" json:"session_state"`
-	CreatedAt    time.Time `db:"created_at" json:"created_at"`
-	UpdatedAt    time.Time `db:"updated_at" json:"updated_at"`
+	ID           string     `db:"id" json:"id"`
+	UserID       string     `db:"user_id" json:"user_id"`
+	Title        string     `db:"title" json:"title"`
+	Notes        string     `db:"notes" json:"notes"`
+	Date         time.Time  `db:"date" json:"date"`
+	SessionState string     `db:"session_state" json:"session_state"`
+	CreatedAt    time.Time  `db:"created_at" json:"created_at"`
+	UpdatedAt    time.Time  `db:"updated_at" json:"updated_at"`
+	DeletedAt    *time.Time `db:"deleted_at" json:"deleted_at"`
+	ImportKey    *string    `db:"import_key" json:"import_key"`
+	AutoClosedAt *time.Time `db:"auto_closed_at" json:"auto_closed_at"`
+
+	PomodoroWorkMinutes       *int `db:"pomodoro_work_minutes" json:"pomodoro_work_minutes"`
+	PomodoroShortBreakMinutes *int `db:"pomodoro_short_break_minutes" json:"pomodoro_short_break_minutes"`
+	PomodoroLongBreakMinutes  *int `db:"pomodoro_long_break_minutes" json:"pomodoro_long_break_minutes"`
+	PomodoroCycles            *int `db:"pomodoro_cycles" json:"pomodoro_cycles"`
+}
+
+// DBSessionExportRow is a session joined with one of its events, the event
+// columns are null for sessions without events
+type DBSessionExportRow struct {
+	DBStudySession
+	Subjects      []byte     `db:"subjects"`
+	EventID       *string    `db:"event_id"`
+	EventType     *string    `db:"event_type"`
+	EventTime     *time.Time `db:"event_time"`
+	EventDeviceID *string    `db:"event_device_id"`
+}
+
+type DBSessionInterval struct {
+	SessionID string     `db:"session_id"`
+	StartedAt time.Time  `db:"started_at"`
+	StoppedAt *time.Time `db:"stopped_at"`
+}
+
+// newDBSessionEvent keeps the id and device of events sent by clients, the
+// id of other events is generated when they are created
+func newDBSessionEvent(sessionID string, event models.SessionEvent) DBSessionEvent {
+	dbEvent := DBSessionEvent{
+		SessionID: sessionID,
+		EventType: string(event.EventType),
+		EventTime: event.EventTime.UTC(),
+	}
+	if event.ID != uuid.Nil {
+		dbEvent.ID = event.ID.String()
+	}
+	if event.DeviceID != "" {
+		dbEvent.DeviceID = &event.DeviceID
+	}
+	return dbEvent
 }
 
 func (e DBSessionEvent) ToSessionEvent() models.SessionEvent {
-	return models.SessionEvent{
+	// The id column is a UUID, it always parses
+	id, _ := uuid.Parse(e.ID)
+	event := models.SessionEvent{
+		ID:        id,
 		EventType: models.EventType(e.EventType),
 		EventTime: e.EventTime,
 	}
+	if e.DeviceID != nil {
+		event.DeviceID = *e.DeviceID
+	}
+	return event
 }
 
 func (s DBStudySession) ToStudySession() (*models.StudySession, error) {
@@ -41,6 +99,10 @@ func (s DBStudySession) ToStudySession() (*models.StudySession, error) {
 	if err != nil {
 		return nil, err
 	}
+	var pomodoro *models.Pomodoro
+	if settings := s.pomodoroSettings(); settings != nil {
+		pomodoro = &models.Pomodoro{Settings: *settings}
+	}
 	return &models.StudySession{
 		ID:           id,
 		UserID:       userID,
@@ -48,5 +110,86 @@ func (s DBStudySession) ToStudySession() (*models.StudySession, error) {
 		Notes:        s.Notes,
 		Date:         s.Date,
 		SessionState: models.SessionState(s.SessionState),
+		CreatedAt:    s.CreatedAt,
+		UpdatedAt:    s.UpdatedAt,
+		DeletedAt:    s.DeletedAt,
+		AutoClosedAt: s.AutoClosedAt,
+		Pomodoro:     pomodoro,
+	}, nil
+}
+
+// pomodoroSettings returns nil for sessions without pomodoro mode
+func (s DBStudySession) pomodoroSettings() *models.PomodoroSettings {
+	if s.PomodoroWorkMinutes == nil || s.PomodoroShortBreakMinutes == nil ||
+		s.PomodoroLongBreakMinutes == nil || s.PomodoroCycles == nil {
+		return nil
+	}
+	return &models.PomodoroSettings{
+		WorkMinutes:       *s.PomodoroWorkMinutes,
+		ShortBreakMinutes: *s.PomodoroShortBreakMinutes,
+		LongBreakMinutes:  *s.PomodoroLongBreakMinutes,
+		Cycles:            *s.PomodoroCycles,
+	}
+}
+
+// setPomodoroSettings stores the settings of a session in pomodoro mode
+func (s *DBStudySession) setPomodoroSettings(settings models.PomodoroSettings) {
+	s.PomodoroWorkMinutes = &settings.WorkMinutes
+	s.PomodoroShortBreakMinutes = &settings.ShortBreakMinutes
+	s.PomodoroLongBreakMinutes = &settings.LongBreakMinutes
+	s.PomodoroCycles = &settings.Cycles
+}
+
+func (s DBSessionSubject) ToSessionSubject() (*models.SessionSubject, error) {
+	subjectID, err := uuid.Parse(s.SubjectID)
+	if err != nil {
+		return nil, err
+	}
+	return &models.SessionSubject{
+		SubjectID:  subjectID,
+		Percentage: s.Percentage,
+	}, nil
+}
+
+func (r DBSessionExportRow) ToStudySessionDetails() (*models.StudySessionDetails, error) {
+	session, err := r.ToStudySession()
+	if err != nil {
+		return nil, err
+	}
+	session.Subjects = []models.SessionSubject{}
+	if err := json.Unmarshal(r.Subjects, &session.Subjects); err != nil {
+		return nil, err
+	}
+	return &models.StudySessionDetails{
+		StudySession: *session,
+		Events:       []models.SessionEvent{},
+	}, nil
+}
+
+func (r DBSessionExportRow) ToSessionEvent() *models.SessionEvent {
+	if r.EventType == nil || r.EventTime == nil {
+		return nil
+	}
+	event := DBSessionEvent{
+		EventType: *r.EventType,
+		EventTime: *r.EventTime,
+		DeviceID:  r.EventDeviceID,
+	}
+	if r.EventID != nil {
+		event.ID = *r.EventID
+	}
+	sessionEvent := event.ToSessionEvent()
+	return &sessionEvent
+}
+
+func (i DBSessionInterval) ToSessionInterval() (*models.SessionInterval, error) {
+	sessionID, err := uuid.Parse(i.SessionID)
+	if err != nil {
+		return nil, err
+	}
+	return &models.SessionInterval{
+		SessionID: sessionID,
+		StartedAt: i.StartedAt,
+		StoppedAt: i.StoppedAt,
 	}, nil
 }
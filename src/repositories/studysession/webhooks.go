@@ -0,0 +1,127 @@
+package studysession
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	models "go-api/src/models/studysession"
+	webhookmodels "go-api/src/models/webhooks"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// enqueueWebhookEventQuery stores the event and a delivery for each active
+// endpoint of the user subscribed to its type, nothing is stored when there
+// is none
+const enqueueWebhookEventQuery = `
+    WITH endpoints AS (
+        SELECT id FROM webhook_endpoints
+        WHERE user_id = $2 AND active AND $4 = ANY(event_types)
+    ), event AS (
+        INSERT INTO webhook_events (id, user_id, session_id, event_type, payload, created_at)
+        SELECT $1, $2, $3, $4, $5, $6
+        WHERE EXISTS (SELECT 1 FROM endpoints)
+        RETURNING id
+    )
+    INSERT INTO webhook_deliveries (event_id, endpoint_id, next_attempt_at)
+    SELECT event.id, endpoints.id, $6 FROM event, endpoints
+`
+
+// enqueueWebhookEvent adds the change of the session to the webhook outbox.
+// It runs in the transaction of the change, so the event is delivered if and
+// only if the change is committed. It must be called once the session and
+// its events are written.
+func (tx openTransaction) enqueueWebhookEvent(ctx context.Context, session *DBStudySession, eventType webhookmodels.EventType) error {
+	var subscribed bool
+	err := tx.GetContext(ctx, &subscribed,
+		"SELECT EXISTS (SELECT 1 FROM webhook_endpoints WHERE user_id = $1 AND active AND $2 = ANY(event_types))",
+		session.UserID, string(eventType),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to check webhook endpoints: %w", err)
+	}
+	if !subscribed {
+		return nil
+	}
+
+	now := time.Now().UTC().Round(time.Microsecond)
+	snapshot, err := tx.getSessionSnapshot(ctx, session, now)
+	if err != nil {
+		return err
+	}
+	eventID, err := uuid.NewRandom()
+	if err != nil {
+		return fmt.Errorf("failed to create webhook event uuid: %w", err)
+	}
+	payload, err := json.Marshal(webhookmodels.Event{
+		ID:        eventID,
+		Type:      eventType,
+		CreatedAt: now,
+		Data:      webhookmodels.EventData{Session: *snapshot},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to encode webhook event: %w", err)
+	}
+
+	_, err = tx.ExecContext(ctx, enqueueWebhookEventQuery,
+		eventID.String(), session.UserID, session.ID, string(eventType), payload, now,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to enqueue webhook event: %w", err)
+	}
+	return nil
+}
+
+// getSessionSnapshot returns the session with its subjects, durations and
+// pomodoro state as seen by the transaction
+func (tx openTransaction) getSessionSnapshot(ctx context.Context, session *DBStudySession, now time.Time) (*models.StudySession, error) {
+	snapshot, err := session.ToStudySession()
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse study session: %w", err)
+	}
+
+	dbEvents, err := tx.getSessionEvents(ctx, session.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed get session events: %w", err)
+	}
+	events := make([]models.SessionEvent, len(dbEvents))
+	for i, dbEvent := range dbEvents {
+		events[i] = dbEvent.ToSessionEvent()
+	}
+	snapshot.Durations = models.ComputeDurations(events, now)
+	if snapshot.Pomodoro != nil {
+		pomodoro := models.ComputePomodoro(snapshot.Pomodoro.Settings, events, now)
+		snapshot.Pomodoro = &pomodoro
+	}
+
+	var dbSubjects []DBSessionSubject
+	err = tx.SelectContext(ctx, &dbSubjects,
+		"SELECT * FROM session_subjects WHERE session_id = $1 ORDER BY subject_id",
+		session.ID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get session subjects: %w", err)
+	}
+	snapshot.Subjects = make([]models.SessionSubject, len(dbSubjects))
+	for i, dbSubject := range dbSubjects {
+		subject, err := dbSubject.ToSessionSubject()
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse session subject: %w", err)
+		}
+		snapshot.Subjects[i] = *subject
+	}
+	return snapshot, nil
+}
+
+// timerWebhookEventType reports the timer transition made by an event added
+// to the active session, which can only pause or resume it
+func timerWebhookEventType(eventType models.EventType) (webhookmodels.EventType, bool) {
+	switch eventType {
+	case models.EventTypePause:
+		return webhookmodels.EventTypePaused, true
+	case models.EventTypeResume:
+		return webhookmodels.EventTypeResumed, true
+	}
+	return "", false
+}
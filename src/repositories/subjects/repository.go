@@ -0,0 +1,332 @@
+package subjects
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"go-api/src/clients/postgres"
+	models "go-api/src/models/subjects"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/fx"
+	"go.uber.org/zap"
+)
+
+type SubjectRepository interface {
+	CreateSubject(ctx context.Context, subject models.Subject) (*models.Subject, error)
+	ListSubjects(ctx context.Context, userID uuid.UUID, includeArchived bool) ([]models.Subject, error)
+	GetSubject(ctx context.Context, userID uuid.UUID, subjectID uuid.UUID) (*models.Subject, error)
+	UpdateSubject(ctx context.Context, userID uuid.UUID, subjectID uuid.UUID, update models.SubjectUpdate) (*models.Subject, error)
+	SetSubjectArchived(ctx context.Context, userID uuid.UUID, subjectID uuid.UUID, archived bool) (*models.Subject, error)
+	CreateRelation(ctx context.Context, relation models.SubjectRelation) (*models.SubjectRelation, error)
+	ListRelations(ctx context.Context, userID uuid.UUID) ([]models.SubjectRelation, error)
+	DeleteRelation(ctx context.Context, userID uuid.UUID, relationID uuid.UUID) error
+}
+
+type subjectRepository struct {
+	logger   *zap.Logger
+	pgclient postgres.PostgresClient
+}
+
+type SubjectRepositoryParams struct {
+	fx.In
+
+	Logger   *zap.Logger
+	PGClient postgres.PostgresClient
+}
+
+func NewSubjectRepository(p SubjectRepositoryParams) (SubjectRepository, error) {
+	return &subjectRepository{
+		logger:   p.Logger,
+		pgclient: p.PGClient,
+	}, nil
+}
+
+func (r *subjectRepository) CreateSubject(ctx context.Context, subject models.Subject) (*models.Subject, error) {
+	tx, err := r.beginTransaction(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.SafeRollback()
+
+	var dbSubject DBSubject
+	err = tx.GetContext(ctx, &dbSubject,
+		`INSERT INTO subjects (user_id, name, color, icon)
+		VALUES ($1, $2, $3, $4)
+		RETURNING *`,
+		subject.UserID.String(), subject.Name, subject.Color, subject.Icon,
+	)
+	if postgres.IsUniqueViolation(err) {
+		return nil, models.ErrSubjectNameTaken
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to create subject: %w", err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, fmt.Errorf("commit failed: %w", err)
+	}
+	return dbSubject.ToSubject()
+}
+
+func (r *subjectRepository) ListSubjects(ctx context.Context, userID uuid.UUID, includeArchived bool) ([]models.Subject, error) {
+	query := "SELECT * FROM subjects WHERE user_id = $1"
+	if !includeArchived {
+		query += " AND archived_at IS NULL"
+	}
+	query += " ORDER BY lower(name), id"
+
+	var dbSubjects []DBSubject
+	if err := r.pgclient.QuerySelect(ctx, &dbSubjects, query, userID.String()); err != nil {
+		return nil, fmt.Errorf("failed to list subjects: %w", err)
+	}
+	subjects := make([]models.Subject, len(dbSubjects))
+	for i, dbSubject := range dbSubjects {
+		subject, err := dbSubject.ToSubject()
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse subject: %w", err)
+		}
+		subjects[i] = *subject
+	}
+	return subjects, nil
+}
+
+func (r *subjectRepository) GetSubject(ctx context.Context, userID uuid.UUID, subjectID uuid.UUID) (*models.Subject, error) {
+	tx, err := r.beginTransaction(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.SafeRollback()
+
+	subject, err := tx.getUserSubject(ctx, userID.String(), subjectID.String())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get subject: %w", err)
+	}
+	if subject == nil {
+		return nil, models.ErrSubjectNotFound
+	}
+	return subject.ToSubject()
+}
+
+func (r *subjectRepository) UpdateSubject(ctx context.Context, userID uuid.UUID, subjectID uuid.UUID, update models.SubjectUpdate) (*models.Subject, error) {
+	tx, err := r.beginTransaction(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.SafeRollback()
+
+	var dbSubject DBSubject
+	err = tx.GetContext(ctx, &dbSubject,
+		`UPDATE subjects
+		SET name = COALESCE($1, name), color = COALESCE($2, color), icon = COALESCE($3, icon)
+		WHERE id = $4 AND user_id = $5
+		RETURNING *`,
+		update.Name, update.Color, update.Icon, subjectID.String(), userID.String(),
+	)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, models.ErrSubjectNotFound
+	}
+	if postgres.IsUniqueViolation(err) {
+		return nil, models.ErrSubjectNameTaken
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to update subject: %w", err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, fmt.Errorf("commit failed: %w", err)
+	}
+	return dbSubject.ToSubject()
+}
+
+// SetSubjectArchived archives or unarchives a subject. Unarchiving fails when
+// another active subject took its name in the meantime.
+func (r *subjectRepository) SetSubjectArchived(ctx context.Context, userID uuid.UUID, subjectID uuid.UUID, archived bool) (*models.Subject, error) {
+	tx, err := r.beginTransaction(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.SafeRollback()
+
+	query := "UPDATE subjects SET archived_at = NULL WHERE id = $1 AND user_id = $2 RETURNING *"
+	params := []any{subjectID.String(), userID.String()}
+	if archived {
+		query = "UPDATE subjects SET archived_at = COALESCE(archived_at, $3) WHERE id = $1 AND user_id = $2 RETURNING *"
+		params = append(params, time.Now().UTC())
+	}
+
+	var dbSubject DBSubject
+	err = tx.GetContext(ctx, &dbSubject, query, params...)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, models.ErrSubjectNotFound
+	}
+	if postgres.IsUniqueViolation(err) {
+		return nil, models.ErrSubjectNameTaken
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to archive subject: %w", err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, fmt.Errorf("commit failed: %w", err)
+	}
+	return dbSubject.ToSubject()
+}
+
+// CreateRelation adds an edge between two subjects of the user. Relations
+// that would turn the hierarchy or the prerequisites into a cycle are rejected.
+func (r *subjectRepository) CreateRelation(ctx context.Context, relation models.SubjectRelation) (*models.SubjectRelation, error) {
+	tx, err := r.beginTransaction(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.SafeRollback()
+
+	userID := relation.UserID.String()
+	// Serialize the relation changes of the user, so two concurrent edges
+	// can't close a cycle that neither of them sees
+	if _, err := tx.ExecContext(ctx, "SELECT pg_advisory_xact_lock(hashtext($1))", "subject_relations:"+userID); err != nil {
+		return nil, fmt.Errorf("failed to lock subject relations: %w", err)
+	}
+
+	for _, subjectID := range []uuid.UUID{relation.FromSubjectID, relation.ToSubjectID} {
+		subject, err := tx.getUserSubject(ctx, userID, subjectID.String())
+		if err != nil {
+			return nil, fmt.Errorf("failed to get subject: %w", err)
+		}
+		if subject == nil {
+			return nil, models.ErrSubjectNotFound
+		}
+	}
+
+	existing, err := tx.getUserRelations(ctx, userID, relation.RelationType)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get subject relations: %w", err)
+	}
+	for _, existingRelation := range existing {
+		if existingRelation.FromSubjectID == relation.FromSubjectID && existingRelation.ToSubjectID == relation.ToSubjectID {
+			return nil, models.ErrRelationExists
+		}
+		if relation.RelationType == models.RelationTypeParent && existingRelation.ToSubjectID == relation.ToSubjectID {
+			return nil, models.ErrSubjectHasParent
+		}
+	}
+	if models.CreatesCycle(existing, relation.FromSubjectID, relation.ToSubjectID) {
+		return nil, models.ErrRelationCycle
+	}
+
+	var dbRelation DBSubjectRelation
+	err = tx.GetContext(ctx, &dbRelation,
+		`INSERT INTO subject_relations (user_id, from_subject_id, to_subject_id, relation_type)
+		VALUES ($1, $2, $3, $4)
+		RETURNING *`,
+		userID, relation.FromSubjectID.String(), relation.ToSubjectID.String(), string(relation.RelationType),
+	)
+	if postgres.IsUniqueViolation(err) {
+		return nil, models.ErrRelationExists
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to create subject relation: %w", err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, fmt.Errorf("commit failed: %w", err)
+	}
+	return dbRelation.ToSubjectRelation()
+}
+
+func (r *subjectRepository) ListRelations(ctx context.Context, userID uuid.UUID) ([]models.SubjectRelation, error) {
+	var dbRelations []DBSubjectRelation
+	err := r.pgclient.QuerySelect(ctx, &dbRelations,
+		"SELECT * FROM subject_relations WHERE user_id = $1 ORDER BY created_at, id",
+		userID.String(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list subject relations: %w", err)
+	}
+	return toSubjectRelations(dbRelations)
+}
+
+func (r *subjectRepository) DeleteRelation(ctx context.Context, userID uuid.UUID, relationID uuid.UUID) error {
+	tx, err := r.beginTransaction(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.SafeRollback()
+
+	result, err := tx.ExecContext(ctx,
+		"DELETE FROM subject_relations WHERE id = $1 AND user_id = $2",
+		relationID.String(), userID.String(),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to delete subject relation: %w", err)
+	}
+	deleted, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to count deleted relations: %w", err)
+	}
+	if deleted == 0 {
+		return models.ErrRelationNotFound
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("commit failed: %w", err)
+	}
+	return nil
+}
+
+type openTransaction struct {
+	postgres.Transaction
+}
+
+func (r *subjectRepository) beginTransaction(ctx context.Context, opts *sql.TxOptions) (*openTransaction, error) {
+	tx, err := postgres.NewTransaction(ctx, r.pgclient, opts)
+	if err != nil {
+		return nil, err
+	}
+	return &openTransaction{
+		Transaction: *tx,
+	}, nil
+}
+
+func (tx openTransaction) getUserSubject(ctx context.Context, userID string, subjectID string) (*DBSubject, error) {
+	var subject DBSubject
+	err := tx.GetContext(ctx, &subject,
+		"SELECT * FROM subjects WHERE id = $1 AND user_id = $2",
+		subjectID, userID,
+	)
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &subject, nil
+}
+
+func (tx openTransaction) getUserRelations(ctx context.Context, userID string, relationType models.RelationType) ([]models.SubjectRelation, error) {
+	var dbRelations []DBSubjectRelation
+	err := tx.SelectContext(ctx, &dbRelations,
+		"SELECT * FROM subject_relations WHERE user_id = $1 AND relation_type = $2",
+		userID, string(relationType),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return toSubjectRelations(dbRelations)
+}
+
+func toSubjectRelations(dbRelations []DBSubjectRelation) ([]models.SubjectRelation, error) {
+	relations := make([]models.SubjectRelation, len(dbRelations))
+	for i, dbRelation := range dbRelations {
+		relation, err := dbRelation.ToSubjectRelation()
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse subject relation: %w", err)
+		}
+		relations[i] = *relation
+	}
+	return relations, nil
+}
@@ -0,0 +1,76 @@
+package subjects
+
+import (
+	models "go-api/src/models/subjects"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type DBSubject struct {
+	ID         string     `db:"id" json:"id"`
+	UserID     string     `db:"user_id" json:"user_id"`
+	Name       string     `db:"name" json:"name"`
+	Color      string     `db:"color" json:"color"`
+	Icon       string     `db:"icon" json:"icon"`
+	ArchivedAt *time.Time `db:"archived_at" json:"archived_at"`
+	CreatedAt  time.Time  `db:"created_at" json:"created_at"`
+	UpdatedAt  time.Time  `db:"updated_at" json:"updated_at"`
+}
+
+func (s DBSubject) ToSubject() (*models.Subject, error) {
+	id, err := uuid.Parse(s.ID)
+	if err != nil {
+		return nil, err
+	}
+	userID, err := uuid.Parse(s.UserID)
+	if err != nil {
+		return nil, err
+	}
+	return &models.Subject{
+		ID:         id,
+		UserID:     userID,
+		Name:       s.Name,
+		Color:      s.Color,
+		Icon:       s.Icon,
+		ArchivedAt: s.ArchivedAt,
+		CreatedAt:  s.CreatedAt,
+		UpdatedAt:  s.UpdatedAt,
+	}, nil
+}
+
+type DBSubjectRelation struct {
+	ID            string    `db:"id" json:"id"`
+	UserID        string    `db:"user_id" json:"user_id"`
+	FromSubjectID string    `db:"from_subject_id" json:"from_subject_id"`
+	ToSubjectID   string    `db:"to_subject_id" json:"to_subject_id"`
+	RelationType  string    `db:"relation_type" json:"relation_type"`
+	CreatedAt     time.Time `db:"created_at" json:"created_at"`
+}
+
+func (r DBSubjectRelation) ToSubjectRelation() (*models.SubjectRelation, error) {
+	id, err := uuid.Parse(r.ID)
+	if err != nil {
+		return nil, err
+	}
+	userID, err := uuid.Parse(r.UserID)
+	if err != nil {
+		return nil, err
+	}
+	fromSubjectID, err := uuid.Parse(r.FromSubjectID)
+	if err != nil {
+		return nil, err
+	}
+	toSubjectID, err := uuid.Parse(r.ToSubjectID)
+	if err != nil {
+		return nil, err
+	}
+	return &models.SubjectRelation{
+		ID:            id,
+		UserID:        userID,
+		FromSubjectID: fromSubjectID,
+		ToSubjectID:   toSubjectID,
+		RelationType:  models.RelationType(r.RelationType),
+		CreatedAt:     r.CreatedAt,
+	}, nil
+}
@@ -0,0 +1,374 @@
+package webhooks
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"go-api/src/clients/postgres"
+	models "go-api/src/models/webhooks"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/fx"
+	"go.uber.org/zap"
+)
+
+type WebhookRepository interface {
+	CreateEndpoint(ctx context.Context, endpoint models.Endpoint, secret string, maxEndpoints int) (*models.Endpoint, error)
+	ListEndpoints(ctx context.Context, userID uuid.UUID) ([]models.Endpoint, error)
+	GetEndpoint(ctx context.Context, userID uuid.UUID, endpointID uuid.UUID) (*models.Endpoint, error)
+	UpdateEndpoint(ctx context.Context, userID uuid.UUID, endpointID uuid.UUID, update models.EndpointUpdate) (*models.Endpoint, error)
+	DeleteEndpoint(ctx context.Context, userID uuid.UUID, endpointID uuid.UUID) error
+	ListDeliveries(ctx context.Context, userID uuid.UUID, endpointID uuid.UUID, limit int) ([]models.Delivery, error)
+	Redeliver(ctx context.Context, userID uuid.UUID, endpointID uuid.UUID, deliveryID uuid.UUID) (*models.Delivery, error)
+	ClaimDueDeliveries(ctx context.Context, now time.Time, leaseUntil time.Time, limit int) ([]models.PendingDelivery, error)
+	RecordDeliveryResult(ctx context.Context, result models.DeliveryResult) error
+	PurgeEvents(ctx context.Context, createdBefore time.Time) (int64, error)
+}
+
+type webhookRepository struct {
+	logger   *zap.Logger
+	pgclient postgres.PostgresClient
+}
+
+type WebhookRepositoryParams struct {
+	fx.In
+
+	Logger   *zap.Logger
+	PGClient postgres.PostgresClient
+}
+
+func NewWebhookRepository(p WebhookRepositoryParams) (WebhookRepository, error) {
+	return &webhookRepository{
+		logger:   p.Logger,
+		pgclient: p.PGClient,
+	}, nil
+}
+
+func (r *webhookRepository) CreateEndpoint(ctx context.Context, endpoint models.Endpoint, secret string, maxEndpoints int) (*models.Endpoint, error) {
+	tx, err := postgres.NewTransaction(ctx, r.pgclient, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.SafeRollback()
+
+	userID := endpoint.UserID.String()
+	// Serialize the endpoint creations of the user so the limit can't be exceeded
+	if _, err := tx.ExecContext(ctx, "SELECT pg_advisory_xact_lock(hashtext($1))", "webhook_endpoints:"+userID); err != nil {
+		return nil, fmt.Errorf("failed to lock webhook endpoints: %w", err)
+	}
+	var endpoints int
+	if err := tx.GetContext(ctx, &endpoints, "SELECT count(*) FROM webhook_endpoints WHERE user_id = $1", userID); err != nil {
+		return nil, fmt.Errorf("failed to count webhook endpoints: %w", err)
+	}
+	if endpoints >= maxEndpoints {
+		return nil, models.ErrEndpointLimit
+	}
+
+	var dbEndpoint DBWebhookEndpoint
+	err = tx.GetContext(ctx, &dbEndpoint,
+		`INSERT INTO webhook_endpoints (user_id, url, description, secret, event_types, active)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		RETURNING *`,
+		userID, endpoint.URL, endpoint.Description, secret, eventTypesArray(endpoint.EventTypes), endpoint.Active,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create webhook endpoint: %w", err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, fmt.Errorf("commit failed: %w", err)
+	}
+	return dbEndpoint.ToEndpoint()
+}
+
+func (r *webhookRepository) ListEndpoints(ctx context.Context, userID uuid.UUID) ([]models.Endpoint, error) {
+	var dbEndpoints []DBWebhookEndpoint
+	err := r.pgclient.QuerySelect(ctx, &dbEndpoints,
+		"SELECT * FROM webhook_endpoints WHERE user_id = $1 ORDER BY created_at, id",
+		userID.String(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list webhook endpoints: %w", err)
+	}
+	endpoints := make([]models.Endpoint, len(dbEndpoints))
+	for i, dbEndpoint := range dbEndpoints {
+		endpoint, err := dbEndpoint.ToEndpoint()
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse webhook endpoint: %w", err)
+		}
+		endpoints[i] = *endpoint
+	}
+	return endpoints, nil
+}
+
+func (r *webhookRepository) GetEndpoint(ctx context.Context, userID uuid.UUID, endpointID uuid.UUID) (*models.Endpoint, error) {
+	var dbEndpoints []DBWebhookEndpoint
+	err := r.pgclient.QuerySelect(ctx, &dbEndpoints,
+		"SELECT * FROM webhook_endpoints WHERE id = $1 AND user_id = $2",
+		endpointID.String(), userID.String(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get webhook endpoint: %w", err)
+	}
+	if len(dbEndpoints) == 0 {
+		return nil, models.ErrEndpointNotFound
+	}
+	return dbEndpoints[0].ToEndpoint()
+}
+
+func (r *webhookRepository) UpdateEndpoint(ctx context.Context, userID uuid.UUID, endpointID uuid.UUID, update models.EndpointUpdate) (*models.Endpoint, error) {
+	tx, err := postgres.NewTransaction(ctx, r.pgclient, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.SafeRollback()
+
+	var eventTypes any
+	if update.EventTypes != nil {
+		eventTypes = eventTypesArray(update.EventTypes)
+	}
+	var dbEndpoint DBWebhookEndpoint
+	err = tx.GetContext(ctx, &dbEndpoint,
+		`UPDATE webhook_endpoints
+		SET url = COALESCE($1, url), description = COALESCE($2, description),
+			event_types = COALESCE($3, event_types), active = COALESCE($4, active)
+		WHERE id = $5 AND user_id = $6
+		RETURNING *`,
+		update.URL, update.Description, eventTypes, update.Active, endpointID.String(), userID.String(),
+	)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, models.ErrEndpointNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to update webhook endpoint: %w", err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, fmt.Errorf("commit failed: %w", err)
+	}
+	return dbEndpoint.ToEndpoint()
+}
+
+// DeleteEndpoint removes the endpoint along with its delivery log, pending
+// deliveries are dropped
+func (r *webhookRepository) DeleteEndpoint(ctx context.Context, userID uuid.UUID, endpointID uuid.UUID) error {
+	tx, err := postgres.NewTransaction(ctx, r.pgclient, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.SafeRollback()
+
+	result, err := tx.ExecContext(ctx,
+		"DELETE FROM webhook_endpoints WHERE id = $1 AND user_id = $2",
+		endpointID.String(), userID.String(),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to delete webhook endpoint: %w", err)
+	}
+	deleted, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to delete webhook endpoint: %w", err)
+	}
+	if deleted == 0 {
+		return models.ErrEndpointNotFound
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("commit failed: %w", err)
+	}
+	return nil
+}
+
+// deliveryColumns are the columns of a delivery joined with its event
+const deliveryColumns = `d.id, d.event_id, d.endpoint_id, ev.event_type, d.status, d.attempts,
+	d.next_attempt_at, d.last_attempt_at, d.response_code, d.last_error, d.created_at`
+
+// ListDeliveries returns, newest first, at most limit deliveries of the endpoint
+func (r *webhookRepository) ListDeliveries(ctx context.Context, userID uuid.UUID, endpointID uuid.UUID, limit int) ([]models.Delivery, error) {
+	if _, err := r.GetEndpoint(ctx, userID, endpointID); err != nil {
+		return nil, err
+	}
+
+	var dbDeliveries []DBWebhookDelivery
+	err := r.pgclient.QuerySelect(ctx, &dbDeliveries,
+		`SELECT `+deliveryColumns+`
+		FROM webhook_deliveries d
+		JOIN webhook_events ev ON ev.id = d.event_id
+		WHERE d.endpoint_id = $1
+		ORDER BY d.created_at DESC, d.id
+		LIMIT $2`,
+		endpointID.String(), limit,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list webhook deliveries: %w", err)
+	}
+	deliveries := make([]models.Delivery, len(dbDeliveries))
+	for i, dbDelivery := range dbDeliveries {
+		delivery, err := dbDelivery.ToDelivery()
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse webhook delivery: %w", err)
+		}
+		deliveries[i] = *delivery
+	}
+	return deliveries, nil
+}
+
+// Redeliver queues a new delivery of the event of a finished delivery to the
+// same endpoint
+func (r *webhookRepository) Redeliver(ctx context.Context, userID uuid.UUID, endpointID uuid.UUID, deliveryID uuid.UUID) (*models.Delivery, error) {
+	tx, err := postgres.NewTransaction(ctx, r.pgclient, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.SafeRollback()
+
+	var status string
+	err = tx.GetContext(ctx, &status,
+		`SELECT d.status FROM webhook_deliveries d
+		JOIN webhook_endpoints e ON e.id = d.endpoint_id
+		WHERE d.id = $1 AND d.endpoint_id = $2 AND e.user_id = $3
+		FOR UPDATE OF d`,
+		deliveryID.String(), endpointID.String(), userID.String(),
+	)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, models.ErrDeliveryNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get webhook delivery: %w", err)
+	}
+	if status == string(models.DeliveryStatusPending) {
+		return nil, models.ErrDeliveryPending
+	}
+
+	var dbDelivery DBWebhookDelivery
+	err = tx.GetContext(ctx, &dbDelivery,
+		`WITH redelivery AS (
+			INSERT INTO webhook_deliveries (event_id, endpoint_id, next_attempt_at)
+			SELECT event_id, endpoint_id, $2 FROM webhook_deliveries WHERE id = $1
+			RETURNING *
+		)
+		SELECT `+deliveryColumns+`
+		FROM redelivery d
+		JOIN webhook_events ev ON ev.id = d.event_id`,
+		deliveryID.String(), time.Now().UTC(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create webhook redelivery: %w", err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, fmt.Errorf("commit failed: %w", err)
+	}
+	return dbDelivery.ToDelivery()
+}
+
+// claimDeliveriesQuery leases at most $4 due deliveries of active endpoints
+// until $3 and counts the attempt. Deliveries leased by another replica are
+// skipped, a lease that runs out makes the delivery due again.
+const claimDeliveriesQuery = `
+    WITH due AS (
+        SELECT d.id FROM webhook_deliveries d
+        JOIN webhook_endpoints e ON e.id = d.endpoint_id
+        WHERE d.status = $1 AND d.next_attempt_at <= $2 AND e.active
+        ORDER BY d.next_attempt_at
+        LIMIT $4
+        FOR UPDATE OF d SKIP LOCKED
+    )
+    UPDATE webhook_deliveries d
+    SET next_attempt_at = $3, attempts = d.attempts + 1
+    FROM due, webhook_endpoints e, webhook_events ev
+    WHERE d.id = due.id AND e.id = d.endpoint_id AND ev.id = d.event_id
+    RETURNING d.id, d.event_id, ev.event_type, d.attempts, e.url, e.secret, ev.payload
+`
+
+// ClaimDueDeliveries returns the deliveries to attempt now, they are leased
+// so that no other replica attempts them before their result is recorded
+func (r *webhookRepository) ClaimDueDeliveries(ctx context.Context, now time.Time, leaseUntil time.Time, limit int) ([]models.PendingDelivery, error) {
+	tx, err := postgres.NewTransaction(ctx, r.pgclient, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.SafeRollback()
+
+	var dbDeliveries []DBPendingDelivery
+	err = tx.SelectContext(ctx, &dbDeliveries, claimDeliveriesQuery,
+		string(models.DeliveryStatusPending), now.UTC(), leaseUntil.UTC(), limit,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to claim webhook deliveries: %w", err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, fmt.Errorf("commit failed: %w", err)
+	}
+	deliveries := make([]models.PendingDelivery, len(dbDeliveries))
+	for i, dbDelivery := range dbDeliveries {
+		delivery, err := dbDelivery.ToPendingDelivery()
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse webhook delivery: %w", err)
+		}
+		deliveries[i] = *delivery
+	}
+	return deliveries, nil
+}
+
+func (r *webhookRepository) RecordDeliveryResult(ctx context.Context, result models.DeliveryResult) error {
+	tx, err := postgres.NewTransaction(ctx, r.pgclient, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.SafeRollback()
+
+	var nextAttemptAt *time.Time
+	if result.NextAttemptAt != nil {
+		next := result.NextAttemptAt.UTC()
+		nextAttemptAt = &next
+	}
+	_, err = tx.ExecContext(ctx,
+		`UPDATE webhook_deliveries
+		SET status = $1, next_attempt_at = $2, last_attempt_at = $3, response_code = $4, last_error = $5
+		WHERE id = $6 AND status = $7`,
+		string(result.Status), nextAttemptAt, result.AttemptedAt.UTC(), result.ResponseCode, result.Error,
+		result.DeliveryID.String(), string(models.DeliveryStatusPending),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to record webhook delivery result: %w", err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("commit failed: %w", err)
+	}
+	return nil
+}
+
+// PurgeEvents deletes the events created before the given time that have no
+// pending delivery, their delivery log goes with them
+func (r *webhookRepository) PurgeEvents(ctx context.Context, createdBefore time.Time) (int64, error) {
+	tx, err := postgres.NewTransaction(ctx, r.pgclient, nil)
+	if err != nil {
+		return 0, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.SafeRollback()
+
+	result, err := tx.ExecContext(ctx,
+		`DELETE FROM webhook_events ev
+		WHERE ev.created_at < $1 AND NOT EXISTS (
+			SELECT 1 FROM webhook_deliveries d WHERE d.event_id = ev.id AND d.status = $2
+		)`,
+		createdBefore.UTC(), string(models.DeliveryStatusPending),
+	)
+	if err != nil {
+		return 0, fmt.Errorf("failed to purge webhook events: %w", err)
+	}
+	purged, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to count purged webhook events: %w", err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return 0, fmt.Errorf("commit failed: %w", err)
+	}
+	return purged, nil
+}
@@ -0,0 +1,127 @@
+package webhooks
+
+import (
+	models "go-api/src/models/webhooks"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+type DBWebhookEndpoint struct {
+	ID          string         `db:"id" json:"id"`
+	UserID      string         `db:"user_id" json:"user_id"`
+	URL         string         `db:"url" json:"url"`
+	Description string         `db:"description" json:"description"`
+	Secret      string         `db:"secret" json:"secret"`
+	EventTypes  pq.StringArray `db:"event_types" json:"event_types"`
+	Active      bool           `db:"active" json:"active"`
+	CreatedAt   time.Time      `db:"created_at" json:"created_at"`
+	UpdatedAt   time.Time      `db:"updated_at" json:"updated_at"`
+}
+
+type DBWebhookDelivery struct {
+	ID            string     `db:"id" json:"id"`
+	EventID       string     `db:"event_id" json:"event_id"`
+	EndpointID    string     `db:"endpoint_id" json:"endpoint_id"`
+	EventType     string     `db:"event_type" json:"event_type"`
+	Status        string     `db:"status" json:"status"`
+	Attempts      int        `db:"attempts" json:"attempts"`
+	NextAttemptAt *time.Time `db:"next_attempt_at" json:"next_attempt_at"`
+	LastAttemptAt *time.Time `db:"last_attempt_at" json:"last_attempt_at"`
+	ResponseCode  *int       `db:"response_code" json:"response_code"`
+	LastError     *string    `db:"last_error" json:"last_error"`
+	CreatedAt     time.Time  `db:"created_at" json:"created_at"`
+}
+
+type DBPendingDelivery struct {
+	ID        string `db:"id" json:"id"`
+	EventID   string `db:"event_id" json:"event_id"`
+	EventType string `db:"event_type" json:"event_type"`
+	Attempts  int    `db:"attempts" json:"attempts"`
+	URL       string `db:"url" json:"url"`
+	Secret    string `db:"secret" json:"secret"`
+	Payload   []byte `db:"payload" json:"payload"`
+}
+
+func (e DBWebhookEndpoint) ToEndpoint() (*models.Endpoint, error) {
+	id, err := uuid.Parse(e.ID)
+	if err != nil {
+		return nil, err
+	}
+	userID, err := uuid.Parse(e.UserID)
+	if err != nil {
+		return nil, err
+	}
+	eventTypes := make([]models.EventType, len(e.EventTypes))
+	for i, eventType := range e.EventTypes {
+		eventTypes[i] = models.EventType(eventType)
+	}
+	return &models.Endpoint{
+		ID:          id,
+		UserID:      userID,
+		URL:         e.URL,
+		Description: e.Description,
+		EventTypes:  eventTypes,
+		Active:      e.Active,
+		CreatedAt:   e.CreatedAt,
+		UpdatedAt:   e.UpdatedAt,
+	}, nil
+}
+
+func (d DBWebhookDelivery) ToDelivery() (*models.Delivery, error) {
+	id, err := uuid.Parse(d.ID)
+	if err != nil {
+		return nil, err
+	}
+	eventID, err := uuid.Parse(d.EventID)
+	if err != nil {
+		return nil, err
+	}
+	endpointID, err := uuid.Parse(d.EndpointID)
+	if err != nil {
+		return nil, err
+	}
+	return &models.Delivery{
+		ID:            id,
+		EventID:       eventID,
+		EndpointID:    endpointID,
+		EventType:     models.EventType(d.EventType),
+		Status:        models.DeliveryStatus(d.Status),
+		Attempts:      d.Attempts,
+		NextAttemptAt: d.NextAttemptAt,
+		LastAttemptAt: d.LastAttemptAt,
+		ResponseCode:  d.ResponseCode,
+		LastError:     d.LastError,
+		CreatedAt:     d.CreatedAt,
+	}, nil
+}
+
+func (d DBPendingDelivery) ToPendingDelivery() (*models.PendingDelivery, error) {
+	id, err := uuid.Parse(d.ID)
+	if err != nil {
+		return nil, err
+	}
+	eventID, err := uuid.Parse(d.EventID)
+	if err != nil {
+		return nil, err
+	}
+	return &models.PendingDelivery{
+		ID:        id,
+		EventID:   eventID,
+		EventType: models.EventType(d.EventType),
+		Attempts:  d.Attempts,
+		URL:       d.URL,
+		Secret:    d.Secret,
+		Payload:   d.Payload,
+	}, nil
+}
+
+// eventTypesArray stores the event types of an endpoint
+func eventTypesArray(eventTypes []models.EventType) pq.StringArray {
+	array := make(pq.StringArray, len(eventTypes))
+	for i, eventType := range eventTypes {
+		array[i] = string(eventType)
+	}
+	return array
+}
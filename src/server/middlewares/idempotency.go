@@ -0,0 +1,155 @@
+package middlewares
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	authmodel "go-api/src/models/auth"
+	"go-api/src/models/constants"
+	models "go-api/src/models/idempotency"
+	"io"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"go.uber.org/zap"
+)
+
+const (
+	headerIdempotencyKey     = "Idempotency-Key"
+	headerIdempotentReplayed = "Idempotent-Replayed"
+
+	// maxIdempotentBodyBytes bounds the body buffered for hashing, it leaves
+	// room for the multipart overhead of the largest accepted import file
+	maxIdempotentBodyBytes = 8 << 20
+)
+
+// fingerprintHeaders change the meaning of a request, a key reused with
+// other values is a different request
+var fingerprintHeaders = []string{
+	echo.HeaderContentType,
+	"If-Match",
+	"If-None-Match",
+	"If-Unmodified-Since",
+}
+
+// IdempotencyMiddleware makes the mutating requests sent with an
+// Idempotency-Key header safe to retry: the first response for a key is
+// stored and replayed for the repeats, a key reused for a different request
+// is rejected. Keys are scoped to the user so it must run after
+// AuthMiddleware. Server errors are not stored, the request can be retried.
+func (m middlewares) IdempotencyMiddleware() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			key := c.Request().Header.Get(headerIdempotencyKey)
+			if key == "" || !isMutatingMethod(c.Request().Method) {
+				return next(c)
+			}
+			ctx := c.Request().Context()
+			if _, ok := ctx.Value(constants.ContextKeyUserInfoKey).(*authmodel.UserInfo); !ok {
+				m.logger.Error("Idempotency middleware used without an authenticated user",
+					zap.String("path", c.Path()),
+				)
+				return next(c)
+			}
+
+			body, err := io.ReadAll(http.MaxBytesReader(c.Response(), c.Request().Body, maxIdempotentBodyBytes))
+			var maxBytesErr *http.MaxBytesError
+			if errors.As(err, &maxBytesErr) {
+				return c.JSON(http.StatusRequestEntityTooLarge, map[string]string{"error": "Request body too large"})
+			}
+			if err != nil {
+				return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid request body"})
+			}
+			c.Request().Body = io.NopCloser(bytes.NewReader(body))
+
+			record, err := m.idempotencyService.ReserveKey(ctx, key, hashRequest(c.Request(), body))
+			switch {
+			case errors.Is(err, models.ErrInvalidKey):
+				return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid Idempotency-Key header"})
+			case errors.Is(err, models.ErrKeyReused):
+				return c.JSON(http.StatusUnprocessableEntity, map[string]string{"error": "Idempotency-Key was already used for a different request"})
+			case errors.Is(err, models.ErrKeyInUse):
+				return c.JSON(http.StatusConflict, map[string]string{"error": "A request with this Idempotency-Key is in progress"})
+			case err != nil:
+				m.logger.Error("Failed to reserve idempotency key", zap.Error(err))
+				return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Failed to process Idempotency-Key"})
+			}
+			if record.Response != nil {
+				return replayResponse(c, *record.Response)
+			}
+
+			recorder := &responseRecorder{ResponseWriter: c.Response().Writer}
+			c.Response().Writer = recorder
+			handlerErr := next(c)
+
+			// The key must be settled even when the client went away
+			storeCtx := context.WithoutCancel(ctx)
+			response := c.Response()
+			if handlerErr != nil || !response.Committed || response.Status >= http.StatusInternalServerError {
+				if err := m.idempotencyService.ReleaseKey(storeCtx, *record); err != nil {
+					m.logger.Error("Failed to release idempotency key", zap.Error(err))
+				}
+				return handlerErr
+			}
+			err = m.idempotencyService.CompleteKey(storeCtx, *record, models.Response{
+				StatusCode: response.Status,
+				Header:     response.Header().Clone(),
+				Body:       recorder.body.Bytes(),
+			})
+			if err != nil {
+				m.logger.Error("Failed to store idempotent response", zap.Error(err))
+			}
+			return nil
+		}
+	}
+}
+
+func isMutatingMethod(method string) bool {
+	switch method {
+	case http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+// hashRequest identifies a request by its method, URI, fingerprint headers
+// and body
+func hashRequest(request *http.Request, body []byte) string {
+	hash := sha256.New()
+	hash.Write([]byte(request.Method + " " + request.URL.RequestURI() + "\n"))
+	for _, name := range fingerprintHeaders {
+		// Quoted so that values can't be shifted between headers
+		fmt.Fprintf(hash, "%s: %q\n", name, request.Header.Values(name))
+	}
+	hash.Write(body)
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+func replayResponse(c echo.Context, response models.Response) error {
+	header := c.Response().Header()
+	for name, values := range response.Header {
+		header[name] = values
+	}
+	header.Set(headerIdempotentReplayed, "true")
+	c.Response().WriteHeader(response.StatusCode)
+	_, err := c.Response().Write(response.Body)
+	return err
+}
+
+// responseRecorder keeps a copy of the response body written by the handler
+type responseRecorder struct {
+	http.ResponseWriter
+	body bytes.Buffer
+}
+
+func (r *responseRecorder) Write(b []byte) (int, error) {
+	r.body.Write(b)
+	return r.ResponseWriter.Write(b)
+}
+
+func (r *responseRecorder) Unwrap() http.ResponseWriter {
+	return r.ResponseWriter
+}
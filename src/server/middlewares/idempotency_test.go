@@ -0,0 +1,225 @@
+package middlewares
+
+import (
+	"context"
+	mockidempotency "go-api/.internal/mocks/src/services/idempotency"
+	authmodel "go-api/src/models/auth"
+	"go-api/src/models/constants"
+	models "go-api/src/models/idempotency"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/zap/zaptest"
+)
+
+// IdempotencyMiddlewareTestSuite ...
+type IdempotencyMiddlewareTestSuite struct {
+	suite.Suite
+
+	MockService *mockidempotency.IdempotencyService
+
+	Middlewares Middlewares
+}
+
+// SetupTest ...
+func (s *IdempotencyMiddlewareTestSuite) SetupTest() {
+	t := s.T()
+	s.MockService = mockidempotency.NewIdempotencyService(t)
+	s.Middlewares = NewMiddlewares(MiddlewaresParams{
+		Logger:             zaptest.NewLogger(t),
+		IdempotencyService: s.MockService,
+	})
+}
+
+// SetupSubTest ...
+func (s *IdempotencyMiddlewareTestSuite) SetupSubTest() {
+	s.SetupTest() // Clean up the mocks
+}
+
+// TestIdempotencyMiddlewareTestSuite ...
+func TestIdempotencyMiddlewareTestSuite(t *testing.T) {
+	suite.Run(t, new(IdempotencyMiddlewareTestSuite))
+}
+
+// TestIdempotencyMiddleware ...
+func (s *IdempotencyMiddlewareTestSuite) TestIdempotencyMiddleware() {
+	body := `{"title":"Calculus"}`
+	reservation := &models.Record{Key: "key-1"}
+	created := models.Response{
+		StatusCode: http.StatusCreated,
+		Header:     http.Header{echo.HeaderContentType: []string{echo.MIMEApplicationJSON}},
+		Body:       []byte(`{"id":"1"}`),
+	}
+
+	tests := map[string]struct {
+		Method           string
+		Key              string
+		Body             string
+		HandlerStatus    int
+		MockSetup        func()
+		ExpectedStatus   int
+		ExpectedBody     string
+		ExpectedReplayed bool
+		ExpectedCalls    int
+	}{
+		"without key": {
+			Method:         http.MethodPost,
+			HandlerStatus:  http.StatusCreated,
+			MockSetup:      func() {},
+			ExpectedStatus: http.StatusCreated,
+			ExpectedBody:   `{"id":"1"}`,
+			ExpectedCalls:  1,
+		},
+		"safe method": {
+			Method:         http.MethodGet,
+			Key:            "key-1",
+			HandlerStatus:  http.StatusOK,
+			MockSetup:      func() {},
+			ExpectedStatus: http.StatusOK,
+			ExpectedBody:   `{"id":"1"}`,
+			ExpectedCalls:  1,
+		},
+		"first request stores the response": {
+			Method:        http.MethodPost,
+			Key:           "key-1",
+			HandlerStatus: http.StatusCreated,
+			MockSetup: func() {
+				s.MockService.EXPECT().ReserveKey(mock.Anything, "key-1", mock.Anything).Return(reservation, nil)
+				s.MockService.EXPECT().CompleteKey(mock.Anything, *reservation, mock.MatchedBy(func(response models.Response) bool {
+					return response.StatusCode == http.StatusCreated && string(response.Body) == `{"id":"1"}`
+				})).Return(nil)
+			},
+			ExpectedStatus: http.StatusCreated,
+			ExpectedBody:   `{"id":"1"}`,
+			ExpectedCalls:  1,
+		},
+		"server error releases the key": {
+			Method:        http.MethodPost,
+			Key:           "key-1",
+			HandlerStatus: http.StatusInternalServerError,
+			MockSetup: func() {
+				s.MockService.EXPECT().ReserveKey(mock.Anything, "key-1", mock.Anything).Return(reservation, nil)
+				s.MockService.EXPECT().ReleaseKey(mock.Anything, *reservation).Return(nil)
+			},
+			ExpectedStatus: http.StatusInternalServerError,
+			ExpectedBody:   `{"id":"1"}`,
+			ExpectedCalls:  1,
+		},
+		"repeat replays the stored response": {
+			Method: http.MethodPost,
+			Key:    "key-1",
+			MockSetup: func() {
+				s.MockService.EXPECT().ReserveKey(mock.Anything, "key-1", mock.Anything).Return(&models.Record{Key: "key-1", Response: &created}, nil)
+			},
+			ExpectedStatus:   http.StatusCreated,
+			ExpectedBody:     `{"id":"1"}`,
+			ExpectedReplayed: true,
+		},
+		"key reused for another request": {
+			Method: http.MethodPost,
+			Key:    "key-1",
+			MockSetup: func() {
+				s.MockService.EXPECT().ReserveKey(mock.Anything, "key-1", mock.Anything).Return(nil, models.ErrKeyReused)
+			},
+			ExpectedStatus: http.StatusUnprocessableEntity,
+		},
+		"first request in progress": {
+			Method: http.MethodPost,
+			Key:    "key-1",
+			MockSetup: func() {
+				s.MockService.EXPECT().ReserveKey(mock.Anything, "key-1", mock.Anything).Return(nil, models.ErrKeyInUse)
+			},
+			ExpectedStatus: http.StatusConflict,
+		},
+		"invalid key": {
+			Method: http.MethodPost,
+			Key:    "key-1",
+			MockSetup: func() {
+				s.MockService.EXPECT().ReserveKey(mock.Anything, "key-1", mock.Anything).Return(nil, models.ErrInvalidKey)
+			},
+			ExpectedStatus: http.StatusBadRequest,
+		},
+		"body too large": {
+			Method:         http.MethodPost,
+			Key:            "key-1",
+			Body:           strings.Repeat("a", maxIdempotentBodyBytes+1),
+			MockSetup:      func() {},
+			ExpectedStatus: http.StatusRequestEntityTooLarge,
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			tc.MockSetup()
+			calls := 0
+			handler := func(c echo.Context) error {
+				calls++
+				// The handler still reads the whole body
+				var payload map[string]string
+				s.NoError(c.Bind(&payload))
+				s.Equal("Calculus", payload["title"])
+				return c.JSONBlob(tc.HandlerStatus, []byte(`{"id":"1"}`))
+			}
+
+			requestBody := body
+			if tc.Body != "" {
+				requestBody = tc.Body
+			}
+			e := echo.New()
+			req := httptest.NewRequest(tc.Method, "/study-session/start", strings.NewReader(requestBody))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			if tc.Key != "" {
+				req.Header.Set(headerIdempotencyKey, tc.Key)
+			}
+			ctx := context.WithValue(req.Context(), constants.ContextKeyUserInfoKey, &authmodel.UserInfo{ID: uuid.New()})
+			req = req.WithContext(ctx)
+			rec := httptest.NewRecorder()
+
+			err := s.Middlewares.IdempotencyMiddleware()(handler)(e.NewContext(req, rec))
+
+			s.NoError(err)
+			s.Equal(tc.ExpectedStatus, rec.Code)
+			if tc.ExpectedBody != "" {
+				s.Equal(tc.ExpectedBody, rec.Body.String())
+			}
+			s.Equal(tc.ExpectedReplayed, rec.Header().Get(headerIdempotentReplayed) == "true")
+			s.Equal(tc.ExpectedCalls, calls)
+		})
+	}
+}
+
+// TestIdempotencyMiddlewareFingerprint ...
+func (s *IdempotencyMiddlewareTestSuite) TestIdempotencyMiddlewareFingerprint() {
+	var hashes []string
+	s.MockService.EXPECT().ReserveKey(mock.Anything, "key-1", mock.Anything).
+		RunAndReturn(func(_ context.Context, _ string, requestHash string) (*models.Record, error) {
+			hashes = append(hashes, requestHash)
+			return nil, models.ErrKeyInUse
+		})
+
+	send := func(ifMatch string) {
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodPatch, "/study-session/history/1", strings.NewReader(`{"title":"Calculus"}`))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		req.Header.Set(headerIdempotencyKey, "key-1")
+		req.Header.Set("If-Match", ifMatch)
+		ctx := context.WithValue(req.Context(), constants.ContextKeyUserInfoKey, &authmodel.UserInfo{ID: uuid.New()})
+		rec := httptest.NewRecorder()
+		handler := func(c echo.Context) error { return c.NoContent(http.StatusOK) }
+
+		s.NoError(s.Middlewares.IdempotencyMiddleware()(handler)(e.NewContext(req.WithContext(ctx), rec)))
+	}
+	send(`"v1"`)
+	send(`"v1"`)
+	send(`"v2"`)
+
+	s.Require().Len(hashes, 3)
+	s.Equal(hashes[0], hashes[1])
+	s.NotEqual(hashes[0], hashes[2], "a different If-Match is a different request")
+}
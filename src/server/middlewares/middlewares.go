@@ -2,6 +2,7 @@ package middlewares
 
 import (
 	"go-api/src/services/auth"
+	"go-api/src/services/idempotency"
 
 	"github.com/labstack/echo/v4"
 	"go.uber.org/fx"
@@ -10,23 +11,27 @@ import (
 
 type Middlewares interface {
 	AuthMiddleware() echo.MiddlewareFunc
+	IdempotencyMiddleware() echo.MiddlewareFunc
 }
 
 type middlewares struct {
-	logger      *zap.Logger
-	authService auth.AuthService
+	logger             *zap.Logger
+	authService        auth.AuthService
+	idempotencyService idempotency.IdempotencyService
 }
 
 type MiddlewaresParams struct {
 	fx.In
 
-	Logger      *zap.Logger
-	AuthService auth.AuthService
+	Logger             *zap.Logger
+	AuthService        auth.AuthService
+	IdempotencyService idempotency.IdempotencyService
 }
 
 func NewMiddlewares(params MiddlewaresParams) Middlewares {
 	return &middlewares{
-		logger:      params.Logger,
-		authService: params.AuthService,
+		logger:             params.Logger,
+		authService:        params.AuthService,
+		idempotencyService: params.IdempotencyService,
 	}
 }
@@ -3,8 +3,13 @@ package server
 import (
 	_ "go-api/.internal/docs" // Generate automatically the swagger docs
 	"go-api/src/handlers/auth"
+	"go-api/src/handlers/controlchannel"
+	"go-api/src/handlers/feeds"
 	"go-api/src/handlers/healthcheck"
+	"go-api/src/handlers/stats"
 	"go-api/src/handlers/studysession"
+	"go-api/src/handlers/subjects"
+	"go-api/src/handlers/webhooks"
 	"go-api/src/server/middlewares"
 
 	"github.com/labstack/echo/v4"
@@ -16,11 +21,16 @@ import (
 type RegisterRoutesParams struct {
 	fx.In
 
-	Echo                *echo.Echo
-	Healthcheck         healthcheck.Handler
-	AuthHandler         auth.AuthHandler
-	StudySessionHandler studysession.StudySessionHandler
-	Middlewares         middlewares.Middlewares
+	Echo                  *echo.Echo
+	Healthcheck           healthcheck.Handler
+	AuthHandler           auth.AuthHandler
+	StudySessionHandler   studysession.StudySessionHandler
+	SubjectHandler        subjects.SubjectHandler
+	StatsHandler          stats.StatsHandler
+	FeedHandler           feeds.FeedHandler
+	ControlChannelHandler controlchannel.ControlChannelHandler
+	WebhookHandler        webhooks.WebhookHandler
+	Middlewares           middlewares.Middlewares
 }
 
 // RegisterRoutes registers the routes for the API.
@@ -38,13 +48,86 @@ func RegisterRoutes(p RegisterRoutesParams) {
 		authGroup.GET("/user", p.AuthHandler.GetUser, p.Middlewares.AuthMiddleware())
 	}
 
-	// StudySession routes
-	studySessionGroup := p.Echo.Group("/study-session", p.Middlewares.AuthMiddleware())
+	// StudySession routes, mutating requests can be retried with an
+	// Idempotency-Key header
+	studySessionGroup := p.Echo.Group("/study-session", p.Middlewares.AuthMiddleware(), p.Middlewares.IdempotencyMiddleware())
 	{
 		studySessionGroup.POST("/start", p.StudySessionHandler.StartStudySession)
+		studySessionGroup.POST("/manual", p.StudySessionHandler.CreateManualStudySession)
 		studySessionGroup.GET("", p.StudySessionHandler.GetActiveStudySession)
+		studySessionGroup.PATCH("", p.StudySessionHandler.UpdateActiveStudySession)
 		studySessionGroup.GET("/events", p.StudySessionHandler.GetActiveStudySessionEvents)
+		studySessionGroup.GET("/stream", p.StudySessionHandler.StreamStudySessionNotifications)
 		studySessionGroup.POST("/events", p.StudySessionHandler.AddStudySessionEvents)
 		studySessionGroup.POST("/finish", p.StudySessionHandler.FinishStudySession)
+		studySessionGroup.POST("/cancel", p.StudySessionHandler.CancelActiveStudySession)
+		studySessionGroup.GET("/history", p.StudySessionHandler.GetStudySessionHistory)
+		studySessionGroup.GET("/subject-totals", p.StudySessionHandler.GetSubjectTimeTotals)
+		studySessionGroup.GET("/export.ics", p.StudySessionHandler.ExportStudySessionsICal)
+		studySessionGroup.GET("/export", p.StudySessionHandler.ExportStudySessionData)
+		studySessionGroup.POST("/import", p.StudySessionHandler.ImportStudySessions)
+		studySessionGroup.GET("/:id", p.StudySessionHandler.GetStudySession)
+		studySessionGroup.PATCH("/:id", p.StudySessionHandler.UpdateStudySession)
+		studySessionGroup.DELETE("/:id", p.StudySessionHandler.DeleteStudySession)
+		studySessionGroup.GET("/:id/events", p.StudySessionHandler.GetStudySessionEvents)
+		studySessionGroup.POST("/:id/restore", p.StudySessionHandler.RestoreStudySession)
 	}
+
+	// Subject routes
+	subjectGroup := p.Echo.Group("/subjects", p.Middlewares.AuthMiddleware())
+	{
+		subjectGroup.POST("", p.SubjectHandler.CreateSubject)
+		subjectGroup.GET("", p.SubjectHandler.ListSubjects)
+		subjectGroup.GET("/tree", p.SubjectHandler.GetSubjectTree)
+		subjectGroup.GET("/study-order", p.SubjectHandler.GetStudyOrder)
+		subjectGroup.POST("/relations", p.SubjectHandler.CreateRelation)
+		subjectGroup.GET("/relations", p.SubjectHandler.ListRelations)
+		subjectGroup.DELETE("/relations/:id", p.SubjectHandler.DeleteRelation)
+		subjectGroup.GET("/:id", p.SubjectHandler.GetSubject)
+		subjectGroup.PATCH("/:id", p.SubjectHandler.UpdateSubject)
+		subjectGroup.POST("/:id/archive", p.SubjectHandler.ArchiveSubject)
+		subjectGroup.POST("/:id/unarchive", p.SubjectHandler.UnarchiveSubject)
+	}
+
+	// Stats routes
+	statsGroup := p.Echo.Group("/stats", p.Middlewares.AuthMiddleware())
+	{
+		statsGroup.GET("", p.StatsHandler.GetStudyStats)
+		statsGroup.GET("/heatmap", p.StatsHandler.GetHeatmap)
+	}
+
+	// Streak routes
+	streakGroup := p.Echo.Group("/streaks", p.Middlewares.AuthMiddleware())
+	{
+		streakGroup.GET("", p.StatsHandler.GetStreak)
+		streakGroup.PUT("/settings", p.StatsHandler.UpdateStreakSettings)
+	}
+
+	// Feed token routes
+	feedTokenGroup := p.Echo.Group("/feed-tokens", p.Middlewares.AuthMiddleware())
+	{
+		feedTokenGroup.POST("", p.FeedHandler.CreateFeedToken)
+		feedTokenGroup.GET("", p.FeedHandler.ListFeedTokens)
+		feedTokenGroup.DELETE("/:id", p.FeedHandler.RevokeFeedToken)
+	}
+
+	// Webhook routes
+	webhookGroup := p.Echo.Group("/webhooks", p.Middlewares.AuthMiddleware())
+	{
+		webhookGroup.POST("", p.WebhookHandler.CreateWebhookEndpoint)
+		webhookGroup.GET("", p.WebhookHandler.ListWebhookEndpoints)
+		webhookGroup.GET("/:id", p.WebhookHandler.GetWebhookEndpoint)
+		webhookGroup.PATCH("/:id", p.WebhookHandler.UpdateWebhookEndpoint)
+		webhookGroup.DELETE("/:id", p.WebhookHandler.DeleteWebhookEndpoint)
+		webhookGroup.GET("/:id/deliveries", p.WebhookHandler.ListWebhookDeliveries)
+		webhookGroup.POST("/:id/deliveries/:deliveryId/redeliver", p.WebhookHandler.RedeliverWebhook)
+	}
+
+	// Calendar feeds are polled by calendar apps, the token in the URL
+	// authenticates the request instead of a Bearer token
+	p.Echo.GET("/feeds/:token", p.FeedHandler.GetCalendarFeed)
+
+	// Live timer control channel, the WebSocket authenticates with the
+	// Bearer token itself so it can outlive the token it was opened with
+	p.Echo.GET("/ws", p.ControlChannelHandler.Connect)
 }
@@ -4,6 +4,7 @@ import (
 	"context"
 	"go-api/src/clients/keycloak"
 	model "go-api/src/models/auth"
+	"time"
 
 	"github.com/google/uuid"
 	"go.uber.org/fx"
@@ -97,6 +98,11 @@ func (s *authService) GetUserInfo(ctx context.Context, request model.VerifySessi
 		return nil, err
 	}
 
+	var expiresAt time.Time
+	if res.Exp > 0 {
+		expiresAt = time.Unix(int64(res.Exp), 0)
+	}
+
 	return &model.UserInfo{
 		ID:                userID,
 		Username:          res.Username,
@@ -111,5 +117,6 @@ func (s *authService) GetUserInfo(ctx context.Context, request model.VerifySessi
 				Roles: res.ResourceAccess.Account.Roles,
 			},
 		},
+		ExpiresAt: expiresAt,
 	}, nil
 }
@@ -0,0 +1,120 @@
+package feeds
+
+import (
+	"context"
+	"fmt"
+	authmodel "go-api/src/models/auth"
+	"go-api/src/models/constants"
+	models "go-api/src/models/feeds"
+	repository "go-api/src/repositories/feeds"
+	sessionservice "go-api/src/services/studysession"
+
+	"github.com/google/uuid"
+	"go.uber.org/fx"
+	"go.uber.org/zap"
+)
+
+// maxActiveFeedTokens limits the tokens a user can hold at once
+const maxActiveFeedTokens = 10
+
+type FeedService interface {
+	CreateFeedToken(ctx context.Context, request CreateFeedTokenRequest) (*models.CreatedFeedToken, error)
+	ListFeedTokens(ctx context.Context) ([]models.FeedToken, error)
+	RevokeFeedToken(ctx context.Context, tokenID uuid.UUID) error
+	ResolveCalendarFeed(ctx context.Context, token string) (*models.FeedVersion, error)
+	RenderCalendarFeed(ctx context.Context, version models.FeedVersion) ([]byte, error)
+}
+
+type feedService struct {
+	repository          repository.FeedRepository
+	studySessionService sessionservice.StudySessionService
+	logger              *zap.Logger
+}
+
+type FeedServiceParams struct {
+	fx.In
+
+	Repository          repository.FeedRepository
+	StudySessionService sessionservice.StudySessionService
+	Logger              *zap.Logger
+}
+
+func NewFeedService(p FeedServiceParams) FeedService {
+	return &feedService{
+		repository:          p.Repository,
+		studySessionService: p.StudySessionService,
+		logger:              p.Logger,
+	}
+}
+
+func (s feedService) CreateFeedToken(ctx context.Context, request CreateFeedTokenRequest) (*models.CreatedFeedToken, error) {
+	user := ctx.Value(constants.ContextKeyUserInfoKey).(*authmodel.UserInfo)
+	if user == nil {
+		s.logger.Error("Failed to create feed token, no user found in context")
+		return nil, fmt.Errorf("no user found in context")
+	}
+	name, err := normalizeFeedTokenName(request.Name)
+	if err != nil {
+		return nil, err
+	}
+	token, err := generateFeedToken()
+	if err != nil {
+		return nil, err
+	}
+	feedToken, err := s.repository.CreateFeedToken(ctx, user.ID, name, hashFeedToken(token), maxActiveFeedTokens)
+	if err != nil {
+		return nil, err
+	}
+	return &models.CreatedFeedToken{
+		FeedToken: *feedToken,
+		Token:     token,
+	}, nil
+}
+
+func (s feedService) ListFeedTokens(ctx context.Context) ([]models.FeedToken, error) {
+	user := ctx.Value(constants.ContextKeyUserInfoKey).(*authmodel.UserInfo)
+	if user == nil {
+		s.logger.Error("Failed to list feed tokens, no user found in context")
+		return nil, fmt.Errorf("no user found in context")
+	}
+	return s.repository.ListFeedTokens(ctx, user.ID)
+}
+
+func (s feedService) RevokeFeedToken(ctx context.Context, tokenID uuid.UUID) error {
+	user := ctx.Value(constants.ContextKeyUserInfoKey).(*authmodel.UserInfo)
+	if user == nil {
+		s.logger.Error("Failed to revoke feed token, no user found in context")
+		return fmt.Errorf("no user found in context")
+	}
+	return s.repository.RevokeFeedToken(ctx, user.ID, tokenID)
+}
+
+// ResolveCalendarFeed finds the user of a feed token and the current version
+// of the feed, so that unchanged feeds aren't rendered again
+func (s feedService) ResolveCalendarFeed(ctx context.Context, token string) (*models.FeedVersion, error) {
+	if !isWellFormedFeedToken(token) {
+		return nil, models.ErrFeedNotFound
+	}
+	userID, err := s.repository.UseFeedToken(ctx, hashFeedToken(token))
+	if err != nil {
+		return nil, err
+	}
+	state, err := s.repository.GetFeedState(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	etag, lastModified := newFeedVersion(*state)
+	return &models.FeedVersion{
+		UserID:       userID,
+		ETag:         etag,
+		LastModified: lastModified,
+	}, nil
+}
+
+// RenderCalendarFeed renders the calendar of the feed user. The feed request
+// isn't authenticated, the context only carries the user id the token
+// resolved to and it's only used for the read-only export.
+func (s feedService) RenderCalendarFeed(ctx context.Context, version models.FeedVersion) ([]byte, error) {
+	ctx = context.WithValue(ctx, constants.ContextKeyUserInfoKey, &authmodel.UserInfo{ID: version.UserID})
+	return s.studySessionService.ExportStudySessionsICal(ctx, sessionservice.ExportStudySessionsRequest{})
+}
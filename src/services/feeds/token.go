@@ -0,0 +1,72 @@
+package feeds
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	models "go-api/src/models/feeds"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+const (
+	// feedTokenBytes gives 256 bits of entropy, encoded in 43 characters
+	feedTokenBytes = 32
+	// feedFormatVersion is part of the ETag, bump it when the feed rendering
+	// changes so that pollers download the new format
+	feedFormatVersion = 1
+	maxFeedTokenName  = 100
+)
+
+func generateFeedToken() (string, error) {
+	raw := make([]byte, feedTokenBytes)
+	if _, err := rand.Read(raw); err != nil {
+		return "", fmt.Errorf("failed to generate feed token: %w", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(raw), nil
+}
+
+// hashFeedToken is the only form of the token that is stored
+func hashFeedToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// isWellFormedFeedToken avoids a database lookup for values that can't be tokens
+func isWellFormedFeedToken(token string) bool {
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	return err == nil && len(raw) == feedTokenBytes
+}
+
+func normalizeFeedTokenName(name string) (string, error) {
+	name = strings.TrimSpace(name)
+	if utf8.RuneCountInString(name) > maxFeedTokenName {
+		return "", models.ErrInvalidFeedToken
+	}
+	return name, nil
+}
+
+// newFeedVersion derives the ETag and Last-Modified of a feed from its state,
+// Last-Modified has a precision of a second as in the HTTP date format
+func newFeedVersion(state models.FeedState) (string, time.Time) {
+	var lastModified time.Time
+	for _, updatedAt := range []*time.Time{state.SessionsUpdatedAt, state.SubjectsUpdatedAt} {
+		if updatedAt != nil && updatedAt.After(lastModified) {
+			lastModified = *updatedAt
+		}
+	}
+	micros := func(t *time.Time) int64 {
+		if t == nil {
+			return 0
+		}
+		return t.UnixMicro()
+	}
+	sum := sha256.Sum256(fmt.Appendf(nil, "%d|%d|%d|%d",
+		feedFormatVersion, state.SessionCount, micros(state.SessionsUpdatedAt), micros(state.SubjectsUpdatedAt),
+	))
+	etag := `"` + hex.EncodeToString(sum[:8]) + `"`
+	return etag, lastModified.UTC().Truncate(time.Second)
+}
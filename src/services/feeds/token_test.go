@@ -0,0 +1,56 @@
+package feeds
+
+import (
+	models "go-api/src/models/feeds"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGenerateFeedToken(t *testing.T) {
+	token, err := generateFeedToken()
+	assert.NoError(t, err)
+	other, err := generateFeedToken()
+	assert.NoError(t, err)
+
+	assert.Len(t, token, 43)
+	assert.NotEqual(t, token, other)
+	assert.True(t, isWellFormedFeedToken(token))
+	assert.Len(t, hashFeedToken(token), 64)
+	assert.NotEqual(t, hashFeedToken(token), hashFeedToken(other))
+}
+
+func TestIsWellFormedFeedToken(t *testing.T) {
+	assert.False(t, isWellFormedFeedToken(""))
+	assert.False(t, isWellFormedFeedToken("not a token"))
+	assert.False(t, isWellFormedFeedToken(strings.Repeat("a", 42)))
+	assert.True(t, isWellFormedFeedToken(strings.Repeat("a", 43)))
+}
+
+func TestNewFeedVersion(t *testing.T) {
+	sessionsUpdatedAt := time.Date(2025, 1, 1, 10, 0, 0, 500000000, time.UTC)
+	subjectsUpdatedAt := sessionsUpdatedAt.Add(-time.Hour)
+	state := models.FeedState{
+		SessionCount:      3,
+		SessionsUpdatedAt: &sessionsUpdatedAt,
+		SubjectsUpdatedAt: &subjectsUpdatedAt,
+	}
+
+	etag, lastModified := newFeedVersion(state)
+	assert.Regexp(t, `^"[0-9a-f]{16}"$`, etag)
+	assert.Equal(t, sessionsUpdatedAt.Truncate(time.Second), lastModified)
+
+	sameEtag, _ := newFeedVersion(state)
+	assert.Equal(t, etag, sameEtag)
+
+	// A purged session only changes the count
+	state.SessionCount = 2
+	otherEtag, _ := newFeedVersion(state)
+	assert.NotEqual(t, etag, otherEtag)
+
+	emptyEtag, emptyLastModified := newFeedVersion(models.FeedState{})
+	assert.NotEmpty(t, emptyEtag)
+	assert.True(t, emptyLastModified.IsZero())
+}
@@ -0,0 +1,6 @@
+package feeds
+
+type CreateFeedTokenRequest struct {
+	// Name helps the user recognize the token, e.g. the calendar app using it
+	Name string `json:"name"`
+}
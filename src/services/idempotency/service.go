@@ -0,0 +1,125 @@
+package idempotency
+
+import (
+	"context"
+	"fmt"
+	"go-api/src/config"
+	authmodel "go-api/src/models/auth"
+	"go-api/src/models/constants"
+	models "go-api/src/models/idempotency"
+	repository "go-api/src/repositories/idempotency"
+	"time"
+
+	"go.uber.org/fx"
+	"go.uber.org/zap"
+)
+
+const (
+	maxKeyLength = 255
+	// lockTimeout is how long a request holds its key, a repeat arriving
+	// later takes the key over, e.g. when the server stopped mid request
+	lockTimeout = 5 * time.Minute
+)
+
+type IdempotencyService interface {
+	ReserveKey(ctx context.Context, key string, requestHash string) (*models.Record, error)
+	CompleteKey(ctx context.Context, record models.Record, response models.Response) error
+	ReleaseKey(ctx context.Context, record models.Record) error
+	PurgeExpiredKeys(ctx context.Context) (int64, error)
+}
+
+type idempotencyService struct {
+	config     *config.Config
+	repository repository.IdempotencyRepository
+	logger     *zap.Logger
+}
+
+type IdempotencyServiceParams struct {
+	fx.In
+
+	Config     *config.Config
+	Repository repository.IdempotencyRepository
+	Logger     *zap.Logger
+}
+
+func NewIdempotencyService(p IdempotencyServiceParams) IdempotencyService {
+	return &idempotencyService{
+		config:     p.Config,
+		repository: p.Repository,
+		logger:     p.Logger,
+	}
+}
+
+// ReserveKey reserves the key of the user for the request. When the request
+// was already handled the returned record holds its response, otherwise the
+// record is a new reservation to complete or release once handled.
+func (s idempotencyService) ReserveKey(ctx context.Context, key string, requestHash string) (*models.Record, error) {
+	user := ctx.Value(constants.ContextKeyUserInfoKey).(*authmodel.UserInfo)
+	if user == nil {
+		s.logger.Error("Failed to reserve idempotency key, no user found in context")
+		return nil, fmt.Errorf("no user found in context")
+	}
+	if !isValidKey(key) {
+		return nil, models.ErrInvalidKey
+	}
+
+	now := time.Now().UTC().Round(time.Microsecond)
+	record := models.Record{
+		UserID:      user.ID,
+		Key:         key,
+		RequestHash: requestHash,
+		CreatedAt:   now,
+		ExpiresAt:   now.Add(time.Duration(s.config.IdempotencyKeyTTLHours) * time.Hour),
+	}
+	reserved, err := s.repository.ReserveKey(ctx, record, now.Add(-lockTimeout))
+	if err != nil {
+		return nil, err
+	}
+	if reserved {
+		return &record, nil
+	}
+
+	stored, err := s.repository.GetKey(ctx, user.ID, key)
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case stored == nil:
+		// Released by the first request right after the reservation failed
+		return nil, models.ErrKeyInUse
+	case stored.RequestHash != requestHash:
+		return nil, models.ErrKeyReused
+	case stored.Response == nil:
+		return nil, models.ErrKeyInUse
+	}
+	return stored, nil
+}
+
+// CompleteKey stores the response replayed for the repeats of the request
+func (s idempotencyService) CompleteKey(ctx context.Context, record models.Record, response models.Response) error {
+	return s.repository.SaveResponse(ctx, record, response)
+}
+
+// ReleaseKey frees the key of a request that failed so it can be retried
+func (s idempotencyService) ReleaseKey(ctx context.Context, record models.Record) error {
+	return s.repository.ReleaseKey(ctx, record)
+}
+
+// PurgeExpiredKeys deletes the keys whose TTL is over
+func (s idempotencyService) PurgeExpiredKeys(ctx context.Context) (int64, error) {
+	return s.repository.PurgeExpiredKeys(ctx, time.Now())
+}
+
+// isValidKey accepts up to 255 printable ASCII characters, enough for UUIDs
+// and the random strings clients usually send
+func isValidKey(key string) bool {
+	if key == "" || len(key) > maxKeyLength {
+		return false
+	}
+	for i := 0; i < len(key); i++ {
+		if key[i] < 0x21 || key[i] > 0x7e {
+			return false
+		}
+	}
+	return true
+}
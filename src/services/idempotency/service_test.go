@@ -0,0 +1,136 @@
+package idempotency
+
+import (
+	"context"
+	mockrepository "go-api/.internal/mocks/src/repositories/idempotency"
+	"go-api/src/config"
+	authmodel "go-api/src/models/auth"
+	"go-api/src/models/constants"
+	models "go-api/src/models/idempotency"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/zap/zaptest"
+)
+
+// ServiceTestSuite ...
+type ServiceTestSuite struct {
+	suite.Suite
+
+	MockRepository *mockrepository.IdempotencyRepository
+
+	User    *authmodel.UserInfo
+	Service IdempotencyService
+}
+
+// SetupTest ...
+func (s *ServiceTestSuite) SetupTest() {
+	t := s.T()
+	s.MockRepository = mockrepository.NewIdempotencyRepository(t)
+	s.User = &authmodel.UserInfo{ID: uuid.New()}
+	s.Service = NewIdempotencyService(IdempotencyServiceParams{
+		Config:     &config.Config{IdempotencyKeyTTLHours: 24},
+		Repository: s.MockRepository,
+		Logger:     zaptest.NewLogger(t),
+	})
+}
+
+// SetupSubTest ...
+func (s *ServiceTestSuite) SetupSubTest() {
+	s.SetupTest() // Clean up the mocks
+}
+
+// TestServiceTestSuite ...
+func TestServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(ServiceTestSuite))
+}
+
+func (s *ServiceTestSuite) userContext() context.Context {
+	return context.WithValue(context.Background(), constants.ContextKeyUserInfoKey, s.User)
+}
+
+// TestReserveKey ...
+func (s *ServiceTestSuite) TestReserveKey() {
+	key, requestHash := "key-1", strings.Repeat("a", 64)
+	response := &models.Response{StatusCode: http.StatusCreated, Header: http.Header{}, Body: []byte(`{}`)}
+	reservation := mock.MatchedBy(func(record models.Record) bool {
+		return record.UserID == s.User.ID && record.Key == key && record.RequestHash == requestHash &&
+			record.ExpiresAt.Sub(record.CreatedAt) == 24*time.Hour
+	})
+	stored := func(requestHash string, response *models.Response) *models.Record {
+		return &models.Record{UserID: s.User.ID, Key: key, RequestHash: requestHash, Response: response}
+	}
+
+	tests := map[string]struct {
+		Key              string
+		MockSetup        func()
+		ExpectedResponse *models.Response
+		ExpectedError    error
+	}{
+		"new key is reserved": {
+			Key: key,
+			MockSetup: func() {
+				s.MockRepository.EXPECT().ReserveKey(mock.Anything, reservation, mock.Anything).Return(true, nil)
+			},
+		},
+		"repeat replays the stored response": {
+			Key: key,
+			MockSetup: func() {
+				s.MockRepository.EXPECT().ReserveKey(mock.Anything, reservation, mock.Anything).Return(false, nil)
+				s.MockRepository.EXPECT().GetKey(mock.Anything, s.User.ID, key).Return(stored(requestHash, response), nil)
+			},
+			ExpectedResponse: response,
+		},
+		"fail - key reused for another request": {
+			Key: key,
+			MockSetup: func() {
+				s.MockRepository.EXPECT().ReserveKey(mock.Anything, reservation, mock.Anything).Return(false, nil)
+				s.MockRepository.EXPECT().GetKey(mock.Anything, s.User.ID, key).Return(stored(strings.Repeat("b", 64), response), nil)
+			},
+			ExpectedError: models.ErrKeyReused,
+		},
+		"fail - first request in progress": {
+			Key: key,
+			MockSetup: func() {
+				s.MockRepository.EXPECT().ReserveKey(mock.Anything, reservation, mock.Anything).Return(false, nil)
+				s.MockRepository.EXPECT().GetKey(mock.Anything, s.User.ID, key).Return(stored(requestHash, nil), nil)
+			},
+			ExpectedError: models.ErrKeyInUse,
+		},
+		"fail - empty key": {
+			Key:           "",
+			MockSetup:     func() {},
+			ExpectedError: models.ErrInvalidKey,
+		},
+		"fail - key too long": {
+			Key:           strings.Repeat("k", maxKeyLength+1),
+			MockSetup:     func() {},
+			ExpectedError: models.ErrInvalidKey,
+		},
+		"fail - key with spaces": {
+			Key:           "my key",
+			MockSetup:     func() {},
+			ExpectedError: models.ErrInvalidKey,
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			tc.MockSetup()
+
+			record, err := s.Service.ReserveKey(s.userContext(), tc.Key, requestHash)
+
+			if tc.ExpectedError != nil {
+				s.ErrorIs(err, tc.ExpectedError)
+				return
+			}
+			s.NoError(err)
+			s.Equal(tc.ExpectedResponse, record.Response)
+		})
+	}
+}
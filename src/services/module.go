@@ -2,8 +2,14 @@ package services
 
 import (
 	"go-api/src/services/auth"
+	"go-api/src/services/feeds"
 	"go-api/src/services/healthcheck"
+	"go-api/src/services/idempotency"
+	"go-api/src/services/notifications"
+	"go-api/src/services/stats"
 	"go-api/src/services/studysession"
+	"go-api/src/services/subjects"
+	"go-api/src/services/webhooks"
 
 	"go.uber.org/fx"
 )
@@ -13,5 +19,11 @@ var Module = fx.Options(
 		healthcheck.New,
 		auth.NewAuthService,
 		studysession.NewStudySessionService,
+		subjects.NewSubjectService,
+		stats.NewStatsService,
+		feeds.NewFeedService,
+		idempotency.NewIdempotencyService,
+		notifications.NewNotificationService,
+		webhooks.NewWebhookService,
 	),
 )
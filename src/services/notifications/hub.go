@@ -0,0 +1,71 @@
+package notifications
+
+import (
+	"sync"
+
+	"github.com/google/uuid"
+)
+
+// subscriber is a stream open on this replica. Its wake channel holds at most
+// one pending signal, signals sent while the stream is busy are coalesced
+// since the stream reads every notification it missed anyway.
+type subscriber struct {
+	wake chan struct{}
+}
+
+func (s *subscriber) signal() {
+	select {
+	case s.wake <- struct{}{}:
+	default:
+	}
+}
+
+// hub tracks the streams open on this replica by user
+type hub struct {
+	mu          sync.Mutex
+	subscribers map[uuid.UUID]map[*subscriber]struct{}
+}
+
+func newHub() *hub {
+	return &hub{subscribers: map[uuid.UUID]map[*subscriber]struct{}{}}
+}
+
+func (h *hub) subscribe(userID uuid.UUID) *subscriber {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	sub := &subscriber{wake: make(chan struct{}, 1)}
+	if h.subscribers[userID] == nil {
+		h.subscribers[userID] = map[*subscriber]struct{}{}
+	}
+	h.subscribers[userID][sub] = struct{}{}
+	return sub
+}
+
+func (h *hub) unsubscribe(userID uuid.UUID, sub *subscriber) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	delete(h.subscribers[userID], sub)
+	if len(h.subscribers[userID]) == 0 {
+		delete(h.subscribers, userID)
+	}
+}
+
+// wake signals the streams of the user that there are new notifications
+func (h *hub) wake(userID uuid.UUID) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	for sub := range h.subscribers[userID] {
+		sub.signal()
+	}
+}
+
+// wakeAll signals every stream, e.g. when notifications may have been missed
+func (h *hub) wakeAll() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	for _, subscribers := range h.subscribers {
+		for sub := range subscribers {
+			sub.signal()
+		}
+	}
+}
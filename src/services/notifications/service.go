@@ -0,0 +1,158 @@
+package notifications
+
+import (
+	"context"
+	"fmt"
+	"go-api/src/clients/postgres"
+	"go-api/src/config"
+	authmodel "go-api/src/models/auth"
+	"go-api/src/models/constants"
+	models "go-api/src/models/notifications"
+	repository "go-api/src/repositories/notifications"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/fx"
+	"go.uber.org/zap"
+)
+
+// notificationBatchSize bounds the notifications read at once by a stream
+const notificationBatchSize = 100
+
+type NotificationService interface {
+	PublishNotification(ctx context.Context, notification models.Notification) error
+	Subscribe(ctx context.Context, request SubscribeRequest) (<-chan models.Notification, error)
+	ListenNotifications(ctx context.Context) error
+	PurgeNotifications(ctx context.Context) (int64, error)
+}
+
+type notificationService struct {
+	config     *config.Config
+	repository repository.NotificationRepository
+	listener   postgres.PostgresListener
+	logger     *zap.Logger
+	hub        *hub
+}
+
+type NotificationServiceParams struct {
+	fx.In
+
+	Config     *config.Config
+	Repository repository.NotificationRepository
+	Listener   postgres.PostgresListener
+	Logger     *zap.Logger
+}
+
+func NewNotificationService(p NotificationServiceParams) NotificationService {
+	return &notificationService{
+		config:     p.Config,
+		repository: p.Repository,
+		listener:   p.Listener,
+		logger:     p.Logger,
+		hub:        newHub(),
+	}
+}
+
+// PublishNotification stores the notification, the streams of the user on
+// every replica are woken up once it's committed
+func (s notificationService) PublishNotification(ctx context.Context, notification models.Notification) error {
+	_, err := s.repository.CreateNotification(ctx, notification)
+	return err
+}
+
+// Subscribe opens a stream of the notifications of the user. The channel is
+// closed when the context is done or the notifications can't be read
+// anymore, the client is expected to reconnect with the last id it received.
+func (s notificationService) Subscribe(ctx context.Context, request SubscribeRequest) (<-chan models.Notification, error) {
+	user := ctx.Value(constants.ContextKeyUserInfoKey).(*authmodel.UserInfo)
+	if user == nil {
+		s.logger.Error("Failed to subscribe to notifications, no user found in context")
+		return nil, fmt.Errorf("no user found in context")
+	}
+	var afterID int64
+	if request.LastEventID != "" {
+		id, err := strconv.ParseInt(request.LastEventID, 10, 64)
+		if err != nil || id < 0 {
+			return nil, models.ErrInvalidLastEventID
+		}
+		afterID = id
+	}
+
+	// Subscribe before reading the latest id, a notification published in
+	// between still wakes the stream up
+	sub := s.hub.subscribe(user.ID)
+	if request.LastEventID == "" {
+		latestID, err := s.repository.GetLatestNotificationID(ctx, user.ID)
+		if err != nil {
+			s.hub.unsubscribe(user.ID, sub)
+			return nil, err
+		}
+		afterID = latestID
+	}
+
+	notifications := make(chan models.Notification)
+	go s.stream(ctx, user.ID, sub, afterID, notifications)
+	return notifications, nil
+}
+
+// stream sends the notifications published after afterID, then waits to be
+// woken up to send the next ones
+func (s notificationService) stream(ctx context.Context, userID uuid.UUID, sub *subscriber, afterID int64, notifications chan<- models.Notification) {
+	defer close(notifications)
+	defer s.hub.unsubscribe(userID, sub)
+
+	for {
+		batch, err := s.repository.ListNotifications(ctx, userID, afterID, notificationBatchSize)
+		if err != nil {
+			if ctx.Err() == nil {
+				s.logger.Error("Failed to read notifications", zap.Error(err), zap.String("user_id", userID.String()))
+			}
+			return
+		}
+		for _, notification := range batch {
+			select {
+			case notifications <- notification:
+				afterID = notification.ID
+			case <-ctx.Done():
+				return
+			}
+		}
+		if len(batch) == notificationBatchSize {
+			continue
+		}
+
+		select {
+		case <-sub.wake:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// ListenNotifications wakes up the streams open on this replica whenever a
+// notification is published, on any replica, until the context is done
+func (s notificationService) ListenNotifications(ctx context.Context) error {
+	return s.listener.Listen(ctx, repository.NotificationChannel, s.handlePayload)
+}
+
+func (s notificationService) handlePayload(payload string) {
+	// Notifications may have been missed while the connection was down
+	if payload == "" {
+		s.hub.wakeAll()
+		return
+	}
+	userID, err := uuid.Parse(payload)
+	if err != nil {
+		s.logger.Warn("Ignoring notification with invalid payload", zap.String("payload", payload))
+		return
+	}
+	s.hub.wake(userID)
+}
+
+// PurgeNotifications deletes the notifications older than the retention
+// window, streams can't resume from them anymore
+func (s notificationService) PurgeNotifications(ctx context.Context) (int64, error) {
+	retention := time.Duration(s.config.SessionNotificationRetentionHours) * time.Hour
+	return s.repository.PurgeNotifications(ctx, time.Now().Add(-retention))
+}
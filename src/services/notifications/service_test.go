@@ -0,0 +1,188 @@
+package notifications
+
+import (
+	"context"
+	mockpostgres "go-api/.internal/mocks/src/clients/postgres"
+	mockrepository "go-api/.internal/mocks/src/repositories/notifications"
+	"go-api/src/config"
+	authmodel "go-api/src/models/auth"
+	"go-api/src/models/constants"
+	models "go-api/src/models/notifications"
+	repository "go-api/src/repositories/notifications"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/zap/zaptest"
+)
+
+// ServiceTestSuite ...
+type ServiceTestSuite struct {
+	suite.Suite
+
+	MockRepository *mockrepository.NotificationRepository
+	MockListener   *mockpostgres.PostgresListener
+
+	User    *authmodel.UserInfo
+	Service NotificationService
+}
+
+// SetupTest ...
+func (s *ServiceTestSuite) SetupTest() {
+	t := s.T()
+	s.MockRepository = mockrepository.NewNotificationRepository(t)
+	s.MockListener = mockpostgres.NewPostgresListener(t)
+	s.User = &authmodel.UserInfo{ID: uuid.New()}
+	s.Service = NewNotificationService(NotificationServiceParams{
+		Config:     &config.Config{SessionNotificationRetentionHours: 24},
+		Repository: s.MockRepository,
+		Listener:   s.MockListener,
+		Logger:     zaptest.NewLogger(t),
+	})
+}
+
+// SetupSubTest ...
+func (s *ServiceTestSuite) SetupSubTest() {
+	s.SetupTest() // Clean up the mocks
+}
+
+// TestServiceTestSuite ...
+func TestServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(ServiceTestSuite))
+}
+
+func (s *ServiceTestSuite) userContext() (context.Context, context.CancelFunc) {
+	return context.WithCancel(context.WithValue(context.Background(), constants.ContextKeyUserInfoKey, s.User))
+}
+
+// receive waits for the next notification of the stream
+func (s *ServiceTestSuite) receive(notifications <-chan models.Notification) models.Notification {
+	select {
+	case notification, ok := <-notifications:
+		s.Require().True(ok, "stream closed")
+		return notification
+	case <-time.After(time.Second):
+		s.FailNow("no notification received")
+		return models.Notification{}
+	}
+}
+
+// close ends the stream and waits for it to be closed
+func (s *ServiceTestSuite) close(cancel context.CancelFunc, notifications <-chan models.Notification) {
+	cancel()
+	for range notifications {
+	}
+}
+
+func (s *ServiceTestSuite) notification(id int64) models.Notification {
+	return models.Notification{ID: id, UserID: s.User.ID, SessionID: uuid.New(), Type: models.NotificationTypeStarted}
+}
+
+// TestSubscribe ...
+func (s *ServiceTestSuite) TestSubscribe() {
+	tests := map[string]struct {
+		LastEventID   string
+		ExpectedError error
+	}{
+		"invalid last event id": {
+			LastEventID:   "abc",
+			ExpectedError: models.ErrInvalidLastEventID,
+		},
+		"negative last event id": {
+			LastEventID:   "-1",
+			ExpectedError: models.ErrInvalidLastEventID,
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			ctx, cancel := s.userContext()
+			defer cancel()
+
+			_, err := s.Service.Subscribe(ctx, SubscribeRequest{LastEventID: tc.LastEventID})
+
+			s.ErrorIs(err, tc.ExpectedError)
+		})
+	}
+}
+
+// TestSubscribeResumesAfterLastEventID ...
+func (s *ServiceTestSuite) TestSubscribeResumesAfterLastEventID() {
+	missed := []models.Notification{s.notification(6), s.notification(8)}
+	s.MockRepository.EXPECT().ListNotifications(mock.Anything, s.User.ID, int64(5), notificationBatchSize).Return(missed, nil).Once()
+	s.MockRepository.EXPECT().ListNotifications(mock.Anything, s.User.ID, int64(8), notificationBatchSize).Return(nil, nil).Maybe()
+	ctx, cancel := s.userContext()
+
+	notifications, err := s.Service.Subscribe(ctx, SubscribeRequest{LastEventID: "5"})
+
+	s.Require().NoError(err)
+	s.Equal(missed[0], s.receive(notifications))
+	s.Equal(missed[1], s.receive(notifications))
+	s.close(cancel, notifications)
+}
+
+// TestSubscribeWakesOnPublishedNotification ...
+func (s *ServiceTestSuite) TestSubscribeWakesOnPublishedNotification() {
+	published := s.notification(10)
+	s.MockRepository.EXPECT().GetLatestNotificationID(mock.Anything, s.User.ID).Return(9, nil)
+	s.MockRepository.EXPECT().ListNotifications(mock.Anything, s.User.ID, int64(9), notificationBatchSize).Return(nil, nil).Once()
+	s.MockRepository.EXPECT().ListNotifications(mock.Anything, s.User.ID, int64(9), notificationBatchSize).Return([]models.Notification{published}, nil).Once()
+	s.MockRepository.EXPECT().ListNotifications(mock.Anything, s.User.ID, int64(10), notificationBatchSize).Return(nil, nil).Maybe()
+	s.MockListener.EXPECT().Listen(mock.Anything, repository.NotificationChannel, mock.Anything).
+		Run(func(ctx context.Context, channel string, handle func(string)) {
+			handle(uuid.NewString()) // Another user
+			handle(s.User.ID.String())
+		}).
+		Return(nil)
+	ctx, cancel := s.userContext()
+
+	notifications, err := s.Service.Subscribe(ctx, SubscribeRequest{})
+	s.Require().NoError(err)
+	s.NoError(s.Service.ListenNotifications(context.Background()))
+
+	s.Equal(published, s.receive(notifications))
+	s.close(cancel, notifications)
+}
+
+// TestHandlePayload ...
+func (s *ServiceTestSuite) TestHandlePayload() {
+	userID, otherUserID := uuid.New(), uuid.New()
+
+	tests := map[string]struct {
+		Payload            string
+		ExpectedUserWoken  bool
+		ExpectedOtherWoken bool
+	}{
+		"notification of the user": {
+			Payload:           userID.String(),
+			ExpectedUserWoken: true,
+		},
+		"notification of another user": {
+			Payload:            otherUserID.String(),
+			ExpectedOtherWoken: true,
+		},
+		"reconnection wakes every stream": {
+			Payload:            "",
+			ExpectedUserWoken:  true,
+			ExpectedOtherWoken: true,
+		},
+		"invalid payload is ignored": {
+			Payload: "not a user",
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			service := s.Service.(*notificationService)
+			userSubscriber := service.hub.subscribe(userID)
+			otherSubscriber := service.hub.subscribe(otherUserID)
+
+			service.handlePayload(tc.Payload)
+
+			s.Equal(tc.ExpectedUserWoken, len(userSubscriber.wake) == 1)
+			s.Equal(tc.ExpectedOtherWoken, len(otherSubscriber.wake) == 1)
+		})
+	}
+}
@@ -0,0 +1,8 @@
+package notifications
+
+// SubscribeRequest ...
+type SubscribeRequest struct {
+	// LastEventID is the id of the last notification the client received,
+	// the stream resumes after it. Without it only new notifications are sent.
+	LastEventID string
+}
@@ -0,0 +1,148 @@
+package stats
+
+import (
+	models "go-api/src/models/stats"
+	sessionmodels "go-api/src/models/studysession"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	statsDateLayout = "2006-01-02"
+	// maxStatsBuckets keeps a single request to about a year of daily buckets
+	maxStatsBuckets = 366
+	minHeatmapYear  = 2000
+)
+
+// defaultStatsBuckets is the number of buckets returned when no start date is requested
+var defaultStatsBuckets = map[models.Granularity]int{
+	models.GranularityDay:   30,
+	models.GranularityWeek:  12,
+	models.GranularityMonth: 12,
+}
+
+// statsRange is the period covered by a stats request, From and End are
+// local midnights in Location and End is exclusive
+type statsRange struct {
+	Granularity models.Granularity
+	Location    *time.Location
+	From        time.Time
+	End         time.Time
+}
+
+func buildStatsRange(request GetStudyStatsRequest, now time.Time) (statsRange, error) {
+	statsRange := statsRange{
+		Granularity: models.Granularity(request.Granularity),
+		Location:    time.UTC,
+	}
+	if statsRange.Granularity == "" {
+		statsRange.Granularity = models.GranularityDay
+	}
+	if !statsRange.Granularity.IsValid() {
+		return statsRange, models.ErrInvalidStatsFilter
+	}
+
+	if request.Timezone != "" {
+		location, err := loadLocation(request.Timezone)
+		if err != nil {
+			return statsRange, err
+		}
+		statsRange.Location = location
+	}
+
+	lastBucket := statsRange.Granularity.BucketStart(now.In(statsRange.Location))
+	if request.To != "" {
+		to, err := time.ParseInLocation(statsDateLayout, request.To, statsRange.Location)
+		if err != nil {
+			return statsRange, models.ErrInvalidStatsFilter
+		}
+		lastBucket = statsRange.Granularity.BucketStart(to)
+	}
+	statsRange.End = statsRange.Granularity.NextBucket(lastBucket)
+
+	if request.From != "" {
+		from, err := time.ParseInLocation(statsDateLayout, request.From, statsRange.Location)
+		if err != nil {
+			return statsRange, models.ErrInvalidStatsFilter
+		}
+		statsRange.From = statsRange.Granularity.BucketStart(from)
+	} else {
+		statsRange.From = lastBucket
+		for i := 1; i < defaultStatsBuckets[statsRange.Granularity]; i++ {
+			statsRange.From = statsRange.Granularity.PreviousBucket(statsRange.From)
+		}
+	}
+
+	if !statsRange.From.Before(statsRange.End) {
+		return statsRange, models.ErrInvalidStatsFilter
+	}
+	buckets := 0
+	for start := statsRange.From; start.Before(statsRange.End); start = statsRange.Granularity.NextBucket(start) {
+		if buckets++; buckets > maxStatsBuckets {
+			return statsRange, models.ErrInvalidStatsFilter
+		}
+	}
+	return statsRange, nil
+}
+
+// heatmapYear defaults to the current year, future years have no sessions
+func heatmapYear(year int, today time.Time) (int, error) {
+	if year == 0 {
+		return today.Year(), nil
+	}
+	if year < minHeatmapYear || year > today.Year() {
+		return 0, models.ErrInvalidStatsFilter
+	}
+	return year, nil
+}
+
+// aggregateStats buckets the sessions by the local time of their start event
+func aggregateStats(statsRange statsRange, eventsBySession map[uuid.UUID][]sessionmodels.SessionEvent, now time.Time) models.StudyStats {
+	stats := models.StudyStats{
+		Granularity: statsRange.Granularity,
+		Timezone:    statsRange.Location.String(),
+		Buckets:     []models.StatsBucket{},
+	}
+	bucketIndex := map[int64]int{}
+	for start := statsRange.From; start.Before(statsRange.End); start = statsRange.Granularity.NextBucket(start) {
+		bucketIndex[start.Unix()] = len(stats.Buckets)
+		stats.Buckets = append(stats.Buckets, models.StatsBucket{Start: start})
+	}
+
+	for _, events := range eventsBySession {
+		startedAt, ok := sessionStart(events)
+		if !ok {
+			continue
+		}
+		index, ok := bucketIndex[statsRange.Granularity.BucketStart(startedAt.In(statsRange.Location)).Unix()]
+		if !ok {
+			continue
+		}
+		focused := sessionmodels.ComputeDurations(events, now).FocusedSeconds
+		bucket := &stats.Buckets[index]
+		bucket.FocusedSeconds += focused
+		bucket.SessionCount++
+		if focused > bucket.LongestSessionSeconds {
+			bucket.LongestSessionSeconds = focused
+		}
+	}
+
+	for i := range stats.Buckets {
+		if stats.Buckets[i].SessionCount > 0 {
+			stats.Buckets[i].AverageSessionSeconds = stats.Buckets[i].FocusedSeconds / int64(stats.Buckets[i].SessionCount)
+		}
+	}
+	return stats
+}
+
+// sessionStart returns the time of the first start event
+func sessionStart(events []sessionmodels.SessionEvent) (time.Time, bool) {
+	var startedAt time.Time
+	for _, event := range events {
+		if event.EventType == sessionmodels.EventTypeStart && (startedAt.IsZero() || event.EventTime.Before(startedAt)) {
+			startedAt = event.EventTime
+		}
+	}
+	return startedAt, !startedAt.IsZero()
+}
@@ -0,0 +1,135 @@
+package stats
+
+import (
+	models "go-api/src/models/stats"
+	sessionmodels "go-api/src/models/studysession"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuildStatsRange(t *testing.T) {
+	tokyo, err := time.LoadLocation("Asia/Tokyo")
+	assert.NoError(t, err)
+	now := time.Date(2025, 1, 15, 20, 0, 0, 0, time.UTC) // 2025-01-16 05:00 in Tokyo
+
+	tests := map[string]struct {
+		Request       GetStudyStatsRequest
+		ExpectedRange statsRange
+		ExpectedError error
+	}{
+		"defaults to the last 30 days in utc": {
+			Request: GetStudyStatsRequest{},
+			ExpectedRange: statsRange{
+				Granularity: models.GranularityDay,
+				Location:    time.UTC,
+				From:        time.Date(2024, 12, 17, 0, 0, 0, 0, time.UTC),
+				End:         time.Date(2025, 1, 16, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		"today follows the requested timezone": {
+			Request: GetStudyStatsRequest{Granularity: "week", Timezone: "Asia/Tokyo"},
+			ExpectedRange: statsRange{
+				Granularity: models.GranularityWeek,
+				Location:    tokyo,
+				From:        time.Date(2024, 10, 28, 0, 0, 0, 0, tokyo),
+				End:         time.Date(2025, 1, 20, 0, 0, 0, 0, tokyo),
+			},
+		},
+		"dates are aligned to the buckets": {
+			Request: GetStudyStatsRequest{Granularity: "month", From: "2024-11-20", To: "2025-01-02"},
+			ExpectedRange: statsRange{
+				Granularity: models.GranularityMonth,
+				Location:    time.UTC,
+				From:        time.Date(2024, 11, 1, 0, 0, 0, 0, time.UTC),
+				End:         time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		"invalid granularity": {
+			Request:       GetStudyStatsRequest{Granularity: "year"},
+			ExpectedError: models.ErrInvalidStatsFilter,
+		},
+		"invalid timezone": {
+			Request:       GetStudyStatsRequest{Timezone: "Mars/Olympus"},
+			ExpectedError: models.ErrInvalidTimezone,
+		},
+		"server timezone": {
+			Request:       GetStudyStatsRequest{Timezone: "Local"},
+			ExpectedError: models.ErrInvalidTimezone,
+		},
+		"invalid date": {
+			Request:       GetStudyStatsRequest{From: "15/01/2025"},
+			ExpectedError: models.ErrInvalidStatsFilter,
+		},
+		"from after to": {
+			Request:       GetStudyStatsRequest{From: "2025-01-10", To: "2025-01-01"},
+			ExpectedError: models.ErrInvalidStatsFilter,
+		},
+		"too many buckets": {
+			Request:       GetStudyStatsRequest{From: "2023-01-01", To: "2025-01-01"},
+			ExpectedError: models.ErrInvalidStatsFilter,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			statsRange, err := buildStatsRange(tc.Request, now)
+			assert.Equal(t, tc.ExpectedError, err)
+			if tc.ExpectedError == nil {
+				assert.Equal(t, tc.ExpectedRange, statsRange)
+			}
+		})
+	}
+}
+
+func TestAggregateStats(t *testing.T) {
+	newYork, err := time.LoadLocation("America/New_York")
+	assert.NoError(t, err)
+	statsRange := statsRange{
+		Granularity: models.GranularityDay,
+		Location:    newYork,
+		From:        time.Date(2025, 1, 1, 0, 0, 0, 0, newYork),
+		End:         time.Date(2025, 1, 3, 0, 0, 0, 0, newYork),
+	}
+	session := func(start time.Time, events ...sessionmodels.SessionEvent) []sessionmodels.SessionEvent {
+		return append([]sessionmodels.SessionEvent{{EventType: sessionmodels.EventTypeStart, EventTime: start}}, events...)
+	}
+	stop := func(at time.Time) sessionmodels.SessionEvent {
+		return sessionmodels.SessionEvent{EventType: sessionmodels.EventTypeStop, EventTime: at}
+	}
+	// 2025-01-02 01:00 UTC is still the evening of 2025-01-01 in New York
+	lateEvening := time.Date(2025, 1, 2, 1, 0, 0, 0, time.UTC)
+	nextDay := time.Date(2025, 1, 2, 15, 0, 0, 0, time.UTC)
+
+	stats := aggregateStats(statsRange, map[uuid.UUID][]sessionmodels.SessionEvent{
+		uuid.New(): session(lateEvening, stop(lateEvening.Add(30*time.Minute))),
+		uuid.New(): session(nextDay, stop(nextDay.Add(time.Hour))),
+		uuid.New(): session(nextDay.Add(2*time.Hour),
+			sessionmodels.SessionEvent{EventType: sessionmodels.EventTypePause, EventTime: nextDay.Add(150 * time.Minute)},
+			stop(nextDay.Add(3*time.Hour)),
+		),
+	}, nextDay.Add(4*time.Hour))
+
+	assert.Equal(t, models.StudyStats{
+		Granularity: models.GranularityDay,
+		Timezone:    "America/New_York",
+		Buckets: []models.StatsBucket{
+			{
+				Start:                 statsRange.From,
+				FocusedSeconds:        1800,
+				SessionCount:          1,
+				AverageSessionSeconds: 1800,
+				LongestSessionSeconds: 1800,
+			},
+			{
+				Start:                 time.Date(2025, 1, 2, 0, 0, 0, 0, newYork),
+				FocusedSeconds:        5400,
+				SessionCount:          2,
+				AverageSessionSeconds: 2700,
+				LongestSessionSeconds: 3600,
+			},
+		},
+	}, stats)
+}
@@ -0,0 +1,207 @@
+package stats
+
+import (
+	"context"
+	"fmt"
+	"go-api/src/config"
+	authmodel "go-api/src/models/auth"
+	"go-api/src/models/constants"
+	models "go-api/src/models/stats"
+	sessionmodels "go-api/src/models/studysession"
+	repository "go-api/src/repositories/stats"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/fx"
+	"go.uber.org/zap"
+)
+
+type StatsService interface {
+	GetStudyStats(ctx context.Context, request GetStudyStatsRequest) (*models.StudyStats, error)
+	GetStreak(ctx context.Context) (*models.Streak, error)
+	UpdateStreakSettings(ctx context.Context, request UpdateStreakSettingsRequest) (*models.StreakSettings, error)
+	GetStreakUpdate(ctx context.Context, sessionID uuid.UUID) (*models.StreakUpdate, error)
+	GetHeatmap(ctx context.Context, request GetHeatmapRequest) (*models.Heatmap, error)
+}
+
+type statsService struct {
+	config     *config.Config
+	repository repository.StatsRepository
+	logger     *zap.Logger
+}
+
+type StatsServiceParams struct {
+	fx.In
+
+	Config     *config.Config
+	Repository repository.StatsRepository
+	Logger     *zap.Logger
+}
+
+func NewStatsService(p StatsServiceParams) StatsService {
+	return &statsService{
+		config:     p.Config,
+		repository: p.Repository,
+		logger:     p.Logger,
+	}
+}
+
+func (s statsService) GetStudyStats(ctx context.Context, request GetStudyStatsRequest) (*models.StudyStats, error) {
+	user := ctx.Value(constants.ContextKeyUserInfoKey).(*authmodel.UserInfo)
+	if user == nil {
+		s.logger.Error("Failed to get study stats, no user found in context")
+		return nil, fmt.Errorf("no user found in context")
+	}
+	now := time.Now()
+	statsRange, err := buildStatsRange(request, now)
+	if err != nil {
+		return nil, err
+	}
+	eventsBySession, err := s.repository.ListCompletedSessionEvents(ctx, user.ID, statsRange.From, statsRange.End)
+	if err != nil {
+		return nil, err
+	}
+	stats := aggregateStats(statsRange, eventsBySession, now)
+	return &stats, nil
+}
+
+func (s statsService) GetStreak(ctx context.Context) (*models.Streak, error) {
+	user := ctx.Value(constants.ContextKeyUserInfoKey).(*authmodel.UserInfo)
+	if user == nil {
+		s.logger.Error("Failed to get streak, no user found in context")
+		return nil, fmt.Errorf("no user found in context")
+	}
+	settings, location, daily, err := s.loadStreakData(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+	streak := computeStreak(daily, location, s.streakRules(*settings), time.Now())
+	streak.DailyGoalMinutes = settings.DailyGoalMinutes
+	streak.Timezone = settings.Timezone
+	return &streak, nil
+}
+
+func (s statsService) UpdateStreakSettings(ctx context.Context, request UpdateStreakSettingsRequest) (*models.StreakSettings, error) {
+	user := ctx.Value(constants.ContextKeyUserInfoKey).(*authmodel.UserInfo)
+	if user == nil {
+		s.logger.Error("Failed to update streak settings, no user found in context")
+		return nil, fmt.Errorf("no user found in context")
+	}
+	settings, err := buildStreakSettings(request)
+	if err != nil {
+		return nil, err
+	}
+	return s.repository.UpsertStreakSettings(ctx, user.ID, settings)
+}
+
+// GetStreakUpdate compares the user's streak with and without the given
+// completed session
+func (s statsService) GetStreakUpdate(ctx context.Context, sessionID uuid.UUID) (*models.StreakUpdate, error) {
+	user := ctx.Value(constants.ContextKeyUserInfoKey).(*authmodel.UserInfo)
+	if user == nil {
+		s.logger.Error("Failed to get streak update, no user found in context")
+		return nil, fmt.Errorf("no user found in context")
+	}
+	settings, location, daily, err := s.loadStreakData(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+	sessionDaily, err := s.repository.GetSessionDailyFocusedSeconds(ctx, sessionID, settings.Timezone)
+	if err != nil {
+		return nil, err
+	}
+	rules := s.streakRules(*settings)
+	now := time.Now()
+	before := computeStreak(withoutDailyFocus(daily, sessionDaily), location, rules, now)
+	after := computeStreak(daily, location, rules, now)
+	update := models.NewStreakUpdate(before, after)
+	return &update, nil
+}
+
+// GetHeatmap returns the focused time of every day of a year. The daily
+// totals are served from the session rollups, the sessions without rollups
+// in the requested timezone are rolled up first.
+func (s statsService) GetHeatmap(ctx context.Context, request GetHeatmapRequest) (*models.Heatmap, error) {
+	user := ctx.Value(constants.ContextKeyUserInfoKey).(*authmodel.UserInfo)
+	if user == nil {
+		s.logger.Error("Failed to get heatmap, no user found in context")
+		return nil, fmt.Errorf("no user found in context")
+	}
+	timezone := request.Timezone
+	if timezone == "" {
+		settings, err := s.repository.GetStreakSettings(ctx, user.ID)
+		if err != nil {
+			return nil, err
+		}
+		timezone = settings.Timezone
+	}
+	location, err := loadLocation(timezone)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	year, err := heatmapYear(request.Year, now.In(location))
+	if err != nil {
+		return nil, err
+	}
+
+	firstDay := time.Date(year, time.January, 1, 0, 0, 0, 0, time.UTC)
+	lastDay := time.Date(year, time.December, 31, 0, 0, 0, 0, time.UTC)
+	// Session dates are UTC dates, a day of margin covers every timezone
+	eventsBySession, err := s.repository.ListSessionEventsWithoutRollups(ctx, user.ID, timezone, firstDay.AddDate(0, 0, -1), lastDay.AddDate(0, 0, 1))
+	if err != nil {
+		return nil, err
+	}
+	if err := s.saveSessionRollups(ctx, user.ID, timezone, location, eventsBySession, now); err != nil {
+		return nil, err
+	}
+
+	daily, err := s.repository.GetDailyFocusedSeconds(ctx, user.ID, timezone, firstDay, lastDay)
+	if err != nil {
+		return nil, err
+	}
+	heatmap := models.BuildHeatmap(year, timezone, daily)
+	return &heatmap, nil
+}
+
+// loadStreakData returns the focused seconds of every day of the user's
+// history. The daily totals are served from the session rollups, only the
+// sessions without rollups in the user's timezone are rolled up first.
+func (s statsService) loadStreakData(ctx context.Context, userID uuid.UUID) (*models.StreakSettings, *time.Location, map[time.Time]int64, error) {
+	settings, err := s.repository.GetStreakSettings(ctx, userID)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	location, err := loadLocation(settings.Timezone)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	eventsBySession, err := s.repository.ListAllSessionEventsWithoutRollups(ctx, userID, settings.Timezone)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	if err := s.saveSessionRollups(ctx, userID, settings.Timezone, location, eventsBySession, time.Now()); err != nil {
+		return nil, nil, nil, err
+	}
+	daily, err := s.repository.GetAllDailyFocusedSeconds(ctx, userID, settings.Timezone)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	return settings, location, daily, nil
+}
+
+func (s statsService) saveSessionRollups(ctx context.Context, userID uuid.UUID, timezone string, location *time.Location, eventsBySession map[uuid.UUID][]sessionmodels.SessionEvent, now time.Time) error {
+	var rollups []models.SessionDayRollup
+	for sessionID, events := range eventsBySession {
+		rollups = append(rollups, models.RollupSession(sessionID, userID, events, location, now)...)
+	}
+	return s.repository.SaveSessionDayRollups(ctx, timezone, rollups)
+}
+
+func (s statsService) streakRules(settings models.StreakSettings) models.StreakRules {
+	return models.StreakRules{
+		DailyGoalSeconds: int64(settings.DailyGoalMinutes) * 60,
+		FreezeEarnDays:   s.config.StreakFreezeEarnDays,
+		MaxFreezes:       s.config.StreakMaxFreezes,
+	}
+}
@@ -0,0 +1,134 @@
+package stats
+
+import (
+	"context"
+	mockrepository "go-api/.internal/mocks/src/repositories/stats"
+	"go-api/src/config"
+	authmodel "go-api/src/models/auth"
+	"go-api/src/models/constants"
+	models "go-api/src/models/stats"
+	sessionmodels "go-api/src/models/studysession"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/zap/zaptest"
+)
+
+// ServiceTestSuite ...
+type ServiceTestSuite struct {
+	suite.Suite
+
+	MockRepository *mockrepository.StatsRepository
+
+	User    *authmodel.UserInfo
+	Service StatsService
+}
+
+// SetupTest ...
+func (s *ServiceTestSuite) SetupTest() {
+	t := s.T()
+	s.MockRepository = mockrepository.NewStatsRepository(t)
+	s.User = &authmodel.UserInfo{ID: uuid.New()}
+	s.Service = NewStatsService(StatsServiceParams{
+		Config:     &config.Config{StreakFreezeEarnDays: 7, StreakMaxFreezes: 2},
+		Repository: s.MockRepository,
+		Logger:     zaptest.NewLogger(t),
+	})
+}
+
+// SetupSubTest ...
+func (s *ServiceTestSuite) SetupSubTest() {
+	s.SetupTest() // Clean up the mocks
+}
+
+// TestServiceTestSuite ...
+func TestServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(ServiceTestSuite))
+}
+
+func (s *ServiceTestSuite) userContext() context.Context {
+	return context.WithValue(context.Background(), constants.ContextKeyUserInfoKey, s.User)
+}
+
+// TestGetStreakUpdate ...
+func (s *ServiceTestSuite) TestGetStreakUpdate() {
+	now := time.Now().UTC()
+	today := models.LocalDate(now)
+	yesterday := today.AddDate(0, 0, -1)
+	sessionID := uuid.New()
+	start := now.Add(-time.Minute)
+	events := []sessionmodels.SessionEvent{
+		{EventType: sessionmodels.EventTypeStart, EventTime: start},
+		{EventType: sessionmodels.EventTypeStop, EventTime: now},
+	}
+
+	s.Run("rolls up the new sessions and compares the streaks", func() {
+		s.MockRepository.EXPECT().GetStreakSettings(mock.Anything, s.User.ID).
+			Return(&models.StreakSettings{DailyGoalMinutes: 1, Timezone: "UTC"}, nil)
+		s.MockRepository.EXPECT().ListAllSessionEventsWithoutRollups(mock.Anything, s.User.ID, "UTC").
+			Return(map[uuid.UUID][]sessionmodels.SessionEvent{sessionID: events}, nil)
+		s.MockRepository.EXPECT().SaveSessionDayRollups(mock.Anything, "UTC", mock.MatchedBy(func(rollups []models.SessionDayRollup) bool {
+			return len(rollups) > 0 && rollups[0].SessionID == sessionID
+		})).Return(nil)
+		s.MockRepository.EXPECT().GetAllDailyFocusedSeconds(mock.Anything, s.User.ID, "UTC").
+			Return(map[time.Time]int64{yesterday: 120, today: 60}, nil)
+		s.MockRepository.EXPECT().GetSessionDailyFocusedSeconds(mock.Anything, sessionID, "UTC").
+			Return(map[time.Time]int64{today: 60}, nil)
+
+		update, err := s.Service.GetStreakUpdate(s.userContext(), sessionID)
+
+		s.NoError(err)
+		s.Equal(1, update.PreviousStreak)
+		s.Equal(2, update.CurrentStreak)
+		s.True(update.GoalReached)
+	})
+}
+
+// TestGetHeatmap ...
+func (s *ServiceTestSuite) TestGetHeatmap() {
+	firstDay := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	lastDay := time.Date(2024, 12, 31, 0, 0, 0, 0, time.UTC)
+	sessionID := uuid.New()
+	start := time.Date(2024, 5, 10, 9, 0, 0, 0, time.UTC)
+	events := []sessionmodels.SessionEvent{
+		{EventType: sessionmodels.EventTypeStart, EventTime: start},
+		{EventType: sessionmodels.EventTypeStop, EventTime: start.Add(45 * time.Minute)},
+	}
+
+	s.Run("rolls up new sessions before reading the totals", func() {
+		s.MockRepository.EXPECT().GetStreakSettings(mock.Anything, s.User.ID).
+			Return(&models.StreakSettings{DailyGoalMinutes: 30, Timezone: "Europe/Lisbon"}, nil)
+		s.MockRepository.EXPECT().ListSessionEventsWithoutRollups(mock.Anything, s.User.ID, "Europe/Lisbon", firstDay.AddDate(0, 0, -1), lastDay.AddDate(0, 0, 1)).
+			Return(map[uuid.UUID][]sessionmodels.SessionEvent{sessionID: events}, nil)
+		s.MockRepository.EXPECT().SaveSessionDayRollups(mock.Anything, "Europe/Lisbon", []models.SessionDayRollup{{
+			SessionID:      sessionID,
+			UserID:         s.User.ID,
+			Day:            time.Date(2024, 5, 10, 0, 0, 0, 0, time.UTC),
+			FocusedSeconds: 45 * 60,
+		}}).Return(nil)
+		s.MockRepository.EXPECT().GetDailyFocusedSeconds(mock.Anything, s.User.ID, "Europe/Lisbon", firstDay, lastDay).
+			Return(map[time.Time]int64{time.Date(2024, 5, 10, 0, 0, 0, 0, time.UTC): 45 * 60}, nil)
+
+		heatmap, err := s.Service.GetHeatmap(s.userContext(), GetHeatmapRequest{Year: 2024})
+
+		s.NoError(err)
+		s.Equal("Europe/Lisbon", heatmap.Timezone)
+		s.Len(heatmap.Days, 366)
+		s.Equal(models.HeatmapDay{Date: "2024-05-10", FocusedMinutes: 45, Level: 4}, heatmap.Days[130])
+	})
+
+	s.Run("fail - future year", func() {
+		_, err := s.Service.GetHeatmap(s.userContext(), GetHeatmapRequest{Year: time.Now().Year() + 1, Timezone: "UTC"})
+
+		s.ErrorIs(err, models.ErrInvalidStatsFilter)
+	})
+
+	s.Run("fail - invalid timezone", func() {
+		_, err := s.Service.GetHeatmap(s.userContext(), GetHeatmapRequest{Year: 2024, Timezone: "Nowhere"})
+
+		s.ErrorIs(err, models.ErrInvalidTimezone)
+	})
+}
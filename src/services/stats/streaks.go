@@ -0,0 +1,51 @@
+package stats
+
+import (
+	models "go-api/src/models/stats"
+	"time"
+)
+
+// loadLocation resolves an IANA timezone name, "Local" would be the server
+// timezone so it's rejected
+func loadLocation(name string) (*time.Location, error) {
+	location, err := time.LoadLocation(name)
+	if err != nil || name == "Local" {
+		return nil, models.ErrInvalidTimezone
+	}
+	return location, nil
+}
+
+func buildStreakSettings(request UpdateStreakSettingsRequest) (models.StreakSettings, error) {
+	settings := models.StreakSettings{
+		DailyGoalMinutes: request.DailyGoalMinutes,
+		Timezone:         request.Timezone,
+	}
+	if settings.DailyGoalMinutes < 1 || settings.DailyGoalMinutes > models.MaxDailyGoalMinutes {
+		return settings, models.ErrInvalidStreakSettings
+	}
+	if settings.Timezone == "" {
+		return settings, models.ErrInvalidStreakSettings
+	}
+	if _, err := loadLocation(settings.Timezone); err != nil {
+		return settings, err
+	}
+	return settings, nil
+}
+
+// computeStreak computes the streak from the focused seconds of each day
+func computeStreak(daily map[time.Time]int64, location *time.Location, rules models.StreakRules, now time.Time) models.Streak {
+	return models.ComputeStreak(daily, now.In(location), rules)
+}
+
+// withoutDailyFocus removes the focused seconds of a session from the daily
+// totals, so that the streak before a session can be compared with the
+// streak after it
+func withoutDailyFocus(daily map[time.Time]int64, excluded map[time.Time]int64) map[time.Time]int64 {
+	remaining := make(map[time.Time]int64, len(daily))
+	for day, seconds := range daily {
+		if seconds -= excluded[day]; seconds > 0 {
+			remaining[day] = seconds
+		}
+	}
+	return remaining
+}
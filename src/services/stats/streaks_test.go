@@ -0,0 +1,65 @@
+package stats
+
+import (
+	models "go-api/src/models/stats"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuildStreakSettings(t *testing.T) {
+	tests := map[string]struct {
+		Request       UpdateStreakSettingsRequest
+		ExpectedError error
+	}{
+		"valid": {
+			Request: UpdateStreakSettingsRequest{DailyGoalMinutes: 45, Timezone: "America/Sao_Paulo"},
+		},
+		"goal too small": {
+			Request:       UpdateStreakSettingsRequest{DailyGoalMinutes: 0, Timezone: "UTC"},
+			ExpectedError: models.ErrInvalidStreakSettings,
+		},
+		"goal longer than a day": {
+			Request:       UpdateStreakSettingsRequest{DailyGoalMinutes: 1441, Timezone: "UTC"},
+			ExpectedError: models.ErrInvalidStreakSettings,
+		},
+		"missing timezone": {
+			Request:       UpdateStreakSettingsRequest{DailyGoalMinutes: 30},
+			ExpectedError: models.ErrInvalidStreakSettings,
+		},
+		"unknown timezone": {
+			Request:       UpdateStreakSettingsRequest{DailyGoalMinutes: 30, Timezone: "Mars/Olympus"},
+			ExpectedError: models.ErrInvalidTimezone,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			settings, err := buildStreakSettings(tc.Request)
+			assert.Equal(t, tc.ExpectedError, err)
+			if tc.ExpectedError == nil {
+				assert.Equal(t, tc.Request.DailyGoalMinutes, settings.DailyGoalMinutes)
+				assert.Equal(t, tc.Request.Timezone, settings.Timezone)
+			}
+		})
+	}
+}
+
+func TestComputeStreakWithoutSession(t *testing.T) {
+	now := time.Date(2025, 1, 2, 12, 0, 0, 0, time.UTC)
+	yesterday := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	today := time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC)
+	rules := models.StreakRules{DailyGoalSeconds: 1800}
+	daily := map[time.Time]int64{yesterday: 40 * 60, today: 30 * 60}
+	todaySession := map[time.Time]int64{today: 30 * 60}
+
+	before := computeStreak(withoutDailyFocus(daily, todaySession), time.UTC, rules, now)
+	after := computeStreak(daily, time.UTC, rules, now)
+
+	assert.Equal(t, 1, before.CurrentStreak)
+	assert.False(t, before.GoalMetToday)
+	assert.Equal(t, 2, after.CurrentStreak)
+	assert.True(t, after.GoalMetToday)
+	assert.Equal(t, map[time.Time]int64{yesterday: 40 * 60, today: 30 * 60}, daily)
+}
@@ -0,0 +1,20 @@
+package stats
+
+type GetStudyStatsRequest struct {
+	Granularity string `query:"granularity"`
+	From        string `query:"from"`
+	To          string `query:"to"`
+	Timezone    string `query:"tz"`
+}
+
+type UpdateStreakSettingsRequest struct {
+	// DailyGoalMinutes is the focused time needed for a day to count, 1 to 1440
+	DailyGoalMinutes int `json:"daily_goal_minutes"`
+	// Timezone is the IANA name of the timezone the days are counted in
+	Timezone string `json:"timezone"`
+}
+
+type GetHeatmapRequest struct {
+	Year     int    `query:"year"`
+	Timezone string `query:"tz"`
+}
@@ -0,0 +1,140 @@
+package studysession
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	models "go-api/src/models/studysession"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const exportDateLayout = "2006-01-02"
+
+var exportCSVHeader = []string{
+	"session_id", "title", "notes", "date", "session_state", "subjects",
+	"created_at", "updated_at", "total_seconds", "focused_seconds",
+	"paused_seconds", "pause_count", "event_type", "event_time",
+}
+
+// sessionExporter writes the exported sessions one at a time, Close must be
+// called once all the sessions are written
+type sessionExporter interface {
+	Write(session models.StudySessionDetails) error
+	Close() error
+}
+
+func newSessionExporter(format models.ExportFormat, w io.Writer) sessionExporter {
+	if format == models.ExportFormatJSON {
+		return &jsonSessionExporter{writer: bufio.NewWriter(w)}
+	}
+	return &csvSessionExporter{writer: csv.NewWriter(w)}
+}
+
+// csvSessionExporter writes one row per event, the session columns are
+// repeated on every row. Sessions without events are written as a single row
+// with empty event columns.
+type csvSessionExporter struct {
+	writer        *csv.Writer
+	headerWritten bool
+}
+
+func (e *csvSessionExporter) Write(session models.StudySessionDetails) error {
+	if err := e.writeHeader(); err != nil {
+		return err
+	}
+	subjects := make([]string, len(session.Subjects))
+	for i, subject := range session.Subjects {
+		subjects[i] = subject.SubjectID.String()
+		if subject.Percentage != nil {
+			subjects[i] += ":" + strconv.Itoa(*subject.Percentage)
+		}
+	}
+	columns := []string{
+		session.ID.String(),
+		escapeCSVFormula(session.Title),
+		escapeCSVFormula(session.Notes),
+		session.Date.Format(exportDateLayout),
+		string(session.SessionState),
+		strings.Join(subjects, ";"),
+		session.CreatedAt.UTC().Format(time.RFC3339),
+		session.UpdatedAt.UTC().Format(time.RFC3339),
+		strconv.FormatInt(session.Durations.TotalSeconds, 10),
+		strconv.FormatInt(session.Durations.FocusedSeconds, 10),
+		strconv.FormatInt(session.Durations.PausedSeconds, 10),
+		strconv.Itoa(session.Durations.PauseCount),
+	}
+
+	if len(session.Events) == 0 {
+		return e.writer.Write(append(columns, "", ""))
+	}
+	for _, event := range session.Events {
+		row := append(columns[:len(columns):len(columns)], string(event.EventType), event.EventTime.UTC().Format(time.RFC3339))
+		if err := e.writer.Write(row); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (e *csvSessionExporter) Close() error {
+	if err := e.writeHeader(); err != nil {
+		return err
+	}
+	e.writer.Flush()
+	return e.writer.Error()
+}
+
+func (e *csvSessionExporter) writeHeader() error {
+	if e.headerWritten {
+		return nil
+	}
+	e.headerWritten = true
+	return e.writer.Write(exportCSVHeader)
+}
+
+// jsonSessionExporter writes the sessions as a single JSON array
+type jsonSessionExporter struct {
+	writer *bufio.Writer
+	count  int
+}
+
+func (e *jsonSessionExporter) Write(session models.StudySessionDetails) error {
+	encoded, err := json.Marshal(session)
+	if err != nil {
+		return fmt.Errorf("failed to encode study session: %w", err)
+	}
+	separator := ","
+	if e.count == 0 {
+		separator = "["
+	}
+	e.count++
+	if _, err := e.writer.WriteString(separator); err != nil {
+		return err
+	}
+	_, err = e.writer.Write(encoded)
+	return err
+}
+
+func (e *jsonSessionExporter) Close() error {
+	closing := "]"
+	if e.count == 0 {
+		closing = "[]"
+	}
+	if _, err := e.writer.WriteString(closing); err != nil {
+		return err
+	}
+	return e.writer.Flush()
+}
+
+// escapeCSVFormula prevents spreadsheets from evaluating user input as a
+// formula by prefixing it with a quote
+func escapeCSVFormula(value string) string {
+	if value != "" && strings.ContainsRune("=+-@\t\r", rune(value[0])) {
+		return "'" + value
+	}
+	return value
+}
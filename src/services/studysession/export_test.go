@@ -0,0 +1,88 @@
+package studysession
+
+import (
+	"bytes"
+	models "go-api/src/models/studysession"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSessionExporter(t *testing.T) {
+	sessionID := uuid.MustParse("6f1d7a2e-0000-4000-8000-000000000001")
+	subjectID := uuid.MustParse("6f1d7a2e-0000-4000-8000-000000000002")
+	startedAt := time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)
+	session := models.StudySessionDetails{
+		StudySession: models.StudySession{
+			ID:           sessionID,
+			Title:        "=HYPERLINK(\"x\")",
+			Notes:        "line one\nline two",
+			Date:         startedAt,
+			SessionState: models.SessionStateCompleted,
+			Durations:    models.SessionDurations{TotalSeconds: 600, FocusedSeconds: 600},
+			Subjects:     []models.SessionSubject{{SubjectID: subjectID, Percentage: percentage(100)}},
+			CreatedAt:    startedAt,
+			UpdatedAt:    startedAt,
+		},
+		Events: []models.SessionEvent{
+			{EventType: models.EventTypeStart, EventTime: startedAt},
+			{EventType: models.EventTypeStop, EventTime: startedAt.Add(10 * time.Minute)},
+		},
+	}
+	withoutEvents := session
+	withoutEvents.Title = "Reading"
+	withoutEvents.Notes = ""
+	withoutEvents.Subjects = []models.SessionSubject{}
+	withoutEvents.Events = []models.SessionEvent{}
+
+	tests := map[string]struct {
+		Format         models.ExportFormat
+		Sessions       []models.StudySessionDetails
+		ExpectedOutput string
+	}{
+		"csv without sessions": {
+			Format:         models.ExportFormatCSV,
+			ExpectedOutput: "session_id,title,notes,date,session_state,subjects,created_at,updated_at,total_seconds,focused_seconds,paused_seconds,pause_count,event_type,event_time\n",
+		},
+		"csv with one row per event": {
+			Format:   models.ExportFormatCSV,
+			Sessions: []models.StudySessionDetails{session, withoutEvents},
+			ExpectedOutput: "session_id,title,notes,date,session_state,subjects,created_at,updated_at,total_seconds,focused_seconds,paused_seconds,pause_count,event_type,event_time\n" +
+				`6f1d7a2e-0000-4000-8000-000000000001,"'=HYPERLINK(""x"")","line one` + "\n" + `line two",2025-01-01,completed,6f1d7a2e-0000-4000-8000-000000000002:100,2025-01-01T10:00:00Z,2025-01-01T10:00:00Z,600,600,0,0,start,2025-01-01T10:00:00Z` + "\n" +
+				`6f1d7a2e-0000-4000-8000-000000000001,"'=HYPERLINK(""x"")","line one` + "\n" + `line two",2025-01-01,completed,6f1d7a2e-0000-4000-8000-000000000002:100,2025-01-01T10:00:00Z,2025-01-01T10:00:00Z,600,600,0,0,stop,2025-01-01T10:10:00Z` + "\n" +
+				"6f1d7a2e-0000-4000-8000-000000000001,Reading,,2025-01-01,completed,,2025-01-01T10:00:00Z,2025-01-01T10:00:00Z,600,600,0,0,,\n",
+		},
+		"json without sessions": {
+			Format:         models.ExportFormatJSON,
+			ExpectedOutput: "[]",
+		},
+		"json array": {
+			Format:   models.ExportFormatJSON,
+			Sessions: []models.StudySessionDetails{withoutEvents, withoutEvents},
+			ExpectedOutput: `[{"id":"6f1d7a2e-0000-4000-8000-000000000001","user_id":"00000000-0000-0000-0000-000000000000","title":"Reading","notes":"","date":"2025-01-01T10:00:00Z","session_state":"completed","durations":{"total_seconds":600,"focused_seconds":600,"paused_seconds":0,"pause_count":0},"subjects":[],"created_at":"2025-01-01T10:00:00Z","updated_at":"2025-01-01T10:00:00Z","events":[]},` +
+				`{"id":"6f1d7a2e-0000-4000-8000-000000000001","user_id":"00000000-0000-0000-0000-000000000000","title":"Reading","notes":"","date":"2025-01-01T10:00:00Z","session_state":"completed","durations":{"total_seconds":600,"focused_seconds":600,"paused_seconds":0,"pause_count":0},"subjects":[],"created_at":"2025-01-01T10:00:00Z","updated_at":"2025-01-01T10:00:00Z","events":[]}]`,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			var output bytes.Buffer
+			exporter := newSessionExporter(tc.Format, &output)
+			for _, session := range tc.Sessions {
+				assert.NoError(t, exporter.Write(session))
+			}
+			assert.NoError(t, exporter.Close())
+			assert.Equal(t, tc.ExpectedOutput, output.String())
+		})
+	}
+}
+
+func TestEscapeCSVFormula(t *testing.T) {
+	assert.Equal(t, "", escapeCSVFormula(""))
+	assert.Equal(t, "Algebra", escapeCSVFormula("Algebra"))
+	assert.Equal(t, "'=1+1", escapeCSVFormula("=1+1"))
+	assert.Equal(t, "'-5 minutes", escapeCSVFormula("-5 minutes"))
+	assert.Equal(t, "'@SUM(A1)", escapeCSVFormula("@SUM(A1)"))
+}
@@ -0,0 +1,131 @@
+package studysession
+
+import (
+	"encoding/base64"
+	"fmt"
+	models "go-api/src/models/studysession"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	defaultHistoryLimit = 20
+	maxHistoryLimit     = 100
+	historyDateLayout   = "2006-01-02"
+)
+
+// historyStates are the session states that can be listed in the history,
+// the active session is served by its own endpoints
+var historyStates = []models.SessionState{
+	models.SessionStateCompleted,
+	models.SessionStateAbandoned,
+}
+
+// defaultHistoryStates are listed when no state is requested, abandoned
+// sessions were discarded by the user so they are only listed on demand
+var defaultHistoryStates = []models.SessionState{
+	models.SessionStateCompleted,
+}
+
+// parseDateRange parses the optional from and to dates of a filter
+func parseDateRange(rawFrom string, rawTo string) (*time.Time, *time.Time, error) {
+	var from, to *time.Time
+	if rawFrom != "" {
+		parsed, err := time.Parse(historyDateLayout, rawFrom)
+		if err != nil {
+			return nil, nil, models.ErrInvalidHistoryFilter
+		}
+		from = &parsed
+	}
+	if rawTo != "" {
+		parsed, err := time.Parse(historyDateLayout, rawTo)
+		if err != nil {
+			return nil, nil, models.ErrInvalidHistoryFilter
+		}
+		to = &parsed
+	}
+	if from != nil && to != nil && from.After(*to) {
+		return nil, nil, models.ErrInvalidHistoryFilter
+	}
+	return from, to, nil
+}
+
+func buildHistoryFilter(request GetStudySessionHistoryRequest) (models.HistoryFilter, error) {
+	filter := models.HistoryFilter{
+		Title:      strings.TrimSpace(request.Title),
+		AutoClosed: request.AutoClosed,
+		Limit:      request.Limit,
+	}
+
+	var err error
+	filter.From, filter.To, err = parseDateRange(request.From, request.To)
+	if err != nil {
+		return filter, err
+	}
+
+	filter.States = defaultHistoryStates
+	if len(request.States) > 0 {
+		filter.States = make([]models.SessionState, 0, len(request.States))
+		for _, state := range request.States {
+			if !isHistoryState(models.SessionState(state)) {
+				return filter, models.ErrInvalidHistoryFilter
+			}
+			filter.States = append(filter.States, models.SessionState(state))
+		}
+	}
+
+	switch {
+	case filter.Limit < 0:
+		return filter, models.ErrInvalidHistoryFilter
+	case filter.Limit == 0:
+		filter.Limit = defaultHistoryLimit
+	case filter.Limit > maxHistoryLimit:
+		filter.Limit = maxHistoryLimit
+	}
+
+	if request.Cursor != "" {
+		cursor, err := decodeHistoryCursor(request.Cursor)
+		if err != nil {
+			return filter, models.ErrInvalidHistoryCursor
+		}
+		filter.Cursor = cursor
+	}
+	return filter, nil
+}
+
+func isHistoryState(state models.SessionState) bool {
+	for _, historyState := range historyStates {
+		if state == historyState {
+			return true
+		}
+	}
+	return false
+}
+
+// encodeHistoryCursor returns an opaque token pointing right after the given session
+func encodeHistoryCursor(session models.StudySession) string {
+	raw := session.Date.UTC().Format(historyDateLayout) + "|" + session.ID.String()
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+func decodeHistoryCursor(token string) (*models.HistoryCursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode cursor: %w", err)
+	}
+	date, id, found := strings.Cut(string(raw), "|")
+	if !found {
+		return nil, fmt.Errorf("malformed cursor")
+	}
+	cursorDate, err := time.Parse(historyDateLayout, date)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse cursor date: %w", err)
+	}
+	cursorID, err := uuid.Parse(id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse cursor id: %w", err)
+	}
+	return &models.HistoryCursor{Date: cursorDate, ID: cursorID}, nil
+}
@@ -0,0 +1,111 @@
+package studysession
+
+import (
+	models "go-api/src/models/studysession"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuildHistoryFilter(t *testing.T) {
+	from := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2025, 1, 31, 0, 0, 0, 0, time.UTC)
+
+	tests := map[string]struct {
+		Request        GetStudySessionHistoryRequest
+		ExpectedFilter models.HistoryFilter
+		ExpectedError  error
+	}{
+		"defaults": {
+			Request: GetStudySessionHistoryRequest{},
+			ExpectedFilter: models.HistoryFilter{
+				States: defaultHistoryStates,
+				Limit:  defaultHistoryLimit,
+			},
+		},
+		"date range and title": {
+			Request: GetStudySessionHistoryRequest{
+				From:  "2025-01-01",
+				To:    "2025-01-31",
+				Title: "  calculus ",
+				Limit: 10,
+			},
+			ExpectedFilter: models.HistoryFilter{
+				From:   &from,
+				To:     &to,
+				Title:  "calculus",
+				States: defaultHistoryStates,
+				Limit:  10,
+			},
+		},
+		"limit is capped": {
+			Request: GetStudySessionHistoryRequest{Limit: 1000},
+			ExpectedFilter: models.HistoryFilter{
+				States: defaultHistoryStates,
+				Limit:  maxHistoryLimit,
+			},
+		},
+		"abandoned sessions": {
+			Request: GetStudySessionHistoryRequest{States: []string{string(models.SessionStateAbandoned)}},
+			ExpectedFilter: models.HistoryFilter{
+				States: []models.SessionState{models.SessionStateAbandoned},
+				Limit:  defaultHistoryLimit,
+			},
+		},
+		"auto closed sessions": {
+			Request: GetStudySessionHistoryRequest{AutoClosed: true},
+			ExpectedFilter: models.HistoryFilter{
+				States:     defaultHistoryStates,
+				AutoClosed: true,
+				Limit:      defaultHistoryLimit,
+			},
+		},
+		"fail - invalid date": {
+			Request:       GetStudySessionHistoryRequest{From: "01/01/2025"},
+			ExpectedError: models.ErrInvalidHistoryFilter,
+		},
+		"fail - from after to": {
+			Request:       GetStudySessionHistoryRequest{From: "2025-02-01", To: "2025-01-01"},
+			ExpectedError: models.ErrInvalidHistoryFilter,
+		},
+		"fail - active state": {
+			Request:       GetStudySessionHistoryRequest{States: []string{string(models.SessionStateActive)}},
+			ExpectedError: models.ErrInvalidHistoryFilter,
+		},
+		"fail - negative limit": {
+			Request:       GetStudySessionHistoryRequest{Limit: -1},
+			ExpectedError: models.ErrInvalidHistoryFilter,
+		},
+		"fail - invalid cursor": {
+			Request:       GetStudySessionHistoryRequest{Cursor: "not a cursor"},
+			ExpectedError: models.ErrInvalidHistoryCursor,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			filter, err := buildHistoryFilter(tc.Request)
+			if tc.ExpectedError != nil {
+				assert.ErrorIs(t, err, tc.ExpectedError)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.ExpectedFilter, filter)
+		})
+	}
+}
+
+func TestHistoryCursorRoundTrip(t *testing.T) {
+	session := models.StudySession{
+		ID:   uuid.New(),
+		Date: time.Date(2025, 3, 14, 0, 0, 0, 0, time.UTC),
+	}
+
+	cursor, err := decodeHistoryCursor(encodeHistoryCursor(session))
+
+	assert.NoError(t, err)
+	assert.Equal(t, session.ID, cursor.ID)
+	assert.True(t, session.Date.Equal(cursor.Date))
+}
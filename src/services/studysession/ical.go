@@ -0,0 +1,177 @@
+package studysession
+
+import (
+	"bytes"
+	"fmt"
+	models "go-api/src/models/studysession"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+)
+
+const (
+	icalTimeLayout = "20060102T150405Z"
+	// icalLineLimit is the maximum line length in octets, without the CRLF
+	icalLineLimit = 75
+	icalProductID = "-//go-api//Study Sessions//EN"
+	icalUIDDomain = "study-sessions.go-api"
+)
+
+// calendarEvent is a completed session as it appears in the calendar
+type calendarEvent struct {
+	Session    models.StudySession
+	StartedAt  time.Time
+	StoppedAt  time.Time
+	Pauses     []models.TimeInterval
+	Categories []string
+}
+
+// newCalendarEvents keeps the sessions that have both a start and a stop
+// event, ordered by start time
+func newCalendarEvents(sessions []models.StudySession, eventsBySession map[uuid.UUID][]models.SessionEvent, subjectNames map[uuid.UUID]string) []calendarEvent {
+	calendarEvents := make([]calendarEvent, 0, len(sessions))
+	for _, session := range sessions {
+		events := eventsBySession[session.ID]
+		var startedAt, stoppedAt time.Time
+		for _, event := range events {
+			switch event.EventType {
+			case models.EventTypeStart:
+				if startedAt.IsZero() || event.EventTime.Before(startedAt) {
+					startedAt = event.EventTime
+				}
+			case models.EventTypeStop:
+				stoppedAt = event.EventTime
+			}
+		}
+		if startedAt.IsZero() || stoppedAt.IsZero() {
+			continue
+		}
+
+		calendarEvent := calendarEvent{
+			Session:   session,
+			StartedAt: startedAt,
+			StoppedAt: stoppedAt,
+			Pauses:    pauseIntervals(models.FocusedIntervals(events, stoppedAt), stoppedAt),
+		}
+		for _, subject := range session.Subjects {
+			if name, ok := subjectNames[subject.SubjectID]; ok {
+				calendarEvent.Categories = append(calendarEvent.Categories, name)
+			}
+		}
+		calendarEvents = append(calendarEvents, calendarEvent)
+	}
+	sort.SliceStable(calendarEvents, func(i, j int) bool {
+		return calendarEvents[i].StartedAt.Before(calendarEvents[j].StartedAt)
+	})
+	return calendarEvents
+}
+
+// pauseIntervals are the gaps between the focused intervals, a session
+// stopped while paused ends with a pause
+func pauseIntervals(focused []models.TimeInterval, stoppedAt time.Time) []models.TimeInterval {
+	var pauses []models.TimeInterval
+	for i, interval := range focused {
+		end := stoppedAt
+		if i+1 < len(focused) {
+			end = focused[i+1].Start
+		}
+		if end.After(interval.End) {
+			pauses = append(pauses, models.TimeInterval{Start: interval.End, End: end})
+		}
+	}
+	return pauses
+}
+
+// writeICalendar renders the events as an RFC 5545 calendar
+func writeICalendar(buffer *bytes.Buffer, calendarEvents []calendarEvent) {
+	writeICalLine(buffer, "BEGIN:VCALENDAR")
+	writeICalLine(buffer, "VERSION:2.0")
+	writeICalLine(buffer, "PRODID:"+icalProductID)
+	writeICalLine(buffer, "CALSCALE:GREGORIAN")
+	writeICalLine(buffer, "X-WR-CALNAME:Study sessions")
+	for _, event := range calendarEvents {
+		summary := event.Session.Title
+		if summary == "" {
+			summary = "Study session"
+		}
+		writeICalLine(buffer, "BEGIN:VEVENT")
+		writeICalLine(buffer, fmt.Sprintf("UID:%s@%s", event.Session.ID, icalUIDDomain))
+		writeICalLine(buffer, "DTSTAMP:"+event.Session.UpdatedAt.UTC().Format(icalTimeLayout))
+		writeICalLine(buffer, "DTSTART:"+event.StartedAt.UTC().Format(icalTimeLayout))
+		writeICalLine(buffer, "DTEND:"+event.StoppedAt.UTC().Format(icalTimeLayout))
+		writeICalLine(buffer, "SUMMARY:"+escapeICalText(summary))
+		writeICalLine(buffer, "DESCRIPTION:"+escapeICalText(calendarDescription(event)))
+		if len(event.Categories) > 0 {
+			categories := make([]string, len(event.Categories))
+			for i, category := range event.Categories {
+				categories[i] = escapeICalText(category)
+			}
+			writeICalLine(buffer, "CATEGORIES:"+strings.Join(categories, ","))
+		}
+		writeICalLine(buffer, "END:VEVENT")
+	}
+	writeICalLine(buffer, "END:VCALENDAR")
+}
+
+func calendarDescription(event calendarEvent) string {
+	var description strings.Builder
+	if event.Session.Notes != "" {
+		description.WriteString(event.Session.Notes)
+		description.WriteString("\n\n")
+	}
+	fmt.Fprintf(&description, "Focused: %s", formatMinutes(event.Session.Durations.FocusedSeconds))
+	if len(event.Pauses) == 0 {
+		return description.String()
+	}
+	fmt.Fprintf(&description, "\nPauses (%d):", len(event.Pauses))
+	for _, pause := range event.Pauses {
+		fmt.Fprintf(&description, "\n- %s to %s UTC (%s)",
+			pause.Start.UTC().Format("15:04"),
+			pause.End.UTC().Format("15:04"),
+			formatMinutes(int64(pause.End.Sub(pause.Start).Seconds())),
+		)
+	}
+	return description.String()
+}
+
+func formatMinutes(seconds int64) string {
+	minutes := seconds / 60
+	if minutes < 60 {
+		return fmt.Sprintf("%dm", minutes)
+	}
+	return fmt.Sprintf("%dh %dm", minutes/60, minutes%60)
+}
+
+// escapeICalText escapes a TEXT value as defined in RFC 5545 section 3.3.11
+func escapeICalText(text string) string {
+	return strings.NewReplacer(
+		`\`, `\\`,
+		";", `\;`,
+		",", `\,`,
+		"\r\n", `\n`,
+		"\n", `\n`,
+		"\r", `\n`,
+	).Replace(text)
+}
+
+// writeICalLine folds the content line every 75 octets without splitting
+// multi-byte characters, continuation lines start with a space
+func writeICalLine(buffer *bytes.Buffer, line string) {
+	limit := icalLineLimit
+	for len(line) > limit {
+		cut := limit
+		for cut > 0 && !utf8.RuneStart(line[cut]) {
+			cut--
+		}
+		buffer.WriteString(line[:cut])
+		buffer.WriteString("\r\n ")
+		line = line[cut:]
+		// The leading space counts towards the limit of the next line
+		limit = icalLineLimit - 1
+	}
+	buffer.WriteString(line)
+	buffer.WriteString("\r\n")
+}
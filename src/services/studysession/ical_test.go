@@ -0,0 +1,84 @@
+package studysession
+
+import (
+	"bytes"
+	models "go-api/src/models/studysession"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWriteICalendar(t *testing.T) {
+	start := time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)
+	at := func(minutes int) time.Time {
+		return start.Add(time.Duration(minutes) * time.Minute)
+	}
+	sessionID := uuid.MustParse("0b6c1d5e-3f0a-4a5e-9b8f-1c2d3e4f5a6b")
+	subjectID := uuid.New()
+	session := models.StudySession{
+		ID:        sessionID,
+		Title:     "Calculus; limits, series",
+		Notes:     "Chapter 3\nExercises",
+		Durations: models.SessionDurations{FocusedSeconds: 80 * 60},
+		Subjects:  []models.SessionSubject{{SubjectID: subjectID}, {SubjectID: uuid.New()}},
+		UpdatedAt: at(100),
+	}
+	events := map[uuid.UUID][]models.SessionEvent{
+		sessionID: {
+			{EventType: models.EventTypeStart, EventTime: at(0)},
+			{EventType: models.EventTypePause, EventTime: at(30)},
+			{EventType: models.EventTypeResume, EventTime: at(40)},
+			{EventType: models.EventTypeStop, EventTime: at(90)},
+		},
+	}
+	// Sessions without a stop event can't be placed in the calendar
+	unfinished := models.StudySession{ID: uuid.New()}
+
+	var buffer bytes.Buffer
+	writeICalendar(&buffer, newCalendarEvents(
+		[]models.StudySession{session, unfinished},
+		events,
+		map[uuid.UUID]string{subjectID: "Math, advanced"},
+	))
+
+	assert.Equal(t, strings.Join([]string{
+		"BEGIN:VCALENDAR",
+		"VERSION:2.0",
+		"PRODID:-//go-api//Study Sessions//EN",
+		"CALSCALE:GREGORIAN",
+		"X-WR-CALNAME:Study sessions",
+		"BEGIN:VEVENT",
+		"UID:0b6c1d5e-3f0a-4a5e-9b8f-1c2d3e4f5a6b@study-sessions.go-api",
+		"DTSTAMP:20250101T114000Z",
+		"DTSTART:20250101T100000Z",
+		"DTEND:20250101T113000Z",
+		`SUMMARY:Calculus\; limits\, series`,
+		`DESCRIPTION:Chapter 3\nExercises\n\nFocused: 1h 20m\nPauses (1):\n- 10:30 t`,
+		` o 10:40 UTC (10m)`,
+		`CATEGORIES:Math\, advanced`,
+		"END:VEVENT",
+		"END:VCALENDAR",
+		"",
+	}, "\r\n"), buffer.String())
+}
+
+func TestWriteICalLineFolding(t *testing.T) {
+	var buffer bytes.Buffer
+	line := "SUMMARY:" + strings.Repeat("é", 60)
+
+	writeICalLine(&buffer, line)
+
+	folded := strings.Split(strings.TrimSuffix(buffer.String(), "\r\n"), "\r\n")
+	assert.Greater(t, len(folded), 1)
+	for i, part := range folded {
+		assert.LessOrEqual(t, len(part), icalLineLimit)
+		if i > 0 {
+			assert.True(t, strings.HasPrefix(part, " "))
+		}
+	}
+	unfolded := strings.ReplaceAll(buffer.String(), "\r\n ", "")
+	assert.Equal(t, line+"\r\n", unfolded)
+}
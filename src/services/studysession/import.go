@@ -0,0 +1,376 @@
+package studysession
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/csv"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	models "go-api/src/models/studysession"
+	"io"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+)
+
+const (
+	maxImportFileBytes = 5 << 20
+	maxImportRows      = 2000
+)
+
+// importColumns names the CSV columns of a mapping, either Start and End or
+// the four date and time columns must be present. Header names are matched
+// case insensitively.
+type importColumns struct {
+	Start     string
+	End       string
+	StartDate string
+	StartTime string
+	EndDate   string
+	EndTime   string
+	Title     string
+	Notes     string
+	Subject   string
+	// Layouts are tried in order, split date and time columns are joined
+	// with a space before parsing. RFC 3339 is always accepted.
+	Layouts []string
+}
+
+var importColumnsByMapping = map[models.ImportMapping]importColumns{
+	models.ImportMappingGeneric: {
+		Start:   "start",
+		End:     "end",
+		Title:   "title",
+		Notes:   "notes",
+		Subject: "subject",
+		Layouts: []string{"2006-01-02 15:04:05", "2006-01-02 15:04", "2006-01-02T15:04:05", "2006-01-02T15:04"},
+	},
+	models.ImportMappingToggl: {
+		StartDate: "start date",
+		StartTime: "start time",
+		EndDate:   "end date",
+		EndTime:   "end time",
+		Title:     "description",
+		Subject:   "project",
+		Layouts:   []string{"2006-01-02 15:04:05", "2006-01-02 15:04"},
+	},
+	models.ImportMappingClockify: {
+		StartDate: "start date",
+		StartTime: "start time",
+		EndDate:   "end date",
+		EndTime:   "end time",
+		Title:     "description",
+		Subject:   "project",
+		Layouts: []string{
+			"1/2/2006 15:04:05", "1/2/2006 15:04", "1/2/2006 3:04:05 PM", "1/2/2006 3:04 PM",
+			"2006-01-02 15:04:05", "2006-01-02 15:04",
+		},
+	},
+}
+
+// importRow is a row read from the import file, the import key of the
+// session is only set when the row has no errors
+type importRow struct {
+	Line      int
+	Session   models.ImportedSession
+	Errors    []string
+	Duplicate bool
+}
+
+func (r importRow) isValid() bool {
+	return len(r.Errors) == 0 && !r.Duplicate
+}
+
+func loadImportOptions(request ImportStudySessionsRequest) (importColumns, *time.Location, error) {
+	mapping := models.ImportMapping(request.Mapping)
+	if mapping == "" {
+		mapping = models.ImportMappingGeneric
+	}
+	columns, ok := importColumnsByMapping[mapping]
+	if !ok {
+		return importColumns{}, nil, models.ErrInvalidImportOptions
+	}
+	if request.Timezone == "" {
+		return columns, time.UTC, nil
+	}
+	location, err := time.LoadLocation(request.Timezone)
+	if err != nil || request.Timezone == "Local" {
+		return importColumns{}, nil, models.ErrInvalidImportOptions
+	}
+	return columns, location, nil
+}
+
+// readImportRows parses the CSV file. File level problems (size, encoding,
+// missing columns) fail the whole import while problems with the content of
+// a row are reported on the row.
+func readImportRows(file io.Reader, columns importColumns, location *time.Location, subjectIDs map[string]uuid.UUID, now time.Time) ([]importRow, error) {
+	content, err := io.ReadAll(io.LimitReader(file, maxImportFileBytes+1))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read import file: %w", err)
+	}
+	if len(content) > maxImportFileBytes {
+		return nil, models.ErrImportTooLarge
+	}
+	content = bytes.TrimPrefix(content, []byte("\ufeff"))
+	if !utf8.Valid(content) {
+		return nil, models.ErrInvalidImportFile
+	}
+
+	reader := csv.NewReader(bytes.NewReader(content))
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+	header, err := reader.Read()
+	if err != nil {
+		return nil, models.ErrInvalidImportFile
+	}
+	indexes := make(map[string]int, len(header))
+	for i, name := range header {
+		indexes[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+	splitDateTime := columns.Start == ""
+	required := []string{columns.Start, columns.End}
+	if splitDateTime {
+		required = []string{columns.StartDate, columns.StartTime, columns.EndDate, columns.EndTime}
+	}
+	for _, name := range required {
+		if _, ok := indexes[name]; !ok {
+			return nil, models.ErrInvalidImportFile
+		}
+	}
+
+	var rows []importRow
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, models.ErrInvalidImportFile
+		}
+		if len(rows) == maxImportRows {
+			return nil, models.ErrImportTooLarge
+		}
+		line, _ := reader.FieldPos(0)
+		field := func(name string) string {
+			if index, ok := indexes[name]; ok && name != "" && index < len(record) {
+				return strings.TrimSpace(record[index])
+			}
+			return ""
+		}
+
+		row := importRow{Line: line}
+		var rawStart, rawEnd string
+		if splitDateTime {
+			rawStart = strings.TrimSpace(field(columns.StartDate) + " " + field(columns.StartTime))
+			rawEnd = strings.TrimSpace(field(columns.EndDate) + " " + field(columns.EndTime))
+		} else {
+			rawStart, rawEnd = field(columns.Start), field(columns.End)
+		}
+		startedAt, startErr := parseImportTime(rawStart, columns.Layouts, location)
+		if startErr != "" {
+			row.Errors = append(row.Errors, "start "+startErr)
+		}
+		stoppedAt, endErr := parseImportTime(rawEnd, columns.Layouts, location)
+		if endErr != "" {
+			row.Errors = append(row.Errors, "end "+endErr)
+		}
+		if startErr == "" && endErr == "" {
+			switch {
+			case !stoppedAt.After(startedAt):
+				row.Errors = append(row.Errors, "end must be after start")
+			case stoppedAt.Sub(startedAt) > maxPastSessionDuration:
+				row.Errors = append(row.Errors, "session is longer than 24 hours")
+			case stoppedAt.After(now):
+				row.Errors = append(row.Errors, "session ends in the future")
+			}
+		}
+
+		title := field(columns.Title)
+		if utf8.RuneCountInString(title) > maxTitleLength {
+			row.Errors = append(row.Errors, fmt.Sprintf("title is longer than %d characters", maxTitleLength))
+		}
+		var subjects []models.SessionSubject
+		if name := field(columns.Subject); name != "" {
+			if subjectID, ok := subjectIDs[strings.ToLower(name)]; ok {
+				subjects = []models.SessionSubject{{SubjectID: subjectID}}
+			} else {
+				row.Errors = append(row.Errors, fmt.Sprintf("unknown subject %q", name))
+			}
+		}
+
+		row.Session = models.ImportedSession{
+			Title:     title,
+			Notes:     field(columns.Notes),
+			StartedAt: startedAt,
+			StoppedAt: stoppedAt,
+			Subjects:  subjects,
+		}
+		if len(row.Errors) == 0 {
+			row.Session.ImportKey = newImportKey(row.Session)
+		}
+		rows = append(rows, row)
+	}
+	return rows, nil
+}
+
+// parseImportTime returns the reason the value can't be parsed, if any
+func parseImportTime(value string, layouts []string, location *time.Location) (time.Time, string) {
+	if value == "" {
+		return time.Time{}, "time is missing"
+	}
+	if parsed, err := time.Parse(time.RFC3339, value); err == nil {
+		return parsed.UTC(), ""
+	}
+	for _, layout := range layouts {
+		if parsed, err := time.ParseInLocation(layout, value, location); err == nil {
+			return parsed.UTC(), ""
+		}
+	}
+	return time.Time{}, fmt.Sprintf("time %q is not a valid date and time", value)
+}
+
+// newImportKey hashes the content of an imported session, uploading the same
+// row again produces the same key
+func newImportKey(session models.ImportedSession) string {
+	subjectID := ""
+	if len(session.Subjects) > 0 {
+		subjectID = session.Subjects[0].SubjectID.String()
+	}
+	hash := sha256.Sum256([]byte(strings.Join([]string{
+		session.StartedAt.UTC().Format(time.RFC3339Nano),
+		session.StoppedAt.UTC().Format(time.RFC3339Nano),
+		session.Title,
+		session.Notes,
+		subjectID,
+	}, "\x1f")))
+	return hex.EncodeToString(hash[:])
+}
+
+// markDuplicateRows flags the rows that were imported before or that repeat
+// an earlier row of the same file
+func markDuplicateRows(rows []importRow, existingKeys []string) {
+	seen := make(map[string]bool, len(existingKeys)+len(rows))
+	for _, key := range existingKeys {
+		seen[key] = true
+	}
+	for i := range rows {
+		if len(rows[i].Errors) > 0 {
+			continue
+		}
+		rows[i].Duplicate = seen[rows[i].Session.ImportKey]
+		seen[rows[i].Session.ImportKey] = true
+	}
+}
+
+// markOverlappingRows rejects the rows overlapping an existing session or
+// another row of the file. Active sessions are considered running until now.
+func markOverlappingRows(rows []importRow, intervals []models.SessionInterval, now time.Time) {
+	var candidates []*importRow
+	for i := range rows {
+		if rows[i].isValid() {
+			candidates = append(candidates, &rows[i])
+		}
+	}
+	for _, row := range candidates {
+		for _, interval := range intervals {
+			stoppedAt := now
+			if interval.StoppedAt != nil {
+				stoppedAt = *interval.StoppedAt
+			}
+			if interval.StartedAt.Before(row.Session.StoppedAt) && stoppedAt.After(row.Session.StartedAt) {
+				row.Errors = append(row.Errors, fmt.Sprintf("overlaps existing session %s", interval.SessionID))
+			}
+		}
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].Session.StartedAt.Before(candidates[j].Session.StartedAt)
+	})
+	var latest *importRow
+	for _, row := range candidates {
+		if latest != nil && latest.Session.StoppedAt.After(row.Session.StartedAt) {
+			row.Errors = append(row.Errors, fmt.Sprintf("overlaps row %d", latest.Line))
+		}
+		if latest == nil || row.Session.StoppedAt.After(latest.Session.StoppedAt) {
+			latest = row
+		}
+	}
+}
+
+// importedRange is the period covered by the valid rows
+func importedRange(rows []importRow) (time.Time, time.Time, bool) {
+	var from, to time.Time
+	found := false
+	for _, row := range rows {
+		if !row.isValid() {
+			continue
+		}
+		if !found || row.Session.StartedAt.Before(from) {
+			from = row.Session.StartedAt
+		}
+		if !found || row.Session.StoppedAt.After(to) {
+			to = row.Session.StoppedAt
+		}
+		found = true
+	}
+	return from, to, found
+}
+
+func newImportReport(rows []importRow, dryRun bool) *models.ImportReport {
+	report := &models.ImportReport{
+		DryRun: dryRun,
+		Rows:   make([]models.ImportRowResult, len(rows)),
+	}
+	for i, row := range rows {
+		result := models.ImportRowResult{
+			Row:    row.Line,
+			Title:  row.Session.Title,
+			Errors: row.Errors,
+		}
+		if !row.Session.StartedAt.IsZero() {
+			result.StartedAt = &rows[i].Session.StartedAt
+		}
+		if !row.Session.StoppedAt.IsZero() {
+			result.StoppedAt = &rows[i].Session.StoppedAt
+		}
+		switch {
+		case len(row.Errors) > 0:
+			result.Status = models.ImportRowStatusInvalid
+			report.Invalid++
+		case row.Duplicate:
+			result.Status = models.ImportRowStatusDuplicate
+			report.Duplicates++
+		default:
+			result.Status = models.ImportRowStatusValid
+			report.Valid++
+		}
+		report.Rows[i] = result
+	}
+	return report
+}
+
+// markImportedRows updates the report once the valid rows are imported, rows
+// imported by a concurrent upload in the meantime become duplicates
+func markImportedRows(report *models.ImportReport, rows []importRow, importedKeys []string) {
+	imported := make(map[string]bool, len(importedKeys))
+	for _, key := range importedKeys {
+		imported[key] = true
+	}
+	for i, row := range rows {
+		if report.Rows[i].Status != models.ImportRowStatusValid {
+			continue
+		}
+		if imported[row.Session.ImportKey] {
+			report.Rows[i].Status = models.ImportRowStatusImported
+			report.Imported++
+		} else {
+			report.Rows[i].Status = models.ImportRowStatusDuplicate
+			report.Valid--
+			report.Duplicates++
+		}
+	}
+}
@@ -0,0 +1,239 @@
+package studysession
+
+import (
+	models "go-api/src/models/studysession"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReadImportRows(t *testing.T) {
+	subjectID := uuid.New()
+	subjectIDs := map[string]uuid.UUID{"calculus": subjectID}
+	now := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+	paris, err := time.LoadLocation("Europe/Paris")
+	assert.NoError(t, err)
+
+	type expectedRow struct {
+		Line      int
+		StartedAt time.Time
+		StoppedAt time.Time
+		Title     string
+		Subjects  []models.SessionSubject
+		Errors    []string
+	}
+	tests := map[string]struct {
+		Mapping       models.ImportMapping
+		Location      *time.Location
+		File          string
+		ExpectedRows  []expectedRow
+		ExpectedError error
+	}{
+		"generic with offsets and local times": {
+			Mapping:  models.ImportMappingGeneric,
+			Location: paris,
+			File: "Start,End,Title,Notes,Subject\n" +
+				"2025-01-10T09:00:00Z,2025-01-10T10:30:00Z,Limits,,Calculus\n" +
+				"2025-01-11 09:00,2025-01-11 10:00,Series,,\n",
+			ExpectedRows: []expectedRow{
+				{
+					Line:      2,
+					StartedAt: time.Date(2025, 1, 10, 9, 0, 0, 0, time.UTC),
+					StoppedAt: time.Date(2025, 1, 10, 10, 30, 0, 0, time.UTC),
+					Title:     "Limits",
+					Subjects:  []models.SessionSubject{{SubjectID: subjectID}},
+				},
+				{
+					Line:      3,
+					StartedAt: time.Date(2025, 1, 11, 8, 0, 0, 0, time.UTC),
+					StoppedAt: time.Date(2025, 1, 11, 9, 0, 0, 0, time.UTC),
+					Title:     "Series",
+				},
+			},
+		},
+		"toggl export": {
+			Mapping:  models.ImportMappingToggl,
+			Location: time.UTC,
+			File: "User,Email,Client,Project,Task,Description,Billable,Start date,Start time,End date,End time,Duration,Tags\n" +
+				"Ada,ada@example.com,,Calculus,,Integrals,No,2025-01-10,23:30:00,2025-01-11,00:15:00,00:45:00,\n",
+			ExpectedRows: []expectedRow{{
+				Line:      2,
+				StartedAt: time.Date(2025, 1, 10, 23, 30, 0, 0, time.UTC),
+				StoppedAt: time.Date(2025, 1, 11, 0, 15, 0, 0, time.UTC),
+				Title:     "Integrals",
+				Subjects:  []models.SessionSubject{{SubjectID: subjectID}},
+			}},
+		},
+		"clockify export with byte order mark": {
+			Mapping:  models.ImportMappingClockify,
+			Location: time.UTC,
+			File: "\ufeff\"Project\",\"Client\",\"Description\",\"Task\",\"Start Date\",\"Start Time\",\"End Date\",\"End Time\"\n" +
+				"\"\",\"\",\"Reading\",\"\",\"01/10/2025\",\"09:00:00 PM\",\"01/10/2025\",\"10:00:00 PM\"\n",
+			ExpectedRows: []expectedRow{{
+				Line:      2,
+				StartedAt: time.Date(2025, 1, 10, 21, 0, 0, 0, time.UTC),
+				StoppedAt: time.Date(2025, 1, 10, 22, 0, 0, 0, time.UTC),
+				Title:     "Reading",
+			}},
+		},
+		"row errors": {
+			Mapping:  models.ImportMappingGeneric,
+			Location: time.UTC,
+			File: "start,end,title,subject\n" +
+				",2025-01-10 10:00,,\n" +
+				"2025-01-10 10:00,2025-01-10 09:00,,\n" +
+				"2025-01-10 10:00,2025-01-11 11:00,,\n" +
+				"2025-06-01 10:00,2025-06-01 11:00,,\n" +
+				"2025-01-10 10:00,yesterday,,Physics\n",
+			ExpectedRows: []expectedRow{
+				{Line: 2, StoppedAt: time.Date(2025, 1, 10, 10, 0, 0, 0, time.UTC), Errors: []string{"start time is missing"}},
+				{
+					Line:      3,
+					StartedAt: time.Date(2025, 1, 10, 10, 0, 0, 0, time.UTC),
+					StoppedAt: time.Date(2025, 1, 10, 9, 0, 0, 0, time.UTC),
+					Errors:    []string{"end must be after start"},
+				},
+				{
+					Line:      4,
+					StartedAt: time.Date(2025, 1, 10, 10, 0, 0, 0, time.UTC),
+					StoppedAt: time.Date(2025, 1, 11, 11, 0, 0, 0, time.UTC),
+					Errors:    []string{"session is longer than 24 hours"},
+				},
+				{
+					Line:      5,
+					StartedAt: time.Date(2025, 6, 1, 10, 0, 0, 0, time.UTC),
+					StoppedAt: time.Date(2025, 6, 1, 11, 0, 0, 0, time.UTC),
+					Errors:    []string{"session ends in the future"},
+				},
+				{
+					Line:      6,
+					StartedAt: time.Date(2025, 1, 10, 10, 0, 0, 0, time.UTC),
+					Errors:    []string{`end time "yesterday" is not a valid date and time`, `unknown subject "Physics"`},
+				},
+			},
+		},
+		"missing columns": {
+			Mapping:       models.ImportMappingToggl,
+			Location:      time.UTC,
+			File:          "start,end\n2025-01-10 09:00,2025-01-10 10:00\n",
+			ExpectedError: models.ErrInvalidImportFile,
+		},
+		"malformed csv": {
+			Mapping:       models.ImportMappingGeneric,
+			Location:      time.UTC,
+			File:          "start,end\n\"2025-01-10 09:00,2025-01-10 10:00\n",
+			ExpectedError: models.ErrInvalidImportFile,
+		},
+		"too many rows": {
+			Mapping:       models.ImportMappingGeneric,
+			Location:      time.UTC,
+			File:          "start,end\n" + strings.Repeat("2025-01-10 09:00,2025-01-10 10:00\n", maxImportRows+1),
+			ExpectedError: models.ErrImportTooLarge,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			rows, err := readImportRows(strings.NewReader(tc.File), importColumnsByMapping[tc.Mapping], tc.Location, subjectIDs, now)
+
+			if tc.ExpectedError != nil {
+				assert.ErrorIs(t, err, tc.ExpectedError)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Len(t, rows, len(tc.ExpectedRows))
+			for i, expected := range tc.ExpectedRows {
+				row := rows[i]
+				assert.Equal(t, expected.Line, row.Line)
+				assert.Equal(t, expected.StartedAt, row.Session.StartedAt)
+				assert.Equal(t, expected.StoppedAt, row.Session.StoppedAt)
+				assert.Equal(t, expected.Title, row.Session.Title)
+				assert.Equal(t, expected.Subjects, row.Session.Subjects)
+				assert.Equal(t, expected.Errors, row.Errors)
+				assert.Equal(t, len(expected.Errors) == 0, row.Session.ImportKey != "")
+			}
+		})
+	}
+}
+
+func TestLoadImportOptions(t *testing.T) {
+	_, location, err := loadImportOptions(ImportStudySessionsRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, time.UTC, location)
+
+	_, location, err = loadImportOptions(ImportStudySessionsRequest{Mapping: "clockify", Timezone: "America/New_York"})
+	assert.NoError(t, err)
+	assert.Equal(t, "America/New_York", location.String())
+
+	_, _, err = loadImportOptions(ImportStudySessionsRequest{Mapping: "harvest"})
+	assert.Equal(t, models.ErrInvalidImportOptions, err)
+	_, _, err = loadImportOptions(ImportStudySessionsRequest{Timezone: "Local"})
+	assert.Equal(t, models.ErrInvalidImportOptions, err)
+}
+
+func TestImportRowChecks(t *testing.T) {
+	at := func(hour int) time.Time {
+		return time.Date(2025, 1, 10, hour, 0, 0, 0, time.UTC)
+	}
+	row := func(line int, start int, end int) importRow {
+		session := models.ImportedSession{StartedAt: at(start), StoppedAt: at(end)}
+		session.ImportKey = newImportKey(session)
+		return importRow{Line: line, Session: session}
+	}
+	existingID, activeID := uuid.New(), uuid.New()
+	stoppedAt := at(9)
+	intervals := []models.SessionInterval{
+		{SessionID: existingID, StartedAt: at(8), StoppedAt: &stoppedAt},
+		{SessionID: activeID, StartedAt: at(20)},
+	}
+	rows := []importRow{
+		row(2, 10, 12),
+		row(3, 11, 13),
+		row(4, 8, 9),
+		row(5, 10, 12),
+		row(6, 6, 7),
+		row(7, 21, 22),
+		row(8, 15, 16),
+		{Line: 9, Errors: []string{"start time is missing"}},
+	}
+
+	markDuplicateRows(rows, []string{rows[4].Session.ImportKey})
+	markOverlappingRows(rows, intervals, at(23))
+	report := newImportReport(rows, false)
+
+	statuses := make([]models.ImportRowStatus, len(report.Rows))
+	for i, result := range report.Rows {
+		statuses[i] = result.Status
+	}
+	assert.Equal(t, []models.ImportRowStatus{
+		models.ImportRowStatusValid,
+		models.ImportRowStatusInvalid,
+		models.ImportRowStatusInvalid,
+		models.ImportRowStatusDuplicate,
+		models.ImportRowStatusDuplicate,
+		models.ImportRowStatusInvalid,
+		models.ImportRowStatusValid,
+		models.ImportRowStatusInvalid,
+	}, statuses)
+	assert.Equal(t, []string{"overlaps row 2"}, rows[1].Errors)
+	assert.Equal(t, []string{"overlaps existing session " + existingID.String()}, rows[2].Errors)
+	assert.Equal(t, []string{"overlaps existing session " + activeID.String()}, rows[5].Errors)
+	assert.Equal(t, 2, report.Valid)
+	assert.Equal(t, 2, report.Duplicates)
+	assert.Equal(t, 4, report.Invalid)
+
+	from, to, ok := importedRange(rows)
+	assert.True(t, ok)
+	assert.Equal(t, at(10), from)
+	assert.Equal(t, at(16), to)
+
+	markImportedRows(report, rows, []string{rows[0].Session.ImportKey})
+	assert.Equal(t, models.ImportRowStatusImported, report.Rows[0].Status)
+	assert.Equal(t, models.ImportRowStatusDuplicate, report.Rows[6].Status)
+	assert.Equal(t, 1, report.Imported)
+	assert.Equal(t, 1, report.Valid)
+	assert.Equal(t, 3, report.Duplicates)
+}
@@ -0,0 +1,48 @@
+package studysession
+
+import (
+	models "go-api/src/models/studysession"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// maxPastSessionDuration rejects sessions logged after the fact that are
+// most likely a timer left running, e.g. in the previous tracker
+const maxPastSessionDuration = 24 * time.Hour
+
+// buildManualSessionEvents validates a session logged after the fact and
+// returns its timeline. A pause lasting until the end of the session has no
+// resume event, the session was stopped while paused.
+func buildManualSessionEvents(request CreateManualStudySessionRequest, now time.Time) ([]models.SessionEvent, error) {
+	startedAt, stoppedAt := request.StartedAt.UTC(), request.StoppedAt.UTC()
+	if startedAt.IsZero() || !stoppedAt.After(startedAt) || stoppedAt.After(now) ||
+		stoppedAt.Sub(startedAt) > maxPastSessionDuration {
+		return nil, models.ErrInvalidManualSession
+	}
+	if utf8.RuneCountInString(strings.TrimSpace(request.Title)) > maxTitleLength {
+		return nil, models.ErrInvalidManualSession
+	}
+
+	pauses := make([]PauseInterval, len(request.Pauses))
+	copy(pauses, request.Pauses)
+	sort.Slice(pauses, func(i, j int) bool {
+		return pauses[i].Start.Before(pauses[j].Start)
+	})
+
+	events := []models.SessionEvent{{EventType: models.EventTypeStart, EventTime: startedAt}}
+	resumedAt := startedAt
+	for _, pause := range pauses {
+		pauseStart, pauseEnd := pause.Start.UTC(), pause.End.UTC()
+		if !pauseStart.After(resumedAt) || !pauseEnd.After(pauseStart) || pauseEnd.After(stoppedAt) {
+			return nil, models.ErrInvalidManualSession
+		}
+		events = append(events, models.SessionEvent{EventType: models.EventTypePause, EventTime: pauseStart})
+		if pauseEnd.Before(stoppedAt) {
+			events = append(events, models.SessionEvent{EventType: models.EventTypeResume, EventTime: pauseEnd})
+		}
+		resumedAt = pauseEnd
+	}
+	return append(events, models.SessionEvent{EventType: models.EventTypeStop, EventTime: stoppedAt}), nil
+}
@@ -0,0 +1,127 @@
+package studysession
+
+import (
+	models "go-api/src/models/studysession"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuildManualSessionEvents(t *testing.T) {
+	now := time.Date(2025, 1, 10, 18, 0, 0, 0, time.UTC)
+	at := func(hour int, minute int) time.Time {
+		return time.Date(2025, 1, 10, hour, minute, 0, 0, time.UTC)
+	}
+	event := func(eventType models.EventType, hour int, minute int) models.SessionEvent {
+		return models.SessionEvent{EventType: eventType, EventTime: at(hour, minute)}
+	}
+
+	tests := map[string]struct {
+		Request        CreateManualStudySessionRequest
+		ExpectedEvents []models.SessionEvent
+		ExpectedError  error
+	}{
+		"without pauses": {
+			Request: CreateManualStudySessionRequest{StartedAt: at(9, 0), StoppedAt: at(10, 0)},
+			ExpectedEvents: []models.SessionEvent{
+				event(models.EventTypeStart, 9, 0),
+				event(models.EventTypeStop, 10, 0),
+			},
+		},
+		"unordered pauses in another timezone": {
+			Request: CreateManualStudySessionRequest{
+				StartedAt: at(9, 0).In(time.FixedZone("UTC+2", 2*60*60)),
+				StoppedAt: at(12, 0),
+				Pauses: []PauseInterval{
+					{Start: at(11, 0), End: at(11, 15)},
+					{Start: at(10, 0), End: at(10, 10)},
+				},
+			},
+			ExpectedEvents: []models.SessionEvent{
+				event(models.EventTypeStart, 9, 0),
+				event(models.EventTypePause, 10, 0),
+				event(models.EventTypeResume, 10, 10),
+				event(models.EventTypePause, 11, 0),
+				event(models.EventTypeResume, 11, 15),
+				event(models.EventTypeStop, 12, 0),
+			},
+		},
+		"stopped while paused": {
+			Request: CreateManualStudySessionRequest{
+				StartedAt: at(9, 0),
+				StoppedAt: at(10, 0),
+				Pauses:    []PauseInterval{{Start: at(9, 45), End: at(10, 0)}},
+			},
+			ExpectedEvents: []models.SessionEvent{
+				event(models.EventTypeStart, 9, 0),
+				event(models.EventTypePause, 9, 45),
+				event(models.EventTypeStop, 10, 0),
+			},
+		},
+		"fail - missing start": {
+			Request:       CreateManualStudySessionRequest{StoppedAt: at(10, 0)},
+			ExpectedError: models.ErrInvalidManualSession,
+		},
+		"fail - ends before it starts": {
+			Request:       CreateManualStudySessionRequest{StartedAt: at(10, 0), StoppedAt: at(9, 0)},
+			ExpectedError: models.ErrInvalidManualSession,
+		},
+		"fail - ends in the future": {
+			Request:       CreateManualStudySessionRequest{StartedAt: at(17, 0), StoppedAt: at(19, 0)},
+			ExpectedError: models.ErrInvalidManualSession,
+		},
+		"fail - longer than a day": {
+			Request:       CreateManualStudySessionRequest{StartedAt: at(9, 0).AddDate(0, 0, -2), StoppedAt: at(10, 0)},
+			ExpectedError: models.ErrInvalidManualSession,
+		},
+		"fail - title too long": {
+			Request:       CreateManualStudySessionRequest{StartedAt: at(9, 0), StoppedAt: at(10, 0), Title: strings.Repeat("a", 101)},
+			ExpectedError: models.ErrInvalidManualSession,
+		},
+		"fail - pause outside of the session": {
+			Request: CreateManualStudySessionRequest{
+				StartedAt: at(9, 0),
+				StoppedAt: at(10, 0),
+				Pauses:    []PauseInterval{{Start: at(9, 50), End: at(10, 5)}},
+			},
+			ExpectedError: models.ErrInvalidManualSession,
+		},
+		"fail - pause at the start": {
+			Request: CreateManualStudySessionRequest{
+				StartedAt: at(9, 0),
+				StoppedAt: at(10, 0),
+				Pauses:    []PauseInterval{{Start: at(9, 0), End: at(9, 5)}},
+			},
+			ExpectedError: models.ErrInvalidManualSession,
+		},
+		"fail - overlapping pauses": {
+			Request: CreateManualStudySessionRequest{
+				StartedAt: at(9, 0),
+				StoppedAt: at(10, 0),
+				Pauses: []PauseInterval{
+					{Start: at(9, 10), End: at(9, 30)},
+					{Start: at(9, 20), End: at(9, 40)},
+				},
+			},
+			ExpectedError: models.ErrInvalidManualSession,
+		},
+		"fail - empty pause": {
+			Request: CreateManualStudySessionRequest{
+				StartedAt: at(9, 0),
+				StoppedAt: at(10, 0),
+				Pauses:    []PauseInterval{{Start: at(9, 10), End: at(9, 10)}},
+			},
+			ExpectedError: models.ErrInvalidManualSession,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			events, err := buildManualSessionEvents(tc.Request, now)
+			assert.Equal(t, tc.ExpectedError, err)
+			assert.Equal(t, tc.ExpectedEvents, events)
+		})
+	}
+}
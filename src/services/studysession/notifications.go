@@ -0,0 +1,39 @@
+package studysession
+
+import (
+	"context"
+	notificationmodels "go-api/src/models/notifications"
+	models "go-api/src/models/studysession"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// publishNotification tells the clients of the user that the session changed.
+// The change is already committed, failing to publish it only gets logged.
+func (s studySessionService) publishNotification(ctx context.Context, userID uuid.UUID, sessionID uuid.UUID, notificationType notificationmodels.NotificationType) {
+	err := s.notificationService.PublishNotification(context.WithoutCancel(ctx), notificationmodels.Notification{
+		UserID:    userID,
+		SessionID: sessionID,
+		Type:      notificationType,
+	})
+	if err != nil {
+		s.logger.Warn("Failed to publish session notification",
+			zap.Error(err),
+			zap.String("session_id", sessionID.String()),
+			zap.String("type", string(notificationType)),
+		)
+	}
+}
+
+// timerNotificationType reports the timer transition made by an event added
+// to the active session, which can only pause or resume it
+func timerNotificationType(eventType models.EventType) (notificationmodels.NotificationType, bool) {
+	switch eventType {
+	case models.EventTypePause:
+		return notificationmodels.NotificationTypePaused, true
+	case models.EventTypeResume:
+		return notificationmodels.NotificationTypeResumed, true
+	}
+	return "", false
+}
@@ -1,13 +1,24 @@
 package studysession
 
 import (
+	"bytes"
 	"context"
 	"fmt"
+	"go-api/src/config"
 	authmodel "go-api/src/models/auth"
 	"go-api/src/models/constants"
+	notificationmodels "go-api/src/models/notifications"
 	models "go-api/src/models/studysession"
 	repository "go-api/src/repositories/studysession"
+	notificationservice "go-api/src/services/notifications"
+	statsservice "go-api/src/services/stats"
+	subjectservice "go-api/src/services/subjects"
+	"io"
+	"strings"
+	"time"
+	"unicode/utf8"
 
+	"github.com/google/uuid"
 	"go.uber.org/fx"
 	"go.uber.org/zap"
 )
@@ -15,27 +26,54 @@ import (
 type StudySessionService interface {
 	CreateStudySession(ctx context.Context, request UpsertActiveStudySessionRequest) (*models.StudySession, error)
 	GetActiveStudySession(ctx context.Context) (*models.StudySession, error)
-	GetActiveStudySessionEvents(ctx context.Context) ([]models.SessionEvent, error)
-	AddStudySessionEvents(ctx context.Context, request AddStudySessionEventsRequest) ([]models.SessionEvent, error)
-	FinishStudySession(ctx context.Context, request FinishStudySessionRequest) (*models.StudySession, error)
+	GetActiveStudySessionEvents(ctx context.Context, request GetActiveStudySessionEventsRequest) (*SessionEventsResponse, error)
+	AddStudySessionEvents(ctx context.Context, request AddStudySessionEventsRequest) (*SessionEventsResponse, error)
+	FinishStudySession(ctx context.Context, request FinishStudySessionRequest) (*FinishStudySessionResponse, error)
+	GetStudySessionHistory(ctx context.Context, request GetStudySessionHistoryRequest) (*models.StudySessionPage, error)
+	GetStudySession(ctx context.Context, sessionID uuid.UUID) (*models.StudySessionDetails, error)
+	UpdateActiveStudySession(ctx context.Context, request UpdateStudySessionRequest) (*models.StudySession, error)
+	UpdateStudySession(ctx context.Context, sessionID uuid.UUID, request UpdateStudySessionRequest) (*models.StudySession, error)
+	CancelActiveStudySession(ctx context.Context) (*models.StudySession, error)
+	DeleteStudySession(ctx context.Context, sessionID uuid.UUID) error
+	RestoreStudySession(ctx context.Context, sessionID uuid.UUID) (*models.StudySession, error)
+	PurgeDeletedStudySessions(ctx context.Context) (int64, error)
+	CloseStaleStudySessions(ctx context.Context) (int, error)
+	GetStudySessionEvents(ctx context.Context, sessionID uuid.UUID) ([]models.SessionEvent, error)
+	GetSubjectTimeTotals(ctx context.Context, request GetSubjectTimeTotalsRequest) (*models.SubjectTimeReport, error)
+	ExportStudySessionsICal(ctx context.Context, request ExportStudySessionsRequest) ([]byte, error)
+	ExportStudySessionData(ctx context.Context, request ExportStudySessionDataRequest, w io.Writer) error
+	ImportStudySessions(ctx context.Context, request ImportStudySessionsRequest, file io.Reader) (*models.ImportReport, error)
+	CreateManualStudySession(ctx context.Context, request CreateManualStudySessionRequest) (*models.StudySession, error)
 }
 
 type studySessionService struct {
-	repository repository.StudySessionRepository
-	logger     *zap.Logger
+	config              *config.Config
+	repository          repository.StudySessionRepository
+	statsService        statsservice.StatsService
+	subjectService      subjectservice.SubjectService
+	notificationService notificationservice.NotificationService
+	logger              *zap.Logger
 }
 
 type StudySessionServiceParams struct {
 	fx.In
 
-	Repository repository.StudySessionRepository
-	Logger     *zap.Logger
+	Config              *config.Config
+	Repository          repository.StudySessionRepository
+	StatsService        statsservice.StatsService
+	SubjectService      subjectservice.SubjectService
+	NotificationService notificationservice.NotificationService
+	Logger              *zap.Logger
 }
 
 func NewStudySessionService(p StudySessionServiceParams) StudySessionService {
 	return &studySessionService{
-		repository: p.Repository,
-		logger:     p.Logger,
+		config:              p.Config,
+		repository:          p.Repository,
+		statsService:        p.StatsService,
+		subjectService:      p.SubjectService,
+		notificationService: p.NotificationService,
+		logger:              p.Logger,
 	}
 }
 
@@ -45,33 +83,146 @@ func (s studySessionService) CreateStudySession(ctx context.Context, request Ups
 		s.logger.Error("Failed to create studySession, no user found in context")
 		return nil, fmt.Errorf("no user found in context")
 	}
-	return s.repository.CreateStudySession(
+	if err := validateSessionSubjects(request.Subjects); err != nil {
+		return nil, err
+	}
+	var pomodoro *models.Pomodoro
+	if request.Pomodoro != nil {
+		if !request.Pomodoro.IsValid() {
+			return nil, models.ErrInvalidPomodoro
+		}
+		pomodoro = &models.Pomodoro{Settings: *request.Pomodoro}
+	}
+	session, err := s.repository.CreateStudySession(
 		ctx,
 		models.StudySession{
-			Notes:  request.Notes,
-			Title:  request.Title,
-			UserID: user.ID,
+			Notes:    request.Notes,
+			Title:    request.Title,
+			UserID:   user.ID,
+			Subjects: request.Subjects,
+			Pomodoro: pomodoro,
 		},
 		request.StartedAt,
 	)
+	if err != nil {
+		return nil, err
+	}
+	s.publishNotification(ctx, user.ID, session.ID, notificationmodels.NotificationTypeStarted)
+	if err := s.withSessionDetails(ctx, session); err != nil {
+		return nil, err
+	}
+	return session, nil
 }
 
-func (s studySessionService) AddStudySessionEvents(ctx context.Context, request AddStudySessionEventsRequest) ([]models.SessionEvent, error) {
+// CreateManualStudySession logs a completed session after the fact, the
+// active session of the user if any is left untouched
+func (s studySessionService) CreateManualStudySession(ctx context.Context, request CreateManualStudySessionRequest) (*models.StudySession, error) {
+	user := ctx.Value(constants.ContextKeyUserInfoKey).(*authmodel.UserInfo)
+	if user == nil {
+		s.logger.Error("Failed to create manual studySession, no user found in context")
+		return nil, fmt.Errorf("no user found in context")
+	}
+	events, err := buildManualSessionEvents(request, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	if err := validateSessionSubjects(request.Subjects); err != nil {
+		return nil, err
+	}
+	session, err := s.repository.CreateManualStudySession(
+		ctx,
+		models.StudySession{
+			Notes:    request.Notes,
+			Title:    strings.TrimSpace(request.Title),
+			UserID:   user.ID,
+			Subjects: request.Subjects,
+		},
+		events,
+	)
+	if err != nil {
+		return nil, err
+	}
+	s.publishNotification(ctx, user.ID, session.ID, notificationmodels.NotificationTypeCreated)
+	if err := s.withSessionDetails(ctx, session); err != nil {
+		return nil, err
+	}
+	return session, nil
+}
+
+func (s studySessionService) AddStudySessionEvents(ctx context.Context, request AddStudySessionEventsRequest) (*SessionEventsResponse, error) {
 	user := ctx.Value(constants.ContextKeyUserInfoKey).(*authmodel.UserInfo)
 	if user == nil {
 		s.logger.Error("Failed to create studySession, no user found in context")
 		return nil, fmt.Errorf("no user found in context")
 	}
-	return s.repository.AddActiveStudySessionEvents(ctx, user.ID, request.Events)
+	for _, event := range request.Events {
+		if event.EventType == models.EventTypeStop {
+			return nil, &models.InvalidEventError{
+				Event:  event,
+				Reason: "sessions must be stopped through /study-session/finish",
+			}
+		}
+		if event.EventType.IsPhase() {
+			return nil, &models.InvalidEventError{
+				Event:  event,
+				Reason: "pomodoro phases are recorded by the server",
+			}
+		}
+		if utf8.RuneCountInString(event.DeviceID) > maxDeviceIDLength {
+			return nil, &models.InvalidEventError{
+				Event:  event,
+				Reason: fmt.Sprintf("device id is longer than %d characters", maxDeviceIDLength),
+			}
+		}
+	}
+	sync, err := s.repository.AddActiveStudySessionEvents(ctx, user.ID, request.Events)
+	if err != nil {
+		return nil, err
+	}
+	// Replays change nothing, each inserted pause or resume is a transition
+	for _, event := range sync.Added {
+		if notificationType, ok := timerNotificationType(event.EventType); ok {
+			s.publishNotification(ctx, user.ID, sync.Cursor.SessionID, notificationType)
+		}
+	}
+	return newSessionEventsResponse(sync), nil
 }
 
-func (s studySessionService) FinishStudySession(ctx context.Context, request FinishStudySessionRequest) (*models.StudySession, error) {
+func (s studySessionService) FinishStudySession(ctx context.Context, request FinishStudySessionRequest) (*FinishStudySessionResponse, error) {
 	user := ctx.Value(constants.ContextKeyUserInfoKey).(*authmodel.UserInfo)
 	if user == nil {
 		s.logger.Error("Failed to create studySession, no user found in context")
 		return nil, fmt.Errorf("no user found in context")
 	}
-	return s.repository.FinishActiveStudySession(ctx, user.ID)
+	options := models.FinishOptions{FinishedAt: request.FinishedAt}
+	switch request.FinalState {
+	case "":
+	case models.TimerStatusPaused:
+		if !request.FinishedAt.IsZero() {
+			return nil, models.ErrInvalidFinishRequest
+		}
+		options.AtLastPause = true
+	default:
+		return nil, models.ErrInvalidFinishRequest
+	}
+
+	session, err := s.repository.FinishActiveStudySession(ctx, user.ID, options)
+	if err != nil {
+		return nil, err
+	}
+	s.publishNotification(ctx, user.ID, session.ID, notificationmodels.NotificationTypeFinished)
+	if err := s.withSessionDetails(ctx, session); err != nil {
+		return nil, err
+	}
+
+	// The session is already finished, a streak failure must not fail the request
+	response := &FinishStudySessionResponse{StudySession: *session}
+	response.Streak, err = s.statsService.GetStreakUpdate(ctx, session.ID)
+	if err != nil {
+		s.logger.Warn("Failed to compute streak update", zap.Error(err), zap.String("session_id", session.ID.String()))
+		response.Streak = nil
+	}
+	return response, nil
 }
 
 func (s studySessionService) GetActiveStudySession(ctx context.Context) (*models.StudySession, error) {
@@ -80,13 +231,471 @@ func (s studySessionService) GetActiveStudySession(ctx context.Context) (*models
 		s.logger.Error("Failed to create studySession, no user found in context")
 		return nil, fmt.Errorf("no user found in context")
 	}
-	return s.repository.GetActiveStudySession(ctx, user.ID)
+	session, err := s.repository.GetActiveStudySession(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.withSessionDetails(ctx, session); err != nil {
+		return nil, err
+	}
+	return session, nil
 }
-func (s studySessionService) GetActiveStudySessionEvents(ctx context.Context) ([]models.SessionEvent, error) {
+func (s studySessionService) GetActiveStudySessionEvents(ctx context.Context, request GetActiveStudySessionEventsRequest) (*SessionEventsResponse, error) {
 	user := ctx.Value(constants.ContextKeyUserInfoKey).(*authmodel.UserInfo)
 	if user == nil {
 		s.logger.Error("Failed to create studySession, no user found in context")
 		return nil, fmt.Errorf("no user found in context")
 	}
-	return s.repository.GetActiveStudySessionEvents(ctx, user.ID)
+	var after *models.SyncCursor
+	if request.Cursor != "" {
+		cursor, err := decodeSyncCursor(request.Cursor)
+		if err != nil {
+			return nil, models.ErrInvalidSyncCursor
+		}
+		after = cursor
+	}
+	sync, err := s.repository.GetActiveStudySessionEvents(ctx, user.ID, after)
+	if err != nil {
+		return nil, err
+	}
+	return newSessionEventsResponse(sync), nil
+}
+
+func (s studySessionService) GetStudySessionHistory(ctx context.Context, request GetStudySessionHistoryRequest) (*models.StudySessionPage, error) {
+	user := ctx.Value(constants.ContextKeyUserInfoKey).(*authmodel.UserInfo)
+	if user == nil {
+		s.logger.Error("Failed to get studySession history, no user found in context")
+		return nil, fmt.Errorf("no user found in context")
+	}
+	filter, err := buildHistoryFilter(request)
+	if err != nil {
+		return nil, err
+	}
+
+	// Fetch one extra session to know whether there is a next page
+	pageSize := filter.Limit
+	filter.Limit = pageSize + 1
+	sessions, err := s.repository.ListStudySessions(ctx, user.ID, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	page := &models.StudySessionPage{Sessions: sessions}
+	if len(sessions) > pageSize {
+		page.Sessions = sessions[:pageSize]
+		page.NextCursor = encodeHistoryCursor(page.Sessions[pageSize-1])
+	}
+
+	// The extra session is dropped before its details are loaded
+	sessionRefs := make([]*models.StudySession, len(page.Sessions))
+	for i := range page.Sessions {
+		sessionRefs[i] = &page.Sessions[i]
+	}
+	if err := s.withSessionDetails(ctx, sessionRefs...); err != nil {
+		return nil, err
+	}
+	return page, nil
+}
+
+func (s studySessionService) GetStudySession(ctx context.Context, sessionID uuid.UUID) (*models.StudySessionDetails, error) {
+	user := ctx.Value(constants.ContextKeyUserInfoKey).(*authmodel.UserInfo)
+	if user == nil {
+		s.logger.Error("Failed to get studySession, no user found in context")
+		return nil, fmt.Errorf("no user found in context")
+	}
+	session, err := s.repository.GetStudySession(ctx, user.ID, sessionID)
+	if err != nil {
+		return nil, err
+	}
+	events, err := s.repository.GetStudySessionEvents(ctx, user.ID, sessionID)
+	if err != nil {
+		return nil, err
+	}
+	subjectsBySession, err := s.repository.ListSessionSubjects(ctx, []uuid.UUID{sessionID})
+	if err != nil {
+		return nil, err
+	}
+	applySessionEvents(session, events, time.Now())
+	session.Subjects = subjectsBySession[sessionID]
+	if session.Subjects == nil {
+		session.Subjects = []models.SessionSubject{}
+	}
+	return &models.StudySessionDetails{
+		StudySession: *session,
+		Events:       events,
+	}, nil
+}
+
+func (s studySessionService) UpdateActiveStudySession(ctx context.Context, request UpdateStudySessionRequest) (*models.StudySession, error) {
+	user := ctx.Value(constants.ContextKeyUserInfoKey).(*authmodel.UserInfo)
+	if user == nil {
+		s.logger.Error("Failed to update studySession, no user found in context")
+		return nil, fmt.Errorf("no user found in context")
+	}
+	update, err := buildSessionUpdate(request)
+	if err != nil {
+		return nil, err
+	}
+	session, err := s.repository.UpdateActiveStudySession(ctx, user.ID, update)
+	if err != nil {
+		return nil, err
+	}
+	s.publishNotification(ctx, user.ID, session.ID, notificationmodels.NotificationTypeUpdated)
+	if err := s.withSessionDetails(ctx, session); err != nil {
+		return nil, err
+	}
+	return session, nil
+}
+
+func (s studySessionService) UpdateStudySession(ctx context.Context, sessionID uuid.UUID, request UpdateStudySessionRequest) (*models.StudySession, error) {
+	user := ctx.Value(constants.ContextKeyUserInfoKey).(*authmodel.UserInfo)
+	if user == nil {
+		s.logger.Error("Failed to update studySession, no user found in context")
+		return nil, fmt.Errorf("no user found in context")
+	}
+	update, err := buildSessionUpdate(request)
+	if err != nil {
+		return nil, err
+	}
+	session, err := s.repository.UpdateStudySession(ctx, user.ID, sessionID, update)
+	if err != nil {
+		return nil, err
+	}
+	s.publishNotification(ctx, user.ID, session.ID, notificationmodels.NotificationTypeUpdated)
+	if err := s.withSessionDetails(ctx, session); err != nil {
+		return nil, err
+	}
+	return session, nil
+}
+
+func (s studySessionService) CancelActiveStudySession(ctx context.Context) (*models.StudySession, error) {
+	user := ctx.Value(constants.ContextKeyUserInfoKey).(*authmodel.UserInfo)
+	if user == nil {
+		s.logger.Error("Failed to cancel studySession, no user found in context")
+		return nil, fmt.Errorf("no user found in context")
+	}
+	session, err := s.repository.CancelActiveStudySession(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+	s.publishNotification(ctx, user.ID, session.ID, notificationmodels.NotificationTypeCanceled)
+	if err := s.withSessionDetails(ctx, session); err != nil {
+		return nil, err
+	}
+	return session, nil
+}
+
+func (s studySessionService) DeleteStudySession(ctx context.Context, sessionID uuid.UUID) error {
+	user := ctx.Value(constants.ContextKeyUserInfoKey).(*authmodel.UserInfo)
+	if user == nil {
+		s.logger.Error("Failed to delete studySession, no user found in context")
+		return fmt.Errorf("no user found in context")
+	}
+	if err := s.repository.DeleteStudySession(ctx, user.ID, sessionID); err != nil {
+		return err
+	}
+	s.publishNotification(ctx, user.ID, sessionID, notificationmodels.NotificationTypeDeleted)
+	return nil
+}
+
+func (s studySessionService) RestoreStudySession(ctx context.Context, sessionID uuid.UUID) (*models.StudySession, error) {
+	user := ctx.Value(constants.ContextKeyUserInfoKey).(*authmodel.UserInfo)
+	if user == nil {
+		s.logger.Error("Failed to restore studySession, no user found in context")
+		return nil, fmt.Errorf("no user found in context")
+	}
+	session, err := s.repository.RestoreStudySession(ctx, user.ID, sessionID, s.retentionCutoff())
+	if err != nil {
+		return nil, err
+	}
+	s.publishNotification(ctx, user.ID, session.ID, notificationmodels.NotificationTypeRestored)
+	if err := s.withSessionDetails(ctx, session); err != nil {
+		return nil, err
+	}
+	return session, nil
+}
+
+// PurgeDeletedStudySessions hard deletes the sessions that were deleted
+// longer ago than the retention window
+func (s studySessionService) PurgeDeletedStudySessions(ctx context.Context) (int64, error) {
+	return s.repository.PurgeDeletedStudySessions(ctx, s.retentionCutoff())
+}
+
+// retentionCutoff is the deletion time before which sessions can't be restored anymore
+func (s studySessionService) retentionCutoff() time.Time {
+	retention := time.Duration(s.config.DeletedSessionRetentionHours) * time.Hour
+	return time.Now().Add(-retention)
+}
+
+// staleSessionBatchSize bounds the sessions closed in a single transaction
+const staleSessionBatchSize = 100
+
+// CloseStaleStudySessions finishes or pauses, depending on the configured
+// action, the active sessions without events for longer than the idle
+// threshold. Sessions are closed in batches until none is left.
+func (s studySessionService) CloseStaleStudySessions(ctx context.Context) (int, error) {
+	action := models.StaleSessionAction(s.config.StaleSessionAction)
+	if !action.IsValid() {
+		return 0, models.ErrInvalidStaleAction
+	}
+	idleBefore := time.Now().Add(-time.Duration(s.config.StaleSessionIdleMinutes) * time.Minute)
+
+	closed := 0
+	for {
+		sessions, err := s.repository.CloseStaleStudySessions(ctx, idleBefore, action, staleSessionBatchSize)
+		if err != nil {
+			return closed, err
+		}
+		closed += len(sessions)
+		for _, session := range sessions {
+			notificationType := notificationmodels.NotificationTypeFinished
+			if action == models.StaleSessionActionPause {
+				notificationType = notificationmodels.NotificationTypePaused
+			}
+			s.publishNotification(ctx, session.UserID, session.ID, notificationType)
+		}
+		if len(sessions) < staleSessionBatchSize {
+			return closed, nil
+		}
+	}
+}
+
+func (s studySessionService) GetStudySessionEvents(ctx context.Context, sessionID uuid.UUID) ([]models.SessionEvent, error) {
+	user := ctx.Value(constants.ContextKeyUserInfoKey).(*authmodel.UserInfo)
+	if user == nil {
+		s.logger.Error("Failed to get studySession events, no user found in context")
+		return nil, fmt.Errorf("no user found in context")
+	}
+	return s.repository.GetStudySessionEvents(ctx, user.ID, sessionID)
+}
+
+// GetSubjectTimeTotals reports the focused time spent on each subject in the
+// completed sessions of a date range
+func (s studySessionService) GetSubjectTimeTotals(ctx context.Context, request GetSubjectTimeTotalsRequest) (*models.SubjectTimeReport, error) {
+	user := ctx.Value(constants.ContextKeyUserInfoKey).(*authmodel.UserInfo)
+	if user == nil {
+		s.logger.Error("Failed to get subject time totals, no user found in context")
+		return nil, fmt.Errorf("no user found in context")
+	}
+	filter, err := buildSubjectTimeFilter(request)
+	if err != nil {
+		return nil, err
+	}
+	sessions, err := s.repository.ListStudySessions(ctx, user.ID, filter)
+	if err != nil {
+		return nil, err
+	}
+	sessionRefs := make([]*models.StudySession, len(sessions))
+	for i := range sessions {
+		sessionRefs[i] = &sessions[i]
+	}
+	if err := s.withSessionDetails(ctx, sessionRefs...); err != nil {
+		return nil, err
+	}
+	report := splitFocusedTime(sessions)
+	return &report, nil
+}
+
+// ExportStudySessionsICal renders the user's completed sessions as an
+// iCalendar file, one event per session
+func (s studySessionService) ExportStudySessionsICal(ctx context.Context, request ExportStudySessionsRequest) ([]byte, error) {
+	user := ctx.Value(constants.ContextKeyUserInfoKey).(*authmodel.UserInfo)
+	if user == nil {
+		s.logger.Error("Failed to export studySessions, no user found in context")
+		return nil, fmt.Errorf("no user found in context")
+	}
+	filter := models.HistoryFilter{States: []models.SessionState{models.SessionStateCompleted}}
+	var err error
+	filter.From, filter.To, err = parseDateRange(request.From, request.To)
+	if err != nil {
+		return nil, err
+	}
+	sessions, err := s.repository.ListStudySessions(ctx, user.ID, filter)
+	if err != nil {
+		return nil, err
+	}
+	sessionIDs := make([]uuid.UUID, len(sessions))
+	for i := range sessions {
+		sessionIDs[i] = sessions[i].ID
+	}
+	eventsBySession, err := s.repository.ListSessionEvents(ctx, sessionIDs)
+	if err != nil {
+		return nil, err
+	}
+	subjectsBySession, err := s.repository.ListSessionSubjects(ctx, sessionIDs)
+	if err != nil {
+		return nil, err
+	}
+	subjects, err := s.subjectService.ListSubjects(ctx, subjectservice.ListSubjectsRequest{IncludeArchived: true})
+	if err != nil {
+		return nil, err
+	}
+	subjectNames := make(map[uuid.UUID]string, len(subjects))
+	for _, subject := range subjects {
+		subjectNames[subject.ID] = subject.Name
+	}
+
+	now := time.Now()
+	for i := range sessions {
+		sessions[i].Durations = models.ComputeDurations(eventsBySession[sessions[i].ID], now)
+		sessions[i].Subjects = subjectsBySession[sessions[i].ID]
+	}
+	var buffer bytes.Buffer
+	writeICalendar(&buffer, newCalendarEvents(sessions, eventsBySession, subjectNames))
+	return buffer.Bytes(), nil
+}
+
+// ExportStudySessionData writes all the user's sessions with their events and
+// durations to w. Sessions are streamed from the repository and the output is
+// buffered, so nothing reaches w when the export fails right away.
+func (s studySessionService) ExportStudySessionData(ctx context.Context, request ExportStudySessionDataRequest, w io.Writer) error {
+	user := ctx.Value(constants.ContextKeyUserInfoKey).(*authmodel.UserInfo)
+	if user == nil {
+		s.logger.Error("Failed to export studySessions, no user found in context")
+		return fmt.Errorf("no user found in context")
+	}
+	format, err := models.ParseExportFormat(request.Format)
+	if err != nil {
+		return err
+	}
+
+	exporter := newSessionExporter(format, w)
+	now := time.Now()
+	err = s.repository.StreamStudySessions(ctx, user.ID, func(session models.StudySessionDetails) error {
+		applySessionEvents(&session.StudySession, session.Events, now)
+		return exporter.Write(session)
+	})
+	if err != nil {
+		return err
+	}
+	return exporter.Close()
+}
+
+// ImportStudySessions creates completed sessions from the rows of a CSV file.
+// Rows already imported are skipped, and nothing is imported when any row is
+// invalid so that the file can be fixed and uploaded again. The repository
+// checks the overlaps again under a lock, a session created meanwhile fails
+// the import with ErrSessionOverlap.
+func (s studySessionService) ImportStudySessions(ctx context.Context, request ImportStudySessionsRequest, file io.Reader) (*models.ImportReport, error) {
+	user := ctx.Value(constants.ContextKeyUserInfoKey).(*authmodel.UserInfo)
+	if user == nil {
+		s.logger.Error("Failed to import studySessions, no user found in context")
+		return nil, fmt.Errorf("no user found in context")
+	}
+	columns, location, err := loadImportOptions(request)
+	if err != nil {
+		return nil, err
+	}
+	subjects, err := s.subjectService.ListSubjects(ctx, subjectservice.ListSubjectsRequest{IncludeArchived: true})
+	if err != nil {
+		return nil, err
+	}
+	subjectIDs := make(map[string]uuid.UUID, len(subjects))
+	for _, subject := range subjects {
+		subjectIDs[strings.ToLower(subject.Name)] = subject.ID
+	}
+
+	now := time.Now()
+	rows, err := readImportRows(file, columns, location, subjectIDs, now)
+	if err != nil {
+		return nil, err
+	}
+	importKeys := make([]string, 0, len(rows))
+	for _, row := range rows {
+		if row.Session.ImportKey != "" {
+			importKeys = append(importKeys, row.Session.ImportKey)
+		}
+	}
+	existingKeys, err := s.repository.ListImportKeys(ctx, user.ID, importKeys)
+	if err != nil {
+		return nil, err
+	}
+	markDuplicateRows(rows, existingKeys)
+	if from, to, ok := importedRange(rows); ok {
+		intervals, err := s.repository.ListSessionIntervals(ctx, user.ID, from, to)
+		if err != nil {
+			return nil, err
+		}
+		markOverlappingRows(rows, intervals, now)
+	}
+
+	report := newImportReport(rows, request.DryRun)
+	if request.DryRun || report.Invalid > 0 || report.Valid == 0 {
+		return report, nil
+	}
+	var sessions []models.ImportedSession
+	for _, row := range rows {
+		if row.isValid() {
+			sessions = append(sessions, row.Session)
+		}
+	}
+	importedKeys, err := s.repository.ImportStudySessions(ctx, user.ID, sessions)
+	if err != nil {
+		return nil, err
+	}
+	markImportedRows(report, rows, importedKeys)
+	return report, nil
+}
+
+// withSessionDetails computes the durations of the given sessions from their
+// stored events and loads their subjects
+func (s studySessionService) withSessionDetails(ctx context.Context, sessions ...*models.StudySession) error {
+	sessionIDs := make([]uuid.UUID, len(sessions))
+	for i, session := range sessions {
+		sessionIDs[i] = session.ID
+	}
+	eventsBySession, err := s.repository.ListSessionEvents(ctx, sessionIDs)
+	if err != nil {
+		return err
+	}
+	subjectsBySession, err := s.repository.ListSessionSubjects(ctx, sessionIDs)
+	if err != nil {
+		return err
+	}
+	now := time.Now()
+	for _, session := range sessions {
+		applySessionEvents(session, eventsBySession[session.ID], now)
+		session.Subjects = subjectsBySession[session.ID]
+		if session.Subjects == nil {
+			session.Subjects = []models.SessionSubject{}
+		}
+	}
+	return nil
+}
+
+// applySessionEvents derives the durations and the pomodoro state of the
+// session from its events
+func applySessionEvents(session *models.StudySession, events []models.SessionEvent, now time.Time) {
+	session.Durations = models.ComputeDurations(events, now)
+	if session.Pomodoro != nil {
+		pomodoro := models.ComputePomodoro(session.Pomodoro.Settings, events, now)
+		session.Pomodoro = &pomodoro
+	}
+}
+
+// maxTitleLength matches the size of study_sessions.title
+const maxTitleLength = 100
+
+func buildSessionUpdate(request UpdateStudySessionRequest) (models.SessionUpdate, error) {
+	update := models.SessionUpdate{
+		Notes:       request.Notes,
+		Subjects:    request.Subjects,
+		IfUpdatedAt: request.IfUpdatedAt,
+	}
+	if request.Title == nil && request.Notes == nil && request.Subjects == nil {
+		return update, models.ErrInvalidSessionUpdate
+	}
+	if request.Subjects != nil {
+		if err := validateSessionSubjects(*request.Subjects); err != nil {
+			return update, err
+		}
+	}
+	if request.Title != nil {
+		title := strings.TrimSpace(*request.Title)
+		if utf8.RuneCountInString(title) > maxTitleLength {
+			return update, models.ErrInvalidSessionUpdate
+		}
+		update.Title = &title
+	}
+	return update, nil
 }
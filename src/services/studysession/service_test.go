@@ -0,0 +1,745 @@
+package studysession
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	mockrepository "go-api/.internal/mocks/src/repositories/studysession"
+	mocknotificationservice "go-api/.internal/mocks/src/services/notifications"
+	mockstatsservice "go-api/.internal/mocks/src/services/stats"
+	mocksubjectservice "go-api/.internal/mocks/src/services/subjects"
+	"go-api/src/config"
+	authmodel "go-api/src/models/auth"
+	"go-api/src/models/constants"
+	notificationmodels "go-api/src/models/notifications"
+	statsmodels "go-api/src/models/stats"
+	models "go-api/src/models/studysession"
+	subjectmodels "go-api/src/models/subjects"
+	subjectservice "go-api/src/services/subjects"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/zap/zaptest"
+)
+
+// ServiceTestSuite ...
+type ServiceTestSuite struct {
+	suite.Suite
+
+	MockRepository          *mockrepository.StudySessionRepository
+	MockStatsService        *mockstatsservice.StatsService
+	MockSubjectService      *mocksubjectservice.SubjectService
+	MockNotificationService *mocknotificationservice.NotificationService
+
+	User    *authmodel.UserInfo
+	Service StudySessionService
+}
+
+// SetupTest ...
+func (s *ServiceTestSuite) SetupTest() {
+	t := s.T()
+	s.MockRepository = mockrepository.NewStudySessionRepository(t)
+	s.MockStatsService = mockstatsservice.NewStatsService(t)
+	s.MockSubjectService = mocksubjectservice.NewSubjectService(t)
+	s.MockNotificationService = mocknotificationservice.NewNotificationService(t)
+	s.User = &authmodel.UserInfo{ID: uuid.New()}
+	s.Service = NewStudySessionService(StudySessionServiceParams{
+		Config: &config.Config{
+			DeletedSessionRetentionHours: 24,
+			StaleSessionIdleMinutes:      60,
+			StaleSessionAction:           string(models.StaleSessionActionFinish),
+		},
+		Repository:          s.MockRepository,
+		StatsService:        s.MockStatsService,
+		SubjectService:      s.MockSubjectService,
+		NotificationService: s.MockNotificationService,
+		Logger:              zaptest.NewLogger(t),
+	})
+}
+
+// SetupSubTest ...
+func (s *ServiceTestSuite) SetupSubTest() {
+	s.SetupTest() // Clean up the mocks
+}
+
+// TestServiceTestSuite ...
+func TestServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(ServiceTestSuite))
+}
+
+func (s *ServiceTestSuite) userContext() context.Context {
+	return context.WithValue(context.Background(), constants.ContextKeyUserInfoKey, s.User)
+}
+
+func (s *ServiceTestSuite) expectNotification(sessionID uuid.UUID, notificationType notificationmodels.NotificationType) {
+	s.MockNotificationService.EXPECT().PublishNotification(mock.Anything, notificationmodels.Notification{
+		UserID:    s.User.ID,
+		SessionID: sessionID,
+		Type:      notificationType,
+	}).Return(nil)
+}
+
+// TestFinishStudySession ...
+func (s *ServiceTestSuite) TestFinishStudySession() {
+	finishedAt := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	session := &models.StudySession{ID: uuid.New(), SessionState: models.SessionStateCompleted}
+	streakUpdate := &statsmodels.StreakUpdate{PreviousStreak: 2, CurrentStreak: 3, GoalReached: true}
+
+	tests := map[string]struct {
+		Request         FinishStudySessionRequest
+		ExpectedOptions *models.FinishOptions
+		StreakError     error
+		PublishError    error
+		ExpectedError   error
+	}{
+		"finish now": {
+			Request:         FinishStudySessionRequest{},
+			ExpectedOptions: &models.FinishOptions{},
+		},
+		"finish at explicit time": {
+			Request:         FinishStudySessionRequest{FinishedAt: finishedAt},
+			ExpectedOptions: &models.FinishOptions{FinishedAt: finishedAt},
+		},
+		"finish at last pause": {
+			Request:         FinishStudySessionRequest{FinalState: models.TimerStatusPaused},
+			ExpectedOptions: &models.FinishOptions{AtLastPause: true},
+		},
+		"streak failure doesn't fail the finish": {
+			Request:         FinishStudySessionRequest{},
+			ExpectedOptions: &models.FinishOptions{},
+			StreakError:     errors.New("database is down"),
+		},
+		"notification failure doesn't fail the finish": {
+			Request:         FinishStudySessionRequest{},
+			ExpectedOptions: &models.FinishOptions{},
+			PublishError:    errors.New("database is down"),
+		},
+		"fail - last pause with explicit time": {
+			Request:       FinishStudySessionRequest{FinishedAt: finishedAt, FinalState: models.TimerStatusPaused},
+			ExpectedError: models.ErrInvalidFinishRequest,
+		},
+		"fail - unsupported final state": {
+			Request:       FinishStudySessionRequest{FinalState: models.TimerStatusRunning},
+			ExpectedError: models.ErrInvalidFinishRequest,
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			if tc.ExpectedOptions != nil {
+				s.MockRepository.EXPECT().FinishActiveStudySession(mock.Anything, s.User.ID, *tc.ExpectedOptions).Return(session, nil)
+				s.MockNotificationService.EXPECT().PublishNotification(mock.Anything, notificationmodels.Notification{
+					UserID:    s.User.ID,
+					SessionID: session.ID,
+					Type:      notificationmodels.NotificationTypeFinished,
+				}).Return(tc.PublishError)
+				s.MockRepository.EXPECT().ListSessionEvents(mock.Anything, []uuid.UUID{session.ID}).Return(map[uuid.UUID][]models.SessionEvent{}, nil)
+				s.MockRepository.EXPECT().ListSessionSubjects(mock.Anything, []uuid.UUID{session.ID}).Return(map[uuid.UUID][]models.SessionSubject{}, nil)
+				if tc.StreakError != nil {
+					s.MockStatsService.EXPECT().GetStreakUpdate(mock.Anything, session.ID).Return(nil, tc.StreakError)
+				} else {
+					s.MockStatsService.EXPECT().GetStreakUpdate(mock.Anything, session.ID).Return(streakUpdate, nil)
+				}
+			}
+
+			result, err := s.Service.FinishStudySession(s.userContext(), tc.Request)
+
+			if tc.ExpectedError != nil {
+				s.ErrorIs(err, tc.ExpectedError)
+				return
+			}
+			s.NoError(err)
+			s.Equal(session.ID, result.ID)
+			if tc.StreakError != nil {
+				s.Nil(result.Streak)
+			} else {
+				s.Equal(streakUpdate, result.Streak)
+			}
+		})
+	}
+}
+
+// TestGetStudySessionHistory ...
+func (s *ServiceTestSuite) TestGetStudySessionHistory() {
+	date := time.Date(2025, 1, 10, 0, 0, 0, 0, time.UTC)
+	first := models.StudySession{ID: uuid.New(), Date: date}
+	second := models.StudySession{ID: uuid.New(), Date: date.AddDate(0, 0, -1)}
+	extra := models.StudySession{ID: uuid.New(), Date: date.AddDate(0, 0, -2)}
+
+	s.Run("loads the details of the page sessions only", func() {
+		s.MockRepository.EXPECT().ListStudySessions(mock.Anything, s.User.ID, mock.MatchedBy(func(filter models.HistoryFilter) bool {
+			return filter.Limit == 3
+		})).Return([]models.StudySession{first, second, extra}, nil)
+		s.MockRepository.EXPECT().ListSessionEvents(mock.Anything, []uuid.UUID{first.ID, second.ID}).
+			Return(map[uuid.UUID][]models.SessionEvent{}, nil)
+		s.MockRepository.EXPECT().ListSessionSubjects(mock.Anything, []uuid.UUID{first.ID, second.ID}).
+			Return(map[uuid.UUID][]models.SessionSubject{}, nil)
+
+		page, err := s.Service.GetStudySessionHistory(s.userContext(), GetStudySessionHistoryRequest{Limit: 2})
+
+		s.NoError(err)
+		s.Len(page.Sessions, 2)
+		s.Equal(encodeHistoryCursor(second), page.NextCursor)
+	})
+}
+
+// TestRestoreStudySession ...
+func (s *ServiceTestSuite) TestRestoreStudySession() {
+	session := &models.StudySession{ID: uuid.New(), SessionState: models.SessionStateCompleted}
+	withinRetention := mock.MatchedBy(func(deletedAfter time.Time) bool {
+		expected := time.Now().Add(-24 * time.Hour)
+		return deletedAfter.Sub(expected).Abs() < time.Minute
+	})
+
+	tests := map[string]struct {
+		MockSetup     func()
+		ExpectedError error
+	}{
+		"success": {
+			MockSetup: func() {
+				s.MockRepository.EXPECT().RestoreStudySession(mock.Anything, s.User.ID, session.ID, withinRetention).Return(session, nil)
+				s.expectNotification(session.ID, notificationmodels.NotificationTypeRestored)
+				s.MockRepository.EXPECT().ListSessionEvents(mock.Anything, []uuid.UUID{session.ID}).Return(map[uuid.UUID][]models.SessionEvent{}, nil)
+				s.MockRepository.EXPECT().ListSessionSubjects(mock.Anything, []uuid.UUID{session.ID}).Return(map[uuid.UUID][]models.SessionSubject{}, nil)
+			},
+		},
+		"fail - retention window expired": {
+			MockSetup: func() {
+				s.MockRepository.EXPECT().RestoreStudySession(mock.Anything, s.User.ID, session.ID, withinRetention).Return(nil, models.ErrSessionNotFound)
+			},
+			ExpectedError: models.ErrSessionNotFound,
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			tc.MockSetup()
+
+			result, err := s.Service.RestoreStudySession(s.userContext(), session.ID)
+
+			if tc.ExpectedError != nil {
+				s.ErrorIs(err, tc.ExpectedError)
+				return
+			}
+			s.NoError(err)
+			s.Equal(session.ID, result.ID)
+		})
+	}
+}
+
+// TestExportStudySessionData ...
+func (s *ServiceTestSuite) TestExportStudySessionData() {
+	startedAt := time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)
+	session := models.StudySessionDetails{
+		StudySession: models.StudySession{
+			ID:           uuid.New(),
+			Date:         startedAt,
+			SessionState: models.SessionStateCompleted,
+			Subjects:     []models.SessionSubject{},
+		},
+		Events: []models.SessionEvent{
+			{EventType: models.EventTypeStart, EventTime: startedAt},
+			{EventType: models.EventTypeStop, EventTime: startedAt.Add(time.Hour)},
+		},
+	}
+	streamError := errors.New("connection reset")
+
+	tests := map[string]struct {
+		Request          ExportStudySessionDataRequest
+		MockSetup        func()
+		ExpectedContains string
+		ExpectedError    error
+	}{
+		"csv with computed durations": {
+			Request: ExportStudySessionDataRequest{Format: "csv"},
+			MockSetup: func() {
+				s.MockRepository.EXPECT().StreamStudySessions(mock.Anything, s.User.ID, mock.Anything).
+					RunAndReturn(func(_ context.Context, _ uuid.UUID, fn func(models.StudySessionDetails) error) error {
+						return fn(session)
+					})
+			},
+			ExpectedContains: ",3600,3600,0,0,stop,",
+		},
+		"json with computed durations": {
+			Request: ExportStudySessionDataRequest{Format: "json"},
+			MockSetup: func() {
+				s.MockRepository.EXPECT().StreamStudySessions(mock.Anything, s.User.ID, mock.Anything).
+					RunAndReturn(func(_ context.Context, _ uuid.UUID, fn func(models.StudySessionDetails) error) error {
+						return fn(session)
+					})
+			},
+			ExpectedContains: `"focused_seconds":3600`,
+		},
+		"fail - unknown format": {
+			Request:       ExportStudySessionDataRequest{Format: "xlsx"},
+			MockSetup:     func() {},
+			ExpectedError: models.ErrInvalidExportFormat,
+		},
+		"fail - stream error": {
+			Request: ExportStudySessionDataRequest{Format: "json"},
+			MockSetup: func() {
+				s.MockRepository.EXPECT().StreamStudySessions(mock.Anything, s.User.ID, mock.Anything).Return(streamError)
+			},
+			ExpectedError: streamError,
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			tc.MockSetup()
+			var output bytes.Buffer
+
+			err := s.Service.ExportStudySessionData(s.userContext(), tc.Request, &output)
+
+			if tc.ExpectedError != nil {
+				s.ErrorIs(err, tc.ExpectedError)
+				s.Empty(output.String())
+				return
+			}
+			s.NoError(err)
+			s.Contains(output.String(), tc.ExpectedContains)
+		})
+	}
+}
+
+// TestImportStudySessions ...
+func (s *ServiceTestSuite) TestImportStudySessions() {
+	subject := subjectmodels.Subject{ID: uuid.New(), Name: "Calculus"}
+	validFile := "start,end,title,subject\n" +
+		"2025-01-10T09:00:00Z,2025-01-10T10:00:00Z,Limits,calculus\n" +
+		"2025-01-11T09:00:00Z,2025-01-11T10:00:00Z,Series,\n"
+	invalidFile := validFile + "2025-01-12T09:00:00Z,,Derivatives,\n"
+	withSubjects := func() {
+		s.MockSubjectService.EXPECT().ListSubjects(mock.Anything, subjectservice.ListSubjectsRequest{IncludeArchived: true}).
+			Return([]subjectmodels.Subject{subject}, nil)
+	}
+	withoutConflicts := func() {
+		s.MockRepository.EXPECT().ListImportKeys(mock.Anything, s.User.ID, mock.Anything).Return([]string{}, nil)
+		s.MockRepository.EXPECT().ListSessionIntervals(mock.Anything, s.User.ID,
+			time.Date(2025, 1, 10, 9, 0, 0, 0, time.UTC),
+			time.Date(2025, 1, 11, 10, 0, 0, 0, time.UTC),
+		).Return([]models.SessionInterval{}, nil)
+	}
+
+	tests := map[string]struct {
+		Request          ImportStudySessionsRequest
+		File             string
+		MockSetup        func()
+		ExpectedStatuses []models.ImportRowStatus
+		ExpectedImported int
+		ExpectedError    error
+	}{
+		"import": {
+			File: validFile,
+			MockSetup: func() {
+				withSubjects()
+				withoutConflicts()
+				s.MockRepository.EXPECT().ImportStudySessions(mock.Anything, s.User.ID, mock.MatchedBy(func(sessions []models.ImportedSession) bool {
+					return len(sessions) == 2 && sessions[0].Subjects[0].SubjectID == subject.ID && sessions[1].Subjects == nil
+				})).RunAndReturn(func(_ context.Context, _ uuid.UUID, sessions []models.ImportedSession) ([]string, error) {
+					return []string{sessions[0].ImportKey, sessions[1].ImportKey}, nil
+				})
+			},
+			ExpectedStatuses: []models.ImportRowStatus{models.ImportRowStatusImported, models.ImportRowStatusImported},
+			ExpectedImported: 2,
+		},
+		"dry run": {
+			Request: ImportStudySessionsRequest{DryRun: true},
+			File:    validFile,
+			MockSetup: func() {
+				withSubjects()
+				withoutConflicts()
+			},
+			ExpectedStatuses: []models.ImportRowStatus{models.ImportRowStatusValid, models.ImportRowStatusValid},
+		},
+		"nothing imported with invalid rows": {
+			File: invalidFile,
+			MockSetup: func() {
+				withSubjects()
+				withoutConflicts()
+			},
+			ExpectedStatuses: []models.ImportRowStatus{models.ImportRowStatusValid, models.ImportRowStatusValid, models.ImportRowStatusInvalid},
+		},
+		"re-import skips existing rows": {
+			File: validFile,
+			MockSetup: func() {
+				withSubjects()
+				s.MockRepository.EXPECT().ListImportKeys(mock.Anything, s.User.ID, mock.Anything).
+					RunAndReturn(func(_ context.Context, _ uuid.UUID, importKeys []string) ([]string, error) {
+						return importKeys, nil
+					})
+			},
+			ExpectedStatuses: []models.ImportRowStatus{models.ImportRowStatusDuplicate, models.ImportRowStatusDuplicate},
+		},
+		"fail - overlapping session created meanwhile": {
+			File: validFile,
+			MockSetup: func() {
+				withSubjects()
+				withoutConflicts()
+				s.MockRepository.EXPECT().ImportStudySessions(mock.Anything, s.User.ID, mock.Anything).Return(nil, models.ErrSessionOverlap)
+			},
+			ExpectedError: models.ErrSessionOverlap,
+		},
+		"fail - invalid mapping": {
+			Request:       ImportStudySessionsRequest{Mapping: "harvest"},
+			File:          validFile,
+			MockSetup:     func() {},
+			ExpectedError: models.ErrInvalidImportOptions,
+		},
+		"fail - missing columns": {
+			File:          "title\nLimits\n",
+			MockSetup:     withSubjects,
+			ExpectedError: models.ErrInvalidImportFile,
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			tc.MockSetup()
+
+			report, err := s.Service.ImportStudySessions(s.userContext(), tc.Request, strings.NewReader(tc.File))
+
+			if tc.ExpectedError != nil {
+				s.ErrorIs(err, tc.ExpectedError)
+				return
+			}
+			s.NoError(err)
+			statuses := make([]models.ImportRowStatus, len(report.Rows))
+			for i, row := range report.Rows {
+				statuses[i] = row.Status
+			}
+			s.Equal(tc.ExpectedStatuses, statuses)
+			s.Equal(tc.ExpectedImported, report.Imported)
+			s.Equal(tc.Request.DryRun, report.DryRun)
+		})
+	}
+}
+
+// TestCreateManualStudySession ...
+func (s *ServiceTestSuite) TestCreateManualStudySession() {
+	startedAt := time.Date(2025, 1, 10, 9, 0, 0, 0, time.UTC)
+	session := &models.StudySession{ID: uuid.New(), SessionState: models.SessionStateCompleted}
+	request := CreateManualStudySessionRequest{
+		StartedAt: startedAt,
+		StoppedAt: startedAt.Add(time.Hour),
+		Pauses:    []PauseInterval{{Start: startedAt.Add(20 * time.Minute), End: startedAt.Add(30 * time.Minute)}},
+		Title:     " Algebra ",
+	}
+	// The user is created again for every sub test
+	expectedSession := func() models.StudySession {
+		return models.StudySession{UserID: s.User.ID, Title: "Algebra"}
+	}
+	expectedEvents := []models.SessionEvent{
+		{EventType: models.EventTypeStart, EventTime: startedAt},
+		{EventType: models.EventTypePause, EventTime: startedAt.Add(20 * time.Minute)},
+		{EventType: models.EventTypeResume, EventTime: startedAt.Add(30 * time.Minute)},
+		{EventType: models.EventTypeStop, EventTime: startedAt.Add(time.Hour)},
+	}
+
+	tests := map[string]struct {
+		Request         CreateManualStudySessionRequest
+		MockSetup       func()
+		ExpectedFocused int64
+		ExpectedError   error
+	}{
+		"success": {
+			Request: request,
+			MockSetup: func() {
+				s.MockRepository.EXPECT().CreateManualStudySession(mock.Anything, expectedSession(), expectedEvents).Return(session, nil)
+				s.expectNotification(session.ID, notificationmodels.NotificationTypeCreated)
+				s.MockRepository.EXPECT().ListSessionEvents(mock.Anything, []uuid.UUID{session.ID}).
+					Return(map[uuid.UUID][]models.SessionEvent{session.ID: expectedEvents}, nil)
+				s.MockRepository.EXPECT().ListSessionSubjects(mock.Anything, []uuid.UUID{session.ID}).Return(map[uuid.UUID][]models.SessionSubject{}, nil)
+			},
+			ExpectedFocused: 50 * 60,
+		},
+		"fail - overlap": {
+			Request: request,
+			MockSetup: func() {
+				s.MockRepository.EXPECT().CreateManualStudySession(mock.Anything, expectedSession(), expectedEvents).Return(nil, models.ErrSessionOverlap)
+			},
+			ExpectedError: models.ErrSessionOverlap,
+		},
+		"fail - invalid times": {
+			Request:       CreateManualStudySessionRequest{StartedAt: startedAt, StoppedAt: startedAt},
+			MockSetup:     func() {},
+			ExpectedError: models.ErrInvalidManualSession,
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			tc.MockSetup()
+
+			result, err := s.Service.CreateManualStudySession(s.userContext(), tc.Request)
+
+			if tc.ExpectedError != nil {
+				s.ErrorIs(err, tc.ExpectedError)
+				return
+			}
+			s.NoError(err)
+			s.Equal(tc.ExpectedFocused, result.Durations.FocusedSeconds)
+		})
+	}
+}
+
+// TestCreateStudySessionWithPomodoro ...
+func (s *ServiceTestSuite) TestCreateStudySessionWithPomodoro() {
+	startedAt := time.Now().Add(-10 * time.Minute)
+	settings := models.PomodoroSettings{WorkMinutes: 25, ShortBreakMinutes: 5, LongBreakMinutes: 15, Cycles: 4}
+
+	tests := map[string]struct {
+		Settings      models.PomodoroSettings
+		MockSetup     func()
+		ExpectedError error
+	}{
+		"success": {
+			Settings: settings,
+			MockSetup: func() {
+				session := &models.StudySession{ID: uuid.New(), Pomodoro: &models.Pomodoro{Settings: settings}}
+				s.MockRepository.EXPECT().CreateStudySession(mock.Anything, mock.MatchedBy(func(session models.StudySession) bool {
+					return session.Pomodoro != nil && session.Pomodoro.Settings == settings
+				}), startedAt).Return(session, nil)
+				s.expectNotification(session.ID, notificationmodels.NotificationTypeStarted)
+				s.MockRepository.EXPECT().ListSessionEvents(mock.Anything, []uuid.UUID{session.ID}).Return(map[uuid.UUID][]models.SessionEvent{
+					session.ID: {{EventType: models.EventTypeStart, EventTime: startedAt}},
+				}, nil)
+				s.MockRepository.EXPECT().ListSessionSubjects(mock.Anything, []uuid.UUID{session.ID}).Return(map[uuid.UUID][]models.SessionSubject{}, nil)
+			},
+		},
+		"fail - invalid settings": {
+			Settings:      models.PomodoroSettings{WorkMinutes: 25},
+			MockSetup:     func() {},
+			ExpectedError: models.ErrInvalidPomodoro,
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			tc.MockSetup()
+
+			result, err := s.Service.CreateStudySession(s.userContext(), UpsertActiveStudySessionRequest{
+				StartedAt: startedAt,
+				Pomodoro:  &tc.Settings,
+			})
+
+			if tc.ExpectedError != nil {
+				s.ErrorIs(err, tc.ExpectedError)
+				return
+			}
+			s.NoError(err)
+			s.Equal(models.PomodoroPhaseWork, result.Pomodoro.Phase)
+			s.InDelta(15*60, result.Pomodoro.RemainingSeconds, 5)
+			s.NotNil(result.Pomodoro.PhaseEndsAt)
+		})
+	}
+}
+
+// TestAddStudySessionEventsRejectsPhases ...
+func (s *ServiceTestSuite) TestAddStudySessionEventsRejectsPhases() {
+	_, err := s.Service.AddStudySessionEvents(s.userContext(), AddStudySessionEventsRequest{
+		Events: []models.SessionEvent{{EventType: models.EventTypeShortBreak, EventTime: time.Now()}},
+	})
+
+	var invalidEventError *models.InvalidEventError
+	s.ErrorAs(err, &invalidEventError)
+}
+
+// TestCloseStaleStudySessions ...
+func (s *ServiceTestSuite) TestCloseStaleStudySessions() {
+	idleForAnHour := mock.MatchedBy(func(idleBefore time.Time) bool {
+		expected := time.Now().Add(-time.Hour)
+		return idleBefore.Sub(expected).Abs() < time.Minute
+	})
+	fullBatch := make([]models.StudySession, staleSessionBatchSize)
+	finished := mock.MatchedBy(func(notification notificationmodels.Notification) bool {
+		return notification.Type == notificationmodels.NotificationTypeFinished
+	})
+	repositoryErr := errors.New("database unavailable")
+
+	tests := map[string]struct {
+		MockSetup      func()
+		ExpectedClosed int
+		ExpectedError  error
+	}{
+		"nothing to close": {
+			MockSetup: func() {
+				s.MockRepository.EXPECT().CloseStaleStudySessions(mock.Anything, idleForAnHour, models.StaleSessionActionFinish, staleSessionBatchSize).Return(nil, nil)
+			},
+		},
+		"closes batches until a partial one": {
+			MockSetup: func() {
+				s.MockRepository.EXPECT().CloseStaleStudySessions(mock.Anything, idleForAnHour, models.StaleSessionActionFinish, staleSessionBatchSize).Return(fullBatch, nil).Once()
+				s.MockRepository.EXPECT().CloseStaleStudySessions(mock.Anything, idleForAnHour, models.StaleSessionActionFinish, staleSessionBatchSize).Return([]models.StudySession{{ID: uuid.New()}}, nil).Once()
+				s.MockNotificationService.EXPECT().PublishNotification(mock.Anything, finished).Return(nil).Times(staleSessionBatchSize + 1)
+			},
+			ExpectedClosed: staleSessionBatchSize + 1,
+		},
+		"fail - repository error keeps the closed count": {
+			MockSetup: func() {
+				s.MockRepository.EXPECT().CloseStaleStudySessions(mock.Anything, idleForAnHour, models.StaleSessionActionFinish, staleSessionBatchSize).Return(fullBatch, nil).Once()
+				s.MockRepository.EXPECT().CloseStaleStudySessions(mock.Anything, idleForAnHour, models.StaleSessionActionFinish, staleSessionBatchSize).Return(nil, repositoryErr).Once()
+				s.MockNotificationService.EXPECT().PublishNotification(mock.Anything, finished).Return(nil).Times(staleSessionBatchSize)
+			},
+			ExpectedClosed: staleSessionBatchSize,
+			ExpectedError:  repositoryErr,
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			tc.MockSetup()
+
+			closed, err := s.Service.CloseStaleStudySessions(context.Background())
+
+			s.Equal(tc.ExpectedClosed, closed)
+			if tc.ExpectedError != nil {
+				s.ErrorIs(err, tc.ExpectedError)
+				return
+			}
+			s.NoError(err)
+		})
+	}
+}
+
+// TestAddStudySessionEvents ...
+func (s *ServiceTestSuite) TestAddStudySessionEvents() {
+	sessionID := uuid.New()
+	start := models.SessionEvent{ID: uuid.New(), EventType: models.EventTypeStart, EventTime: time.Now().Add(-time.Hour)}
+	event := models.SessionEvent{ID: uuid.New(), EventType: models.EventTypePause, EventTime: time.Now(), DeviceID: "phone"}
+	resume := models.SessionEvent{ID: uuid.New(), EventType: models.EventTypeResume, EventTime: time.Now().Add(time.Minute), DeviceID: "phone"}
+
+	tests := map[string]struct {
+		Events         []models.SessionEvent
+		MockSetup      func()
+		ExpectedEvents []models.SessionEvent
+		ExpectedCursor string
+		ExpectedError  bool
+	}{
+		"success": {
+			Events: []models.SessionEvent{event},
+			MockSetup: func() {
+				s.MockRepository.EXPECT().AddActiveStudySessionEvents(mock.Anything, s.User.ID, []models.SessionEvent{event}).Return(&models.SessionEventSync{
+					Events: []models.SessionEvent{start, event},
+					Cursor: models.SyncCursor{SessionID: sessionID, Seq: 3},
+					Added:  []models.SessionEvent{event},
+				}, nil)
+				s.expectNotification(sessionID, notificationmodels.NotificationTypePaused)
+			},
+			ExpectedEvents: []models.SessionEvent{start, event},
+			ExpectedCursor: encodeSyncCursor(models.SyncCursor{SessionID: sessionID, Seq: 3}),
+		},
+		"success - one notification per transition": {
+			Events: []models.SessionEvent{resume, event},
+			MockSetup: func() {
+				s.MockRepository.EXPECT().AddActiveStudySessionEvents(mock.Anything, s.User.ID, []models.SessionEvent{resume, event}).Return(&models.SessionEventSync{
+					Events: []models.SessionEvent{start, event, resume},
+					Cursor: models.SyncCursor{SessionID: sessionID, Seq: 4},
+					Added:  []models.SessionEvent{event, resume},
+				}, nil)
+				paused := s.MockNotificationService.EXPECT().PublishNotification(mock.Anything, notificationmodels.Notification{
+					UserID:    s.User.ID,
+					SessionID: sessionID,
+					Type:      notificationmodels.NotificationTypePaused,
+				}).Return(nil).Once()
+				resumed := s.MockNotificationService.EXPECT().PublishNotification(mock.Anything, notificationmodels.Notification{
+					UserID:    s.User.ID,
+					SessionID: sessionID,
+					Type:      notificationmodels.NotificationTypeResumed,
+				}).Return(nil).Once()
+				mock.InOrder(paused, resumed)
+			},
+			ExpectedEvents: []models.SessionEvent{start, event, resume},
+			ExpectedCursor: encodeSyncCursor(models.SyncCursor{SessionID: sessionID, Seq: 4}),
+		},
+		"success - replays publish nothing": {
+			Events: []models.SessionEvent{event},
+			MockSetup: func() {
+				s.MockRepository.EXPECT().AddActiveStudySessionEvents(mock.Anything, s.User.ID, []models.SessionEvent{event}).Return(&models.SessionEventSync{
+					Events: []models.SessionEvent{start, event},
+					Cursor: models.SyncCursor{SessionID: sessionID, Seq: 3},
+					Added:  []models.SessionEvent{},
+				}, nil)
+			},
+			ExpectedEvents: []models.SessionEvent{start, event},
+			ExpectedCursor: encodeSyncCursor(models.SyncCursor{SessionID: sessionID, Seq: 3}),
+		},
+		"fail - device id too long": {
+			Events: []models.SessionEvent{{
+				EventType: models.EventTypePause,
+				EventTime: time.Now(),
+				DeviceID:  strings.Repeat("d", maxDeviceIDLength+1),
+			}},
+			MockSetup:     func() {},
+			ExpectedError: true,
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			tc.MockSetup()
+
+			result, err := s.Service.AddStudySessionEvents(s.userContext(), AddStudySessionEventsRequest{Events: tc.Events})
+
+			if tc.ExpectedError {
+				var invalidEventError *models.InvalidEventError
+				s.ErrorAs(err, &invalidEventError)
+				return
+			}
+			s.NoError(err)
+			s.Equal(tc.ExpectedEvents, result.Events)
+			s.Equal(tc.ExpectedCursor, result.Cursor)
+		})
+	}
+}
+
+// TestGetActiveStudySessionEvents ...
+func (s *ServiceTestSuite) TestGetActiveStudySessionEvents() {
+	cursor := models.SyncCursor{SessionID: uuid.New(), Seq: 7}
+	sync := &models.SessionEventSync{
+		Events: []models.SessionEvent{},
+		Cursor: cursor,
+	}
+
+	tests := map[string]struct {
+		Cursor        string
+		MockSetup     func()
+		ExpectedError error
+	}{
+		"all events": {
+			MockSetup: func() {
+				s.MockRepository.EXPECT().GetActiveStudySessionEvents(mock.Anything, s.User.ID, (*models.SyncCursor)(nil)).Return(sync, nil)
+			},
+		},
+		"events after the cursor": {
+			Cursor: encodeSyncCursor(cursor),
+			MockSetup: func() {
+				s.MockRepository.EXPECT().GetActiveStudySessionEvents(mock.Anything, s.User.ID, &cursor).Return(sync, nil)
+			},
+		},
+		"fail - invalid cursor": {
+			Cursor:        "not a cursor",
+			MockSetup:     func() {},
+			ExpectedError: models.ErrInvalidSyncCursor,
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			tc.MockSetup()
+
+			result, err := s.Service.GetActiveStudySessionEvents(s.userContext(), GetActiveStudySessionEventsRequest{Cursor: tc.Cursor})
+
+			if tc.ExpectedError != nil {
+				s.ErrorIs(err, tc.ExpectedError)
+				return
+			}
+			s.NoError(err)
+			s.Equal(encodeSyncCursor(cursor), result.Cursor)
+		})
+	}
+}
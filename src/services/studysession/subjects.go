@@ -0,0 +1,89 @@
+package studysession
+
+import (
+	models "go-api/src/models/studysession"
+	"sort"
+
+	"github.com/google/uuid"
+)
+
+// validateSessionSubjects checks that the subjects are unique and that the
+// percentages are either all omitted or all set and adding up to 100
+func validateSessionSubjects(subjects []models.SessionSubject) error {
+	seen := make(map[uuid.UUID]bool, len(subjects))
+	withPercentage, total := 0, 0
+	for _, subject := range subjects {
+		if subject.SubjectID == uuid.Nil || seen[subject.SubjectID] {
+			return models.ErrInvalidSessionSubjects
+		}
+		seen[subject.SubjectID] = true
+		if subject.Percentage != nil {
+			if *subject.Percentage < 1 || *subject.Percentage > 100 {
+				return models.ErrInvalidSessionSubjects
+			}
+			withPercentage++
+			total += *subject.Percentage
+		}
+	}
+	if withPercentage > 0 && (withPercentage != len(subjects) || total != 100) {
+		return models.ErrInvalidSessionSubjects
+	}
+	return nil
+}
+
+func buildSubjectTimeFilter(request GetSubjectTimeTotalsRequest) (models.HistoryFilter, error) {
+	filter := models.HistoryFilter{
+		States: []models.SessionState{models.SessionStateCompleted},
+	}
+	var err error
+	filter.From, filter.To, err = parseDateRange(request.From, request.To)
+	return filter, err
+}
+
+// splitFocusedTime attributes the focused time of every session to its
+// subjects. Sessions without percentages are split evenly, the seconds that
+// can't be split go to the first subject so that no time is lost
+func splitFocusedTime(sessions []models.StudySession) models.SubjectTimeReport {
+	report := models.SubjectTimeReport{Subjects: []models.SubjectTime{}}
+	totals := map[uuid.UUID]*models.SubjectTime{}
+	for _, session := range sessions {
+		focused := session.Durations.FocusedSeconds
+		if len(session.Subjects) == 0 {
+			report.UnassignedSeconds += focused
+			continue
+		}
+
+		shares := make([]int64, len(session.Subjects))
+		var assigned int64
+		for i, subject := range session.Subjects {
+			if subject.Percentage != nil {
+				shares[i] = focused * int64(*subject.Percentage) / 100
+			} else {
+				shares[i] = focused / int64(len(session.Subjects))
+			}
+			assigned += shares[i]
+		}
+		shares[0] += focused - assigned
+
+		for i, subject := range session.Subjects {
+			total, ok := totals[subject.SubjectID]
+			if !ok {
+				total = &models.SubjectTime{SubjectID: subject.SubjectID}
+				totals[subject.SubjectID] = total
+			}
+			total.FocusedSeconds += shares[i]
+			total.SessionCount++
+		}
+	}
+
+	for _, total := range totals {
+		report.Subjects = append(report.Subjects, *total)
+	}
+	sort.Slice(report.Subjects, func(i, j int) bool {
+		if report.Subjects[i].FocusedSeconds != report.Subjects[j].FocusedSeconds {
+			return report.Subjects[i].FocusedSeconds > report.Subjects[j].FocusedSeconds
+		}
+		return report.Subjects[i].SubjectID.String() < report.Subjects[j].SubjectID.String()
+	})
+	return report
+}
@@ -0,0 +1,126 @@
+package studysession
+
+import (
+	models "go-api/src/models/studysession"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func percentage(value int) *int {
+	return &value
+}
+
+func TestValidateSessionSubjects(t *testing.T) {
+	subjectA, subjectB := uuid.New(), uuid.New()
+
+	tests := map[string]struct {
+		Subjects      []models.SessionSubject
+		ExpectedError error
+	}{
+		"no subjects": {},
+		"without percentages": {
+			Subjects: []models.SessionSubject{{SubjectID: subjectA}, {SubjectID: subjectB}},
+		},
+		"percentages adding up to 100": {
+			Subjects: []models.SessionSubject{
+				{SubjectID: subjectA, Percentage: percentage(70)},
+				{SubjectID: subjectB, Percentage: percentage(30)},
+			},
+		},
+		"duplicated subject": {
+			Subjects:      []models.SessionSubject{{SubjectID: subjectA}, {SubjectID: subjectA}},
+			ExpectedError: models.ErrInvalidSessionSubjects,
+		},
+		"missing subject id": {
+			Subjects:      []models.SessionSubject{{}},
+			ExpectedError: models.ErrInvalidSessionSubjects,
+		},
+		"partial percentages": {
+			Subjects: []models.SessionSubject{
+				{SubjectID: subjectA, Percentage: percentage(100)},
+				{SubjectID: subjectB},
+			},
+			ExpectedError: models.ErrInvalidSessionSubjects,
+		},
+		"percentages not adding up to 100": {
+			Subjects: []models.SessionSubject{
+				{SubjectID: subjectA, Percentage: percentage(50)},
+				{SubjectID: subjectB, Percentage: percentage(40)},
+			},
+			ExpectedError: models.ErrInvalidSessionSubjects,
+		},
+		"percentage out of range": {
+			Subjects: []models.SessionSubject{
+				{SubjectID: subjectA, Percentage: percentage(0)},
+				{SubjectID: subjectB, Percentage: percentage(100)},
+			},
+			ExpectedError: models.ErrInvalidSessionSubjects,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.ExpectedError, validateSessionSubjects(tc.Subjects))
+		})
+	}
+}
+
+func TestSplitFocusedTime(t *testing.T) {
+	subjectA, subjectB := uuid.New(), uuid.New()
+	session := func(focusedSeconds int64, subjects ...models.SessionSubject) models.StudySession {
+		return models.StudySession{
+			Durations: models.SessionDurations{FocusedSeconds: focusedSeconds},
+			Subjects:  subjects,
+		}
+	}
+
+	tests := map[string]struct {
+		Sessions       []models.StudySession
+		ExpectedReport models.SubjectTimeReport
+	}{
+		"no sessions": {
+			ExpectedReport: models.SubjectTimeReport{Subjects: []models.SubjectTime{}},
+		},
+		"sessions without subjects are unassigned": {
+			Sessions: []models.StudySession{session(600), session(300, models.SessionSubject{SubjectID: subjectA})},
+			ExpectedReport: models.SubjectTimeReport{
+				Subjects:          []models.SubjectTime{{SubjectID: subjectA, FocusedSeconds: 300, SessionCount: 1}},
+				UnassignedSeconds: 600,
+			},
+		},
+		"even split gives the remainder to the first subject": {
+			Sessions: []models.StudySession{
+				session(101, models.SessionSubject{SubjectID: subjectA}, models.SessionSubject{SubjectID: subjectB}),
+			},
+			ExpectedReport: models.SubjectTimeReport{
+				Subjects: []models.SubjectTime{
+					{SubjectID: subjectA, FocusedSeconds: 51, SessionCount: 1},
+					{SubjectID: subjectB, FocusedSeconds: 50, SessionCount: 1},
+				},
+			},
+		},
+		"percentages accumulate across sessions": {
+			Sessions: []models.StudySession{
+				session(1000,
+					models.SessionSubject{SubjectID: subjectA, Percentage: percentage(25)},
+					models.SessionSubject{SubjectID: subjectB, Percentage: percentage(75)},
+				),
+				session(200, models.SessionSubject{SubjectID: subjectA}),
+			},
+			ExpectedReport: models.SubjectTimeReport{
+				Subjects: []models.SubjectTime{
+					{SubjectID: subjectB, FocusedSeconds: 750, SessionCount: 1},
+					{SubjectID: subjectA, FocusedSeconds: 450, SessionCount: 2},
+				},
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.ExpectedReport, splitFocusedTime(tc.Sessions))
+		})
+	}
+}
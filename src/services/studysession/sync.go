@@ -0,0 +1,48 @@
+package studysession
+
+import (
+	"encoding/base64"
+	"fmt"
+	models "go-api/src/models/studysession"
+	"strconv"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// maxDeviceIDLength matches the size of session_events.device_id
+const maxDeviceIDLength = 100
+
+func newSessionEventsResponse(sync *models.SessionEventSync) *SessionEventsResponse {
+	return &SessionEventsResponse{
+		Events: sync.Events,
+		Cursor: encodeSyncCursor(sync.Cursor),
+	}
+}
+
+// encodeSyncCursor returns an opaque token pointing after the last event a
+// client received
+func encodeSyncCursor(cursor models.SyncCursor) string {
+	raw := cursor.SessionID.String() + "|" + strconv.FormatInt(cursor.Seq, 10)
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+func decodeSyncCursor(token string) (*models.SyncCursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode cursor: %w", err)
+	}
+	id, seq, found := strings.Cut(string(raw), "|")
+	if !found {
+		return nil, fmt.Errorf("malformed cursor")
+	}
+	sessionID, err := uuid.Parse(id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse cursor session id: %w", err)
+	}
+	cursorSeq, err := strconv.ParseInt(seq, 10, 64)
+	if err != nil || cursorSeq < 0 {
+		return nil, fmt.Errorf("failed to parse cursor sequence")
+	}
+	return &models.SyncCursor{SessionID: sessionID, Seq: cursorSeq}, nil
+}
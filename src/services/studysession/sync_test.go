@@ -0,0 +1,40 @@
+package studysession
+
+import (
+	"encoding/base64"
+	models "go-api/src/models/studysession"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSyncCursor(t *testing.T) {
+	cursor := models.SyncCursor{SessionID: uuid.New(), Seq: 42}
+
+	decoded, err := decodeSyncCursor(encodeSyncCursor(cursor))
+
+	assert.NoError(t, err)
+	assert.Equal(t, &cursor, decoded)
+}
+
+func TestDecodeSyncCursorErrors(t *testing.T) {
+	encode := func(raw string) string {
+		return base64.RawURLEncoding.EncodeToString([]byte(raw))
+	}
+
+	tests := map[string]string{
+		"not base64":        "not a cursor!",
+		"missing separator": encode(uuid.NewString()),
+		"invalid session":   encode("session|3"),
+		"invalid sequence":  encode(uuid.NewString() + "|three"),
+		"negative sequence": encode(uuid.NewString() + "|-1"),
+	}
+
+	for name, token := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := decodeSyncCursor(token)
+			assert.Error(t, err)
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package studysession
 
 import (
+	statsmodels "go-api/src/models/stats"
 	models "go-api/src/models/studysession"
 	"time"
 )
@@ -9,12 +10,98 @@ type UpsertActiveStudySessionRequest struct {
 	StartedAt time.Time `json:"started_at"`
 	Title     string    `json:"title"`
 	Notes     string    `json:"notes"`
+	// Subjects optionally links the session to the user's subjects
+	Subjects []models.SessionSubject `json:"subjects"`
+	// Pomodoro turns on pomodoro mode, the server then tracks the work and
+	// break phases of the session
+	Pomodoro *models.PomodoroSettings `json:"pomodoro"`
+}
+
+// PauseInterval is a pause of a session logged after the fact
+type PauseInterval struct {
+	Start time.Time `json:"start"`
+	End   time.Time `json:"end"`
+}
+
+type CreateManualStudySessionRequest struct {
+	StartedAt time.Time       `json:"started_at"`
+	StoppedAt time.Time       `json:"stopped_at"`
+	Pauses    []PauseInterval `json:"pauses"`
+	Title     string          `json:"title"`
+	Notes     string          `json:"notes"`
+	// Subjects optionally links the session to the user's subjects
+	Subjects []models.SessionSubject `json:"subjects"`
+}
+
+type UpdateStudySessionRequest struct {
+	Title *string `json:"title"`
+	Notes *string `json:"notes"`
+	// Subjects replaces the session subjects when present
+	Subjects *[]models.SessionSubject `json:"subjects"`
+	// IfUpdatedAt comes from the If-Match header, not from the body
+	IfUpdatedAt *time.Time `json:"-" swaggerignore:"true"`
 }
 
 type AddStudySessionEventsRequest struct {
 	Events []models.SessionEvent `json:"events"`
 }
 
+type GetActiveStudySessionEventsRequest struct {
+	// Cursor returned by a previous sync, only the events recorded
+	// afterwards are returned
+	Cursor string `query:"cursor"`
+}
+
+// SessionEventsResponse lists events of the active session, Cursor is sent
+// back on the next sync to only receive the events recorded in between
+type SessionEventsResponse struct {
+	Events []models.SessionEvent
+	Cursor string
+}
+
 type FinishStudySessionRequest struct {
+	// FinishedAt defaults to the current time
 	FinishedAt time.Time `json:"finished_at"`
+	// FinalState "paused" stops a paused session at the time it was paused,
+	// it can't be combined with FinishedAt
+	FinalState models.TimerStatus `json:"final_state"`
+}
+
+// FinishStudySessionResponse is the finished session along with the change it
+// made to the user's streak, the streak is omitted when it couldn't be computed
+type FinishStudySessionResponse struct {
+	models.StudySession
+	Streak *statsmodels.StreakUpdate `json:"streak,omitempty"`
+}
+
+type GetStudySessionHistoryRequest struct {
+	From       string   `query:"from"`
+	To         string   `query:"to"`
+	States     []string `query:"state"`
+	Title      string   `query:"title"`
+	AutoClosed bool     `query:"auto_closed"`
+	Cursor     string   `query:"cursor"`
+	Limit      int      `query:"limit"`
+}
+
+type GetSubjectTimeTotalsRequest struct {
+	From string `query:"from"`
+	To   string `query:"to"`
+}
+
+type ExportStudySessionsRequest struct {
+	From string `query:"from"`
+	To   string `query:"to"`
+}
+
+type ExportStudySessionDataRequest struct {
+	Format string `query:"format"`
+}
+
+type ImportStudySessionsRequest struct {
+	// Mapping is the column layout of the file: generic, toggl or clockify
+	Mapping string `query:"mapping"`
+	// Timezone is used for the times without a UTC offset
+	Timezone string `query:"tz"`
+	DryRun   bool   `query:"dry_run"`
 }
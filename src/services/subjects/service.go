@@ -0,0 +1,201 @@
+package subjects
+
+import (
+	"context"
+	"fmt"
+	authmodel "go-api/src/models/auth"
+	"go-api/src/models/constants"
+	models "go-api/src/models/subjects"
+	repository "go-api/src/repositories/subjects"
+
+	"github.com/google/uuid"
+	"go.uber.org/fx"
+	"go.uber.org/zap"
+)
+
+type SubjectService interface {
+	CreateSubject(ctx context.Context, request CreateSubjectRequest) (*models.Subject, error)
+	ListSubjects(ctx context.Context, request ListSubjectsRequest) ([]models.Subject, error)
+	GetSubject(ctx context.Context, subjectID uuid.UUID) (*models.Subject, error)
+	UpdateSubject(ctx context.Context, subjectID uuid.UUID, request UpdateSubjectRequest) (*models.Subject, error)
+	ArchiveSubject(ctx context.Context, subjectID uuid.UUID) (*models.Subject, error)
+	UnarchiveSubject(ctx context.Context, subjectID uuid.UUID) (*models.Subject, error)
+	CreateRelation(ctx context.Context, request CreateRelationRequest) (*models.SubjectRelation, error)
+	ListRelations(ctx context.Context) ([]models.SubjectRelation, error)
+	DeleteRelation(ctx context.Context, relationID uuid.UUID) error
+	GetSubjectTree(ctx context.Context) ([]models.SubjectNode, error)
+	GetStudyOrder(ctx context.Context) ([]models.Subject, error)
+}
+
+type subjectService struct {
+	repository repository.SubjectRepository
+	logger     *zap.Logger
+}
+
+type SubjectServiceParams struct {
+	fx.In
+
+	Repository repository.SubjectRepository
+	Logger     *zap.Logger
+}
+
+func NewSubjectService(p SubjectServiceParams) SubjectService {
+	return &subjectService{
+		repository: p.Repository,
+		logger:     p.Logger,
+	}
+}
+
+func (s subjectService) CreateSubject(ctx context.Context, request CreateSubjectRequest) (*models.Subject, error) {
+	user := ctx.Value(constants.ContextKeyUserInfoKey).(*authmodel.UserInfo)
+	if user == nil {
+		s.logger.Error("Failed to create subject, no user found in context")
+		return nil, fmt.Errorf("no user found in context")
+	}
+	name, err := normalizeName(request.Name)
+	if err != nil {
+		return nil, err
+	}
+	color, err := normalizeColor(request.Color)
+	if err != nil {
+		return nil, err
+	}
+	icon, err := normalizeIcon(request.Icon)
+	if err != nil {
+		return nil, err
+	}
+	return s.repository.CreateSubject(ctx, models.Subject{
+		UserID: user.ID,
+		Name:   name,
+		Color:  color,
+		Icon:   icon,
+	})
+}
+
+func (s subjectService) ListSubjects(ctx context.Context, request ListSubjectsRequest) ([]models.Subject, error) {
+	user := ctx.Value(constants.ContextKeyUserInfoKey).(*authmodel.UserInfo)
+	if user == nil {
+		s.logger.Error("Failed to list subjects, no user found in context")
+		return nil, fmt.Errorf("no user found in context")
+	}
+	return s.repository.ListSubjects(ctx, user.ID, request.IncludeArchived)
+}
+
+func (s subjectService) GetSubject(ctx context.Context, subjectID uuid.UUID) (*models.Subject, error) {
+	user := ctx.Value(constants.ContextKeyUserInfoKey).(*authmodel.UserInfo)
+	if user == nil {
+		s.logger.Error("Failed to get subject, no user found in context")
+		return nil, fmt.Errorf("no user found in context")
+	}
+	return s.repository.GetSubject(ctx, user.ID, subjectID)
+}
+
+func (s subjectService) UpdateSubject(ctx context.Context, subjectID uuid.UUID, request UpdateSubjectRequest) (*models.Subject, error) {
+	user := ctx.Value(constants.ContextKeyUserInfoKey).(*authmodel.UserInfo)
+	if user == nil {
+		s.logger.Error("Failed to update subject, no user found in context")
+		return nil, fmt.Errorf("no user found in context")
+	}
+	update, err := buildSubjectUpdate(request)
+	if err != nil {
+		return nil, err
+	}
+	return s.repository.UpdateSubject(ctx, user.ID, subjectID, update)
+}
+
+func (s subjectService) ArchiveSubject(ctx context.Context, subjectID uuid.UUID) (*models.Subject, error) {
+	user := ctx.Value(constants.ContextKeyUserInfoKey).(*authmodel.UserInfo)
+	if user == nil {
+		s.logger.Error("Failed to archive subject, no user found in context")
+		return nil, fmt.Errorf("no user found in context")
+	}
+	return s.repository.SetSubjectArchived(ctx, user.ID, subjectID, true)
+}
+
+func (s subjectService) UnarchiveSubject(ctx context.Context, subjectID uuid.UUID) (*models.Subject, error) {
+	user := ctx.Value(constants.ContextKeyUserInfoKey).(*authmodel.UserInfo)
+	if user == nil {
+		s.logger.Error("Failed to unarchive subject, no user found in context")
+		return nil, fmt.Errorf("no user found in context")
+	}
+	return s.repository.SetSubjectArchived(ctx, user.ID, subjectID, false)
+}
+
+func (s subjectService) CreateRelation(ctx context.Context, request CreateRelationRequest) (*models.SubjectRelation, error) {
+	user := ctx.Value(constants.ContextKeyUserInfoKey).(*authmodel.UserInfo)
+	if user == nil {
+		s.logger.Error("Failed to create subject relation, no user found in context")
+		return nil, fmt.Errorf("no user found in context")
+	}
+	switch request.RelationType {
+	case models.RelationTypeParent, models.RelationTypePrerequisite:
+	default:
+		return nil, models.ErrInvalidRelation
+	}
+	if request.FromSubjectID == uuid.Nil || request.ToSubjectID == uuid.Nil {
+		return nil, models.ErrInvalidRelation
+	}
+	if request.FromSubjectID == request.ToSubjectID {
+		return nil, models.ErrRelationCycle
+	}
+	return s.repository.CreateRelation(ctx, models.SubjectRelation{
+		UserID:        user.ID,
+		FromSubjectID: request.FromSubjectID,
+		ToSubjectID:   request.ToSubjectID,
+		RelationType:  request.RelationType,
+	})
+}
+
+func (s subjectService) ListRelations(ctx context.Context) ([]models.SubjectRelation, error) {
+	user := ctx.Value(constants.ContextKeyUserInfoKey).(*authmodel.UserInfo)
+	if user == nil {
+		s.logger.Error("Failed to list subject relations, no user found in context")
+		return nil, fmt.Errorf("no user found in context")
+	}
+	return s.repository.ListRelations(ctx, user.ID)
+}
+
+func (s subjectService) DeleteRelation(ctx context.Context, relationID uuid.UUID) error {
+	user := ctx.Value(constants.ContextKeyUserInfoKey).(*authmodel.UserInfo)
+	if user == nil {
+		s.logger.Error("Failed to delete subject relation, no user found in context")
+		return fmt.Errorf("no user found in context")
+	}
+	return s.repository.DeleteRelation(ctx, user.ID, relationID)
+}
+
+// GetSubjectTree returns the active subjects nested by their parent relations
+func (s subjectService) GetSubjectTree(ctx context.Context) ([]models.SubjectNode, error) {
+	user := ctx.Value(constants.ContextKeyUserInfoKey).(*authmodel.UserInfo)
+	if user == nil {
+		s.logger.Error("Failed to get subject tree, no user found in context")
+		return nil, fmt.Errorf("no user found in context")
+	}
+	subjects, err := s.repository.ListSubjects(ctx, user.ID, false)
+	if err != nil {
+		return nil, err
+	}
+	relations, err := s.repository.ListRelations(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+	return models.BuildSubjectTree(subjects, relations), nil
+}
+
+// GetStudyOrder returns the active subjects sorted so that prerequisites come first
+func (s subjectService) GetStudyOrder(ctx context.Context) ([]models.Subject, error) {
+	user := ctx.Value(constants.ContextKeyUserInfoKey).(*authmodel.UserInfo)
+	if user == nil {
+		s.logger.Error("Failed to get study order, no user found in context")
+		return nil, fmt.Errorf("no user found in context")
+	}
+	subjects, err := s.repository.ListSubjects(ctx, user.ID, false)
+	if err != nil {
+		return nil, err
+	}
+	relations, err := s.repository.ListRelations(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+	return models.StudyOrder(subjects, relations)
+}
@@ -0,0 +1,105 @@
+package subjects
+
+import (
+	"context"
+	mockrepository "go-api/.internal/mocks/src/repositories/subjects"
+	authmodel "go-api/src/models/auth"
+	"go-api/src/models/constants"
+	models "go-api/src/models/subjects"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/zap/zaptest"
+)
+
+// ServiceTestSuite ...
+type ServiceTestSuite struct {
+	suite.Suite
+
+	MockRepository *mockrepository.SubjectRepository
+
+	User    *authmodel.UserInfo
+	Service SubjectService
+}
+
+// SetupTest ...
+func (s *ServiceTestSuite) SetupTest() {
+	t := s.T()
+	s.MockRepository = mockrepository.NewSubjectRepository(t)
+	s.User = &authmodel.UserInfo{ID: uuid.New()}
+	s.Service = NewSubjectService(SubjectServiceParams{
+		Repository: s.MockRepository,
+		Logger:     zaptest.NewLogger(t),
+	})
+}
+
+// SetupSubTest ...
+func (s *ServiceTestSuite) SetupSubTest() {
+	s.SetupTest() // Clean up the mocks
+}
+
+// TestServiceTestSuite ...
+func TestServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(ServiceTestSuite))
+}
+
+func (s *ServiceTestSuite) userContext() context.Context {
+	return context.WithValue(context.Background(), constants.ContextKeyUserInfoKey, s.User)
+}
+
+// TestCreateSubject ...
+func (s *ServiceTestSuite) TestCreateSubject() {
+	tests := map[string]struct {
+		Request         CreateSubjectRequest
+		ExpectedSubject *models.Subject
+		RepositoryError error
+		ExpectedError   error
+	}{
+		"success": {
+			Request:         CreateSubjectRequest{Name: "  Calculus ", Color: "#FFAA00", Icon: "book"},
+			ExpectedSubject: &models.Subject{Name: "Calculus", Color: "#ffaa00", Icon: "book"},
+		},
+		"without color and icon": {
+			Request:         CreateSubjectRequest{Name: "History"},
+			ExpectedSubject: &models.Subject{Name: "History"},
+		},
+		"fail - name already in use": {
+			Request:         CreateSubjectRequest{Name: "History"},
+			ExpectedSubject: &models.Subject{Name: "History"},
+			RepositoryError: models.ErrSubjectNameTaken,
+			ExpectedError:   models.ErrSubjectNameTaken,
+		},
+		"fail - empty name": {
+			Request:       CreateSubjectRequest{Name: "   "},
+			ExpectedError: models.ErrInvalidSubject,
+		},
+		"fail - invalid color": {
+			Request:       CreateSubjectRequest{Name: "History", Color: "red"},
+			ExpectedError: models.ErrInvalidSubject,
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			if tc.ExpectedSubject != nil {
+				tc.ExpectedSubject.UserID = s.User.ID
+				var created *models.Subject
+				if tc.RepositoryError == nil {
+					created = tc.ExpectedSubject
+				}
+				s.MockRepository.EXPECT().CreateSubject(mock.Anything, *tc.ExpectedSubject).Return(created, tc.RepositoryError)
+			}
+
+			subject, err := s.Service.CreateSubject(s.userContext(), tc.Request)
+
+			if tc.ExpectedError != nil {
+				s.ErrorIs(err, tc.ExpectedError)
+				return
+			}
+			s.NoError(err)
+			s.Equal(tc.ExpectedSubject, subject)
+		})
+	}
+}
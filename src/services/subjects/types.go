@@ -0,0 +1,29 @@
+package subjects
+
+import (
+	models "go-api/src/models/subjects"
+
+	"github.com/google/uuid"
+)
+
+type CreateSubjectRequest struct {
+	Name  string `json:"name"`
+	Color string `json:"color"`
+	Icon  string `json:"icon"`
+}
+
+type UpdateSubjectRequest struct {
+	Name  *string `json:"name"`
+	Color *string `json:"color"`
+	Icon  *string `json:"icon"`
+}
+
+type ListSubjectsRequest struct {
+	IncludeArchived bool `query:"include_archived"`
+}
+
+type CreateRelationRequest struct {
+	FromSubjectID uuid.UUID           `json:"from_subject_id"`
+	ToSubjectID   uuid.UUID           `json:"to_subject_id"`
+	RelationType  models.RelationType `json:"relation_type"`
+}
@@ -0,0 +1,70 @@
+package subjects
+
+import (
+	models "go-api/src/models/subjects"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+)
+
+const (
+	// maxNameLength and maxIconLength match the size of the subjects columns
+	maxNameLength = 100
+	maxIconLength = 50
+)
+
+var colorPattern = regexp.MustCompile(`^#[0-9a-f]{6}$`)
+
+func normalizeName(name string) (string, error) {
+	name = strings.TrimSpace(name)
+	if name == "" || utf8.RuneCountInString(name) > maxNameLength {
+		return "", models.ErrInvalidSubject
+	}
+	return name, nil
+}
+
+// normalizeColor accepts an empty color or a #rrggbb hex color
+func normalizeColor(color string) (string, error) {
+	color = strings.ToLower(strings.TrimSpace(color))
+	if color != "" && !colorPattern.MatchString(color) {
+		return "", models.ErrInvalidSubject
+	}
+	return color, nil
+}
+
+func normalizeIcon(icon string) (string, error) {
+	icon = strings.TrimSpace(icon)
+	if utf8.RuneCountInString(icon) > maxIconLength {
+		return "", models.ErrInvalidSubject
+	}
+	return icon, nil
+}
+
+func buildSubjectUpdate(request UpdateSubjectRequest) (models.SubjectUpdate, error) {
+	var update models.SubjectUpdate
+	if request.Name == nil && request.Color == nil && request.Icon == nil {
+		return update, models.ErrInvalidSubject
+	}
+	if request.Name != nil {
+		name, err := normalizeName(*request.Name)
+		if err != nil {
+			return update, err
+		}
+		update.Name = &name
+	}
+	if request.Color != nil {
+		color, err := normalizeColor(*request.Color)
+		if err != nil {
+			return update, err
+		}
+		update.Color = &color
+	}
+	if request.Icon != nil {
+		icon, err := normalizeIcon(*request.Icon)
+		if err != nil {
+			return update, err
+		}
+		update.Icon = &icon
+	}
+	return update, nil
+}
@@ -0,0 +1,93 @@
+package webhooks
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"go-api/src/clients/webhook"
+	models "go-api/src/models/webhooks"
+	"net/http"
+	"time"
+)
+
+const (
+	// headerWebhookID is the id of the event, it's the same for every
+	// attempt and redelivery so receivers can drop duplicates
+	headerWebhookID        = "X-Webhook-Id"
+	headerWebhookDelivery  = "X-Webhook-Delivery"
+	headerWebhookEvent     = "X-Webhook-Event"
+	headerWebhookSignature = "X-Webhook-Signature"
+
+	maxRetryDelay = 6 * time.Hour
+
+	// sendFailedError is stored when no response was received, the transport
+	// error would tell users about the internal network
+	sendFailedError = "no response received"
+)
+
+// signPayload signs "<timestamp>.<payload>" with HMAC-SHA256. Receivers
+// compute the signature again with their secret and reject old timestamps,
+// so a captured request can't be replayed later.
+func signPayload(secret string, timestamp time.Time, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	fmt.Fprintf(mac, "%d.", timestamp.Unix())
+	mac.Write(payload)
+	return fmt.Sprintf("t=%d,v1=%s", timestamp.Unix(), hex.EncodeToString(mac.Sum(nil)))
+}
+
+// newSendWebhookRequest builds the signed request of a delivery attempt
+func newSendWebhookRequest(delivery models.PendingDelivery, timestamp time.Time) webhook.SendWebhookRequest {
+	header := http.Header{}
+	header.Set(headerWebhookID, delivery.EventID.String())
+	header.Set(headerWebhookDelivery, delivery.ID.String())
+	header.Set(headerWebhookEvent, string(delivery.EventType))
+	header.Set(headerWebhookSignature, signPayload(delivery.Secret, timestamp, delivery.Payload))
+	return webhook.SendWebhookRequest{
+		URL:    delivery.URL,
+		Header: header,
+		Body:   delivery.Payload,
+	}
+}
+
+// retryDelay doubles the base delay after each failed attempt, up to
+// maxRetryDelay
+func retryDelay(base time.Duration, attempts int) time.Duration {
+	delay := base
+	for i := 1; i < attempts && delay < maxRetryDelay; i++ {
+		delay *= 2
+	}
+	return min(delay, maxRetryDelay)
+}
+
+// newDeliveryResult succeeds on a 2xx response. Other responses and
+// transport errors are retried until the attempts run out.
+func newDeliveryResult(delivery models.PendingDelivery, response *webhook.SendWebhookResponse, sendErr error, attemptedAt time.Time, maxAttempts int, retryBase time.Duration) models.DeliveryResult {
+	result := models.DeliveryResult{
+		DeliveryID:  delivery.ID,
+		Status:      models.DeliveryStatusSucceeded,
+		AttemptedAt: attemptedAt,
+	}
+	if response != nil {
+		result.ResponseCode = &response.StatusCode
+	}
+	switch {
+	case sendErr != nil:
+		message := sendFailedError
+		result.Error = &message
+	case response.StatusCode >= 200 && response.StatusCode < 300:
+		return result
+	default:
+		message := fmt.Sprintf("unexpected status %d", response.StatusCode)
+		result.Error = &message
+	}
+
+	if delivery.Attempts >= maxAttempts {
+		result.Status = models.DeliveryStatusFailed
+		return result
+	}
+	result.Status = models.DeliveryStatusPending
+	nextAttemptAt := attemptedAt.Add(retryDelay(retryBase, delivery.Attempts))
+	result.NextAttemptAt = &nextAttemptAt
+	return result
+}
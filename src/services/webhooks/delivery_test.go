@@ -0,0 +1,33 @@
+package webhooks
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSignPayload(t *testing.T) {
+	timestamp := time.Unix(1735725600, 0)
+	payload := []byte(`{"id":"1"}`)
+
+	mac := hmac.New(sha256.New, []byte("whsec_test"))
+	mac.Write([]byte("1735725600." + string(payload)))
+	expected := "t=1735725600,v1=" + hex.EncodeToString(mac.Sum(nil))
+
+	assert.Equal(t, expected, signPayload("whsec_test", timestamp, payload))
+	assert.NotEqual(t, expected, signPayload("whsec_other", timestamp, payload))
+	assert.NotEqual(t, expected, signPayload("whsec_test", timestamp.Add(time.Second), payload))
+}
+
+func TestRetryDelay(t *testing.T) {
+	base := 30 * time.Second
+	assert.Equal(t, 30*time.Second, retryDelay(base, 1))
+	assert.Equal(t, time.Minute, retryDelay(base, 2))
+	assert.Equal(t, 4*time.Minute, retryDelay(base, 4))
+	assert.Equal(t, maxRetryDelay, retryDelay(base, 20))
+	assert.Equal(t, maxRetryDelay, retryDelay(base, 1000))
+}
@@ -0,0 +1,89 @@
+package webhooks
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"go-api/src/clients/webhook"
+	models "go-api/src/models/webhooks"
+	"net/netip"
+	"net/url"
+	"strings"
+	"unicode/utf8"
+)
+
+const (
+	// secretBytes gives 256 bits of entropy
+	secretBytes            = 32
+	secretPrefix           = "whsec_"
+	maxEndpointURL         = 2048
+	maxEndpointDescription = 200
+)
+
+func generateSecret() (string, error) {
+	raw := make([]byte, secretBytes)
+	if _, err := rand.Read(raw); err != nil {
+		return "", fmt.Errorf("failed to generate webhook secret: %w", err)
+	}
+	return secretPrefix + base64.RawURLEncoding.EncodeToString(raw), nil
+}
+
+// normalizeEndpointURL only accepts absolute https URLs, or http ones when
+// allowed. Hosts of the internal network are rejected early, the client
+// checks the resolved addresses again when delivering.
+func normalizeEndpointURL(rawURL string, allowHTTP bool) (string, error) {
+	rawURL = strings.TrimSpace(rawURL)
+	if rawURL == "" || len(rawURL) > maxEndpointURL {
+		return "", models.ErrInvalidEndpoint
+	}
+	parsed, err := url.Parse(rawURL)
+	if err != nil || parsed.Host == "" || parsed.User != nil || parsed.Fragment != "" {
+		return "", models.ErrInvalidEndpoint
+	}
+	switch parsed.Scheme {
+	case "https":
+	case "http":
+		if !allowHTTP {
+			return "", models.ErrInvalidEndpoint
+		}
+	default:
+		return "", models.ErrInvalidEndpoint
+	}
+	host := parsed.Hostname()
+	if strings.EqualFold(host, "localhost") || strings.HasSuffix(strings.ToLower(host), ".localhost") {
+		return "", models.ErrInvalidEndpoint
+	}
+	if ip, err := netip.ParseAddr(host); err == nil && !webhook.IsPublicAddress(ip) {
+		return "", models.ErrInvalidEndpoint
+	}
+	return parsed.String(), nil
+}
+
+func normalizeEndpointDescription(description string) (string, error) {
+	description = strings.TrimSpace(description)
+	if utf8.RuneCountInString(description) > maxEndpointDescription {
+		return "", models.ErrInvalidEndpoint
+	}
+	return description, nil
+}
+
+// normalizeEventTypes drops the duplicated types, an endpoint subscribes to
+// at least one
+func normalizeEventTypes(eventTypes []models.EventType) ([]models.EventType, error) {
+	if len(eventTypes) == 0 {
+		return nil, models.ErrInvalidEventTypes
+	}
+	seen := make(map[models.EventType]bool, len(eventTypes))
+	normalized := make([]models.EventType, 0, len(eventTypes))
+	for _, eventType := range eventTypes {
+		if !eventType.IsValid() {
+			return nil, models.ErrInvalidEventTypes
+		}
+		if seen[eventType] {
+			continue
+		}
+		seen[eventType] = true
+		normalized = append(normalized, eventType)
+	}
+	return normalized, nil
+}
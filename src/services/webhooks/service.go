@@ -0,0 +1,249 @@
+package webhooks
+
+import (
+	"context"
+	"fmt"
+	"go-api/src/clients/webhook"
+	"go-api/src/config"
+	authmodel "go-api/src/models/auth"
+	"go-api/src/models/constants"
+	models "go-api/src/models/webhooks"
+	repository "go-api/src/repositories/webhooks"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/fx"
+	"go.uber.org/zap"
+)
+
+const (
+	// maxEndpoints limits the endpoints a user can register
+	maxEndpoints          = 10
+	defaultDeliveryLimit  = 50
+	maxDeliveryLimit      = 100
+	deliveryLeaseOverhead = 30 * time.Second
+)
+
+type WebhookService interface {
+	CreateEndpoint(ctx context.Context, request CreateEndpointRequest) (*models.CreatedEndpoint, error)
+	ListEndpoints(ctx context.Context) ([]models.Endpoint, error)
+	GetEndpoint(ctx context.Context, endpointID uuid.UUID) (*models.Endpoint, error)
+	UpdateEndpoint(ctx context.Context, endpointID uuid.UUID, request UpdateEndpointRequest) (*models.Endpoint, error)
+	DeleteEndpoint(ctx context.Context, endpointID uuid.UUID) error
+	ListDeliveries(ctx context.Context, endpointID uuid.UUID, request ListDeliveriesRequest) ([]models.Delivery, error)
+	Redeliver(ctx context.Context, endpointID uuid.UUID, deliveryID uuid.UUID) (*models.Delivery, error)
+	DeliverDueWebhooks(ctx context.Context) (int, error)
+	PurgeEvents(ctx context.Context) (int64, error)
+}
+
+type webhookService struct {
+	config     *config.Config
+	repository repository.WebhookRepository
+	client     webhook.WebhookClient
+	logger     *zap.Logger
+}
+
+type WebhookServiceParams struct {
+	fx.In
+
+	Config     *config.Config
+	Repository repository.WebhookRepository
+	Client     webhook.WebhookClient
+	Logger     *zap.Logger
+}
+
+func NewWebhookService(p WebhookServiceParams) WebhookService {
+	return &webhookService{
+		config:     p.Config,
+		repository: p.Repository,
+		client:     p.Client,
+		logger:     p.Logger,
+	}
+}
+
+func (s webhookService) CreateEndpoint(ctx context.Context, request CreateEndpointRequest) (*models.CreatedEndpoint, error) {
+	user := ctx.Value(constants.ContextKeyUserInfoKey).(*authmodel.UserInfo)
+	if user == nil {
+		s.logger.Error("Failed to create webhook endpoint, no user found in context")
+		return nil, fmt.Errorf("no user found in context")
+	}
+	endpointURL, err := normalizeEndpointURL(request.URL, s.config.WebhookAllowHTTP)
+	if err != nil {
+		return nil, err
+	}
+	description, err := normalizeEndpointDescription(request.Description)
+	if err != nil {
+		return nil, err
+	}
+	eventTypes, err := normalizeEventTypes(request.EventTypes)
+	if err != nil {
+		return nil, err
+	}
+	secret, err := generateSecret()
+	if err != nil {
+		return nil, err
+	}
+
+	endpoint, err := s.repository.CreateEndpoint(ctx, models.Endpoint{
+		UserID:      user.ID,
+		URL:         endpointURL,
+		Description: description,
+		EventTypes:  eventTypes,
+		Active:      true,
+	}, secret, maxEndpoints)
+	if err != nil {
+		return nil, err
+	}
+	return &models.CreatedEndpoint{
+		Endpoint: *endpoint,
+		Secret:   secret,
+	}, nil
+}
+
+func (s webhookService) ListEndpoints(ctx context.Context) ([]models.Endpoint, error) {
+	user := ctx.Value(constants.ContextKeyUserInfoKey).(*authmodel.UserInfo)
+	if user == nil {
+		s.logger.Error("Failed to list webhook endpoints, no user found in context")
+		return nil, fmt.Errorf("no user found in context")
+	}
+	return s.repository.ListEndpoints(ctx, user.ID)
+}
+
+func (s webhookService) GetEndpoint(ctx context.Context, endpointID uuid.UUID) (*models.Endpoint, error) {
+	user := ctx.Value(constants.ContextKeyUserInfoKey).(*authmodel.UserInfo)
+	if user == nil {
+		s.logger.Error("Failed to get webhook endpoint, no user found in context")
+		return nil, fmt.Errorf("no user found in context")
+	}
+	return s.repository.GetEndpoint(ctx, user.ID, endpointID)
+}
+
+func (s webhookService) UpdateEndpoint(ctx context.Context, endpointID uuid.UUID, request UpdateEndpointRequest) (*models.Endpoint, error) {
+	user := ctx.Value(constants.ContextKeyUserInfoKey).(*authmodel.UserInfo)
+	if user == nil {
+		s.logger.Error("Failed to update webhook endpoint, no user found in context")
+		return nil, fmt.Errorf("no user found in context")
+	}
+	if request.URL == nil && request.Description == nil && request.EventTypes == nil && request.Active == nil {
+		return nil, models.ErrInvalidEndpoint
+	}
+
+	update := models.EndpointUpdate{Active: request.Active}
+	if request.URL != nil {
+		endpointURL, err := normalizeEndpointURL(*request.URL, s.config.WebhookAllowHTTP)
+		if err != nil {
+			return nil, err
+		}
+		update.URL = &endpointURL
+	}
+	if request.Description != nil {
+		description, err := normalizeEndpointDescription(*request.Description)
+		if err != nil {
+			return nil, err
+		}
+		update.Description = &description
+	}
+	if request.EventTypes != nil {
+		eventTypes, err := normalizeEventTypes(*request.EventTypes)
+		if err != nil {
+			return nil, err
+		}
+		update.EventTypes = eventTypes
+	}
+	return s.repository.UpdateEndpoint(ctx, user.ID, endpointID, update)
+}
+
+func (s webhookService) DeleteEndpoint(ctx context.Context, endpointID uuid.UUID) error {
+	user := ctx.Value(constants.ContextKeyUserInfoKey).(*authmodel.UserInfo)
+	if user == nil {
+		s.logger.Error("Failed to delete webhook endpoint, no user found in context")
+		return fmt.Errorf("no user found in context")
+	}
+	return s.repository.DeleteEndpoint(ctx, user.ID, endpointID)
+}
+
+func (s webhookService) ListDeliveries(ctx context.Context, endpointID uuid.UUID, request ListDeliveriesRequest) ([]models.Delivery, error) {
+	user := ctx.Value(constants.ContextKeyUserInfoKey).(*authmodel.UserInfo)
+	if user == nil {
+		s.logger.Error("Failed to list webhook deliveries, no user found in context")
+		return nil, fmt.Errorf("no user found in context")
+	}
+	limit := request.Limit
+	switch {
+	case limit < 0:
+		return nil, models.ErrInvalidDeliveryLimit
+	case limit == 0:
+		limit = defaultDeliveryLimit
+	case limit > maxDeliveryLimit:
+		limit = maxDeliveryLimit
+	}
+	return s.repository.ListDeliveries(ctx, user.ID, endpointID, limit)
+}
+
+// Redeliver sends the event of a finished delivery again, with a new
+// delivery that starts over with its attempts
+func (s webhookService) Redeliver(ctx context.Context, endpointID uuid.UUID, deliveryID uuid.UUID) (*models.Delivery, error) {
+	user := ctx.Value(constants.ContextKeyUserInfoKey).(*authmodel.UserInfo)
+	if user == nil {
+		s.logger.Error("Failed to redeliver webhook, no user found in context")
+		return nil, fmt.Errorf("no user found in context")
+	}
+	return s.repository.Redeliver(ctx, user.ID, endpointID, deliveryID)
+}
+
+// DeliverDueWebhooks attempts a batch of due deliveries concurrently and
+// records their results, it returns the number of attempts made. The
+// deliveries are leased for longer than an attempt can take so that other
+// replicas don't attempt them at the same time.
+func (s webhookService) DeliverDueWebhooks(ctx context.Context) (int, error) {
+	timeout := time.Duration(s.config.WebhookTimeoutSeconds) * time.Second
+	now := time.Now()
+	deliveries, err := s.repository.ClaimDueDeliveries(ctx, now, now.Add(timeout+deliveryLeaseOverhead), s.config.WebhookDeliveryBatchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	var wg sync.WaitGroup
+	for _, delivery := range deliveries {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			s.deliver(ctx, delivery)
+		}()
+	}
+	wg.Wait()
+	return len(deliveries), nil
+}
+
+// deliver makes an attempt of the delivery. An attempt interrupted by the
+// shutdown isn't recorded, the delivery is attempted again once its lease
+// runs out.
+func (s webhookService) deliver(ctx context.Context, delivery models.PendingDelivery) {
+	attemptedAt := time.Now()
+	response, err := s.client.Send(ctx, newSendWebhookRequest(delivery, attemptedAt))
+	if ctx.Err() != nil {
+		return
+	}
+	result := newDeliveryResult(delivery, response, err, attemptedAt,
+		s.config.WebhookMaxAttempts, time.Duration(s.config.WebhookRetryBaseSeconds)*time.Second)
+	if result.Status == models.DeliveryStatusFailed {
+		s.logger.Warn("Webhook delivery failed",
+			zap.String("delivery_id", delivery.ID.String()),
+			zap.Int("attempts", delivery.Attempts),
+		)
+	}
+	if err := s.repository.RecordDeliveryResult(ctx, result); err != nil {
+		s.logger.Error("Failed to record webhook delivery result",
+			zap.Error(err),
+			zap.String("delivery_id", delivery.ID.String()),
+		)
+	}
+}
+
+// PurgeEvents deletes the events older than the retention window whose
+// deliveries are over
+func (s webhookService) PurgeEvents(ctx context.Context) (int64, error) {
+	retention := time.Duration(s.config.WebhookEventRetentionHours) * time.Hour
+	return s.repository.PurgeEvents(ctx, time.Now().Add(-retention))
+}
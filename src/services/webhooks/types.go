@@ -0,0 +1,25 @@
+package webhooks
+
+import models "go-api/src/models/webhooks"
+
+type CreateEndpointRequest struct {
+	// URL receives the events, it must use https
+	URL         string `json:"url"`
+	Description string `json:"description"`
+	// EventTypes are the events sent to the endpoint, at least one
+	EventTypes []models.EventType `json:"event_types"`
+}
+
+type UpdateEndpointRequest struct {
+	URL         *string `json:"url"`
+	Description *string `json:"description"`
+	// EventTypes replaces the events sent to the endpoint when present
+	EventTypes *[]models.EventType `json:"event_types"`
+	// Active pauses or resumes the deliveries to the endpoint
+	Active *bool `json:"active"`
+}
+
+type ListDeliveriesRequest struct {
+	// Limit defaults to 50, at most 100 deliveries are returned
+	Limit int `query:"limit"`
+}
@@ -0,0 +1,91 @@
+package idempotencypurge
+
+import (
+	"context"
+	"go-api/src/config"
+	service "go-api/src/services/idempotency"
+	"time"
+
+	"go.uber.org/fx"
+	"go.uber.org/zap"
+)
+
+// IdempotencyPurgeWorkerParams defines the dependencies for the idempotency key purge worker
+type IdempotencyPurgeWorkerParams struct {
+	fx.In
+
+	Lifecycle fx.Lifecycle
+	Config    *config.Config
+	Service   service.IdempotencyService
+	Logger    *zap.Logger
+}
+
+type idempotencyPurgeWorker struct {
+	service  service.IdempotencyService
+	logger   *zap.Logger
+	interval time.Duration
+}
+
+// RegisterIdempotencyPurgeWorker periodically deletes the idempotency keys
+// whose TTL is over. It runs for as long as the app does, a non positive
+// interval disables it.
+func RegisterIdempotencyPurgeWorker(p IdempotencyPurgeWorkerParams) {
+	if p.Config.IdempotencyPurgeIntervalMinutes <= 0 {
+		p.Logger.Info("Idempotency key purge worker disabled")
+		return
+	}
+	w := &idempotencyPurgeWorker{
+		service:  p.Service,
+		logger:   p.Logger,
+		interval: time.Duration(p.Config.IdempotencyPurgeIntervalMinutes) * time.Minute,
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+
+	p.Lifecycle.Append(fx.Hook{
+		OnStart: func(context.Context) error {
+			go func() {
+				defer close(done)
+				w.run(ctx)
+			}()
+			return nil
+		},
+		OnStop: func(stopCtx context.Context) error {
+			cancel()
+			select {
+			case <-done:
+			case <-stopCtx.Done():
+			}
+			return nil
+		},
+	})
+}
+
+func (w *idempotencyPurgeWorker) run(ctx context.Context) {
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		w.purge(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (w *idempotencyPurgeWorker) purge(ctx context.Context) {
+	purged, err := w.service.PurgeExpiredKeys(ctx)
+	if err != nil {
+		if ctx.Err() == nil {
+			w.logger.Error("Failed to purge expired idempotency keys", zap.Error(err))
+		}
+		return
+	}
+	if purged > 0 {
+		w.logger.Info("Purged expired idempotency keys", zap.Int64("count", purged))
+	}
+}
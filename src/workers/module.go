@@ -0,0 +1,21 @@
+package workers
+
+import (
+	"go-api/src/workers/idempotencypurge"
+	"go-api/src/workers/sessionnotifications"
+	"go-api/src/workers/sessionpurge"
+	"go-api/src/workers/stalesessions"
+	"go-api/src/workers/webhookdelivery"
+
+	"go.uber.org/fx"
+)
+
+var Module = fx.Options(
+	fx.Invoke(
+		sessionpurge.RegisterSessionPurgeWorker,
+		stalesessions.RegisterStaleSessionsWorker,
+		idempotencypurge.RegisterIdempotencyPurgeWorker,
+		sessionnotifications.RegisterSessionNotificationsWorker,
+		webhookdelivery.RegisterWebhookDeliveryWorker,
+	),
+)
@@ -0,0 +1,123 @@
+package sessionnotifications
+
+import (
+	"context"
+	"go-api/src/config"
+	service "go-api/src/services/notifications"
+	"sync"
+	"time"
+
+	"go.uber.org/fx"
+	"go.uber.org/zap"
+)
+
+// listenRetryInterval is the wait before listening again when the listener
+// couldn't be set up
+const listenRetryInterval = 10 * time.Second
+
+// SessionNotificationsWorkerParams defines the dependencies for the session notifications worker
+type SessionNotificationsWorkerParams struct {
+	fx.In
+
+	Lifecycle fx.Lifecycle
+	Config    *config.Config
+	Service   service.NotificationService
+	Logger    *zap.Logger
+}
+
+type sessionNotificationsWorker struct {
+	service       service.NotificationService
+	logger        *zap.Logger
+	purgeInterval time.Duration
+}
+
+// RegisterSessionNotificationsWorker listens for the notifications published
+// by every replica to wake up the session streams open on this one. It also
+// periodically purges the notifications older than the retention window, a
+// non positive purge interval disables the purge only.
+func RegisterSessionNotificationsWorker(p SessionNotificationsWorkerParams) {
+	w := &sessionNotificationsWorker{
+		service:       p.Service,
+		logger:        p.Logger,
+		purgeInterval: time.Duration(p.Config.SessionNotificationPurgeIntervalMinutes) * time.Minute,
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	var wg sync.WaitGroup
+
+	p.Lifecycle.Append(fx.Hook{
+		OnStart: func(context.Context) error {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				w.listen(ctx)
+			}()
+			if w.purgeInterval > 0 {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					w.runPurge(ctx)
+				}()
+			} else {
+				w.logger.Info("Session notification purge disabled")
+			}
+			return nil
+		},
+		OnStop: func(stopCtx context.Context) error {
+			cancel()
+			done := make(chan struct{})
+			go func() {
+				wg.Wait()
+				close(done)
+			}()
+			select {
+			case <-done:
+			case <-stopCtx.Done():
+			}
+			return nil
+		},
+	})
+}
+
+func (w *sessionNotificationsWorker) listen(ctx context.Context) {
+	for {
+		err := w.service.ListenNotifications(ctx)
+		if err != nil && ctx.Err() == nil {
+			w.logger.Error("Failed to listen for session notifications", zap.Error(err))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(listenRetryInterval):
+		}
+	}
+}
+
+func (w *sessionNotificationsWorker) runPurge(ctx context.Context) {
+	ticker := time.NewTicker(w.purgeInterval)
+	defer ticker.Stop()
+
+	for {
+		w.purge(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (w *sessionNotificationsWorker) purge(ctx context.Context) {
+	purged, err := w.service.PurgeNotifications(ctx)
+	if err != nil {
+		if ctx.Err() == nil {
+			w.logger.Error("Failed to purge session notifications", zap.Error(err))
+		}
+		return
+	}
+	if purged > 0 {
+		w.logger.Info("Purged session notifications", zap.Int64("count", purged))
+	}
+}
@@ -0,0 +1,91 @@
+package sessionpurge
+
+import (
+	"context"
+	"go-api/src/config"
+	service "go-api/src/services/studysession"
+	"time"
+
+	"go.uber.org/fx"
+	"go.uber.org/zap"
+)
+
+// SessionPurgeWorkerParams defines the dependencies for the session purge worker
+type SessionPurgeWorkerParams struct {
+	fx.In
+
+	Lifecycle fx.Lifecycle
+	Config    *config.Config
+	Service   service.StudySessionService
+	Logger    *zap.Logger
+}
+
+type sessionPurgeWorker struct {
+	service  service.StudySessionService
+	logger   *zap.Logger
+	interval time.Duration
+}
+
+// RegisterSessionPurgeWorker periodically hard deletes the study sessions
+// whose retention window has expired. It runs for as long as the app does,
+// a non positive interval disables it.
+func RegisterSessionPurgeWorker(p SessionPurgeWorkerParams) {
+	if p.Config.SessionPurgeIntervalMinutes <= 0 {
+		p.Logger.Info("Session purge worker disabled")
+		return
+	}
+	w := &sessionPurgeWorker{
+		service:  p.Service,
+		logger:   p.Logger,
+		interval: time.Duration(p.Config.SessionPurgeIntervalMinutes) * time.Minute,
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+
+	p.Lifecycle.Append(fx.Hook{
+		OnStart: func(context.Context) error {
+			go func() {
+				defer close(done)
+				w.run(ctx)
+			}()
+			return nil
+		},
+		OnStop: func(stopCtx context.Context) error {
+			cancel()
+			select {
+			case <-done:
+			case <-stopCtx.Done():
+			}
+			return nil
+		},
+	})
+}
+
+func (w *sessionPurgeWorker) run(ctx context.Context) {
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		w.purge(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (w *sessionPurgeWorker) purge(ctx context.Context) {
+	purged, err := w.service.PurgeDeletedStudySessions(ctx)
+	if err != nil {
+		if ctx.Err() == nil {
+			w.logger.Error("Failed to purge deleted study sessions", zap.Error(err))
+		}
+		return
+	}
+	if purged > 0 {
+		w.logger.Info("Purged deleted study sessions", zap.Int64("count", purged))
+	}
+}
@@ -0,0 +1,101 @@
+package stalesessions
+
+import (
+	"context"
+	"fmt"
+	"go-api/src/config"
+	models "go-api/src/models/studysession"
+	service "go-api/src/services/studysession"
+	"time"
+
+	"go.uber.org/fx"
+	"go.uber.org/zap"
+)
+
+// StaleSessionsWorkerParams defines the dependencies for the stale sessions worker
+type StaleSessionsWorkerParams struct {
+	fx.In
+
+	Lifecycle fx.Lifecycle
+	Config    *config.Config
+	Service   service.StudySessionService
+	Logger    *zap.Logger
+}
+
+type staleSessionsWorker struct {
+	service  service.StudySessionService
+	logger   *zap.Logger
+	interval time.Duration
+}
+
+// RegisterStaleSessionsWorker periodically closes the active sessions whose
+// timer was left running. Every replica runs the worker, the repository
+// makes sure only one of them sweeps at a time. A non positive interval
+// disables it.
+func RegisterStaleSessionsWorker(p StaleSessionsWorkerParams) error {
+	if p.Config.StaleSessionSweepIntervalMinutes <= 0 {
+		p.Logger.Info("Stale sessions worker disabled")
+		return nil
+	}
+	if !models.StaleSessionAction(p.Config.StaleSessionAction).IsValid() {
+		return fmt.Errorf("%w: %q", models.ErrInvalidStaleAction, p.Config.StaleSessionAction)
+	}
+	if p.Config.StaleSessionIdleMinutes <= 0 {
+		return fmt.Errorf("stale session idle threshold must be positive, got %d minutes", p.Config.StaleSessionIdleMinutes)
+	}
+	w := &staleSessionsWorker{
+		service:  p.Service,
+		logger:   p.Logger,
+		interval: time.Duration(p.Config.StaleSessionSweepIntervalMinutes) * time.Minute,
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+
+	p.Lifecycle.Append(fx.Hook{
+		OnStart: func(context.Context) error {
+			go func() {
+				defer close(done)
+				w.run(ctx)
+			}()
+			return nil
+		},
+		OnStop: func(stopCtx context.Context) error {
+			cancel()
+			select {
+			case <-done:
+			case <-stopCtx.Done():
+			}
+			return nil
+		},
+	})
+	return nil
+}
+
+func (w *staleSessionsWorker) run(ctx context.Context) {
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		w.sweep(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (w *staleSessionsWorker) sweep(ctx context.Context) {
+	closed, err := w.service.CloseStaleStudySessions(ctx)
+	if err != nil {
+		if ctx.Err() == nil {
+			w.logger.Error("Failed to close stale study sessions", zap.Error(err))
+		}
+		return
+	}
+	if closed > 0 {
+		w.logger.Info("Closed stale study sessions", zap.Int("count", closed))
+	}
+}
@@ -0,0 +1,142 @@
+package webhookdelivery
+
+import (
+	"context"
+	"go-api/src/config"
+	service "go-api/src/services/webhooks"
+	"sync"
+	"time"
+
+	"go.uber.org/fx"
+	"go.uber.org/zap"
+)
+
+// WebhookDeliveryWorkerParams defines the dependencies for the webhook delivery worker
+type WebhookDeliveryWorkerParams struct {
+	fx.In
+
+	Lifecycle fx.Lifecycle
+	Config    *config.Config
+	Service   service.WebhookService
+	Logger    *zap.Logger
+}
+
+type webhookDeliveryWorker struct {
+	service          service.WebhookService
+	logger           *zap.Logger
+	deliveryInterval time.Duration
+	batchSize        int
+	purgeInterval    time.Duration
+}
+
+// RegisterWebhookDeliveryWorker periodically sends the due webhook
+// deliveries of the outbox, every replica takes part. It also purges the
+// events older than the retention window. A non positive interval disables
+// the delivery or the purge.
+func RegisterWebhookDeliveryWorker(p WebhookDeliveryWorkerParams) {
+	w := &webhookDeliveryWorker{
+		service:          p.Service,
+		logger:           p.Logger,
+		deliveryInterval: time.Duration(p.Config.WebhookDeliveryIntervalSeconds) * time.Second,
+		batchSize:        p.Config.WebhookDeliveryBatchSize,
+		purgeInterval:    time.Duration(p.Config.WebhookPurgeIntervalMinutes) * time.Minute,
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	var wg sync.WaitGroup
+
+	p.Lifecycle.Append(fx.Hook{
+		OnStart: func(context.Context) error {
+			if w.deliveryInterval > 0 && w.batchSize > 0 {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					w.runDelivery(ctx)
+				}()
+			} else {
+				w.logger.Info("Webhook delivery disabled")
+			}
+			if w.purgeInterval > 0 {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					w.runPurge(ctx)
+				}()
+			} else {
+				w.logger.Info("Webhook event purge disabled")
+			}
+			return nil
+		},
+		OnStop: func(stopCtx context.Context) error {
+			cancel()
+			done := make(chan struct{})
+			go func() {
+				wg.Wait()
+				close(done)
+			}()
+			select {
+			case <-done:
+			case <-stopCtx.Done():
+			}
+			return nil
+		},
+	})
+}
+
+func (w *webhookDeliveryWorker) runDelivery(ctx context.Context) {
+	ticker := time.NewTicker(w.deliveryInterval)
+	defer ticker.Stop()
+
+	for {
+		// A full batch means more deliveries may be due, the next one is
+		// claimed right away
+		if w.deliver(ctx) >= w.batchSize && ctx.Err() == nil {
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (w *webhookDeliveryWorker) deliver(ctx context.Context) int {
+	attempts, err := w.service.DeliverDueWebhooks(ctx)
+	if err != nil {
+		if ctx.Err() == nil {
+			w.logger.Error("Failed to deliver webhooks", zap.Error(err))
+		}
+		return 0
+	}
+	return attempts
+}
+
+func (w *webhookDeliveryWorker) runPurge(ctx context.Context) {
+	ticker := time.NewTicker(w.purgeInterval)
+	defer ticker.Stop()
+
+	for {
+		w.purge(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (w *webhookDeliveryWorker) purge(ctx context.Context) {
+	purged, err := w.service.PurgeEvents(ctx)
+	if err != nil {
+		if ctx.Err() == nil {
+			w.logger.Error("Failed to purge webhook events", zap.Error(err))
+		}
+		return
+	}
+	if purged > 0 {
+		w.logger.Info("Purged webhook events", zap.Int64("count", purged))
+	}
+}